@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -40,6 +41,88 @@ type ApplicationSpec struct {
 	SourceType SourceType `json:"source_type,omitempty"`
 	// HelmTargetNamespace is the namespace in which to deploy an added Helm Chart
 	HelmTargetNamespace string `json:"helm_target_namespace,omitempty"`
+	// SourceInterval is how often the source for this application is fetched; defaults to 30s
+	SourceInterval *metav1.Duration `json:"source_interval,omitempty"`
+	// Interval is how often the automation for this application is reconciled
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Timeout is the time allowed for applying the manifests of this application
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Prune enables garbage collection of the resources removed from the application; defaults to true
+	Prune *bool `json:"prune,omitempty"`
+	// Wait makes the reconciliation wait for all the applied resources to become ready
+	Wait bool `json:"wait,omitempty"`
+	// HealthChecks lists the resources to wait for after applying the manifests of this application
+	HealthChecks []HealthCheck `json:"health_checks,omitempty"`
+	// HelmValues holds the values passed to the Helm chart of this application
+	// +kubebuilder:pruning:PreserveUnknownFields
+	HelmValues *apiextensionsv1.JSON `json:"helm_values,omitempty"`
+	// HelmValuesFrom lists the ConfigMaps and Secrets holding values for the Helm chart of this application
+	HelmValuesFrom []ValuesReference `json:"helm_values_from,omitempty"`
+	// Bucket holds the settings of the bucket containing the app manifests, for bucket sources.
+	// The endpoint of the bucket is stored in URL.
+	Bucket *BucketSource `json:"bucket,omitempty"`
+	// Substitute holds the variables substituted in the manifests of this application after they are built
+	Substitute map[string]string `json:"substitute,omitempty"`
+	// SubstituteFrom lists the ConfigMaps and Secrets holding variables substituted in the manifests of this application
+	SubstituteFrom []SubstituteReference `json:"substitute_from,omitempty"`
+	// DependsOn lists the applications in the same namespace that must be ready before this application is reconciled
+	DependsOn []string `json:"depends_on,omitempty"`
+	// Decryption holds the settings to decrypt the manifests of this application
+	Decryption *Decryption `json:"decryption,omitempty"`
+}
+
+// Decryption holds the settings to decrypt the encrypted manifests of an application
+type Decryption struct {
+	// Provider decrypting the manifests
+	// +kubebuilder:validation:Enum=sops
+	Provider string `json:"provider"`
+	// SecretRef is the name of the secret holding the decryption keys, in the namespace of the application
+	SecretRef string `json:"secret_ref,omitempty"`
+}
+
+// BucketSource holds the settings of an S3 compatible bucket
+type BucketSource struct {
+	// Name of the bucket
+	Name string `json:"name"`
+	// Provider of the bucket storage; defaults to generic
+	// +kubebuilder:validation:Enum=generic;aws;gcp
+	Provider string `json:"provider,omitempty"`
+	// Region of the bucket
+	Region string `json:"region,omitempty"`
+	// Insecure allows connecting to a non-TLS endpoint
+	Insecure bool `json:"insecure,omitempty"`
+	// SecretRef is the name of the secret holding the credentials for the bucket, in the namespace of the application
+	SecretRef string `json:"secret_ref,omitempty"`
+}
+
+// ValuesReference is a reference to a ConfigMap or Secret holding Helm values in its values.yaml key
+type ValuesReference struct {
+	// Kind of the values referent
+	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	Kind string `json:"kind"`
+	// Name of the values referent, in the namespace of the application
+	Name string `json:"name"`
+}
+
+// SubstituteReference is a reference to a ConfigMap or Secret whose data keys are variables substituted in the manifests
+type SubstituteReference struct {
+	// Kind of the variables referent
+	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	Kind string `json:"kind"`
+	// Name of the variables referent, in the namespace of the application
+	Name string `json:"name"`
+}
+
+// HealthCheck is a reference to a resource whose health is assessed after an application is reconciled
+type HealthCheck struct {
+	// APIVersion of the resource; optional for core workload kinds
+	APIVersion string `json:"api_version,omitempty"`
+	// Kind of the resource
+	Kind string `json:"kind"`
+	// Name of the resource
+	Name string `json:"name"`
+	// Namespace of the resource; defaults to the application namespace
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=helm;kustomize
@@ -50,12 +133,14 @@ const (
 	DeploymentTypeKustomize DeploymentType = "kustomize"
 )
 
-// +kubebuilder:validation:Enum=helm;git
+// +kubebuilder:validation:Enum=helm;git;bucket;oci
 type SourceType string
 
 const (
-	SourceTypeGit  SourceType = "git"
-	SourceTypeHelm SourceType = "helm"
+	SourceTypeGit    SourceType = "git"
+	SourceTypeHelm   SourceType = "helm"
+	SourceTypeBucket SourceType = "bucket"
+	SourceTypeOCI    SourceType = "oci"
 )
 
 // SuspendAction defines the command run to pause/unpause an application
@@ -68,15 +153,43 @@ const (
 
 const DefaultNamespace = "wego-system"
 
+const (
+	// ReadyCondition reports whether the automation for an application has been applied successfully
+	ReadyCondition string = "Ready"
+	// SourceReadyCondition reports whether the source for an application has been fetched successfully
+	SourceReadyCondition string = "SourceReady"
+)
+
+const (
+	// ProgressingReason signals that the flux objects for an application have not reported a result yet
+	ProgressingReason string = "Progressing"
+	// SourceNotFoundReason signals that the source for an application does not exist in the cluster
+	SourceNotFoundReason string = "SourceNotFound"
+	// AutomationNotFoundReason signals that the Kustomization or HelmRelease for an application does not exist in the cluster
+	AutomationNotFoundReason string = "AutomationNotFound"
+)
+
 // ApplicationStatus defines the observed state of Application
 type ApplicationStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// ObservedGeneration is the last generation of the application reconciled by the controller
+	ObservedGeneration int64 `json:"observed_generation,omitempty"`
+	// Conditions holds the latest observations of the application's source and automation
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// SourceRevision is the revision of the last artifact fetched for this application
+	SourceRevision string `json:"source_revision,omitempty"`
+	// LastAppliedRevision is the revision of the last artifact applied to the cluster for this application
+	LastAppliedRevision string `json:"last_applied_revision,omitempty"`
+	// LastReconcileTime is the last time the automation for this application reported a reconciliation result
+	LastReconcileTime *metav1.Time `json:"last_reconcile_time,omitempty"`
+	// Suspended is true when the automation for this application is suspended
+	Suspended bool `json:"suspended,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:resource:singular=app,path=apps
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description=""
+//+kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].message",description=""
 
 // Application is the Schema for the applications API
 type Application struct {
@@ -88,7 +201,18 @@ type Application struct {
 }
 
 func (a *Application) IsHelmRepository() bool {
-	return a.Spec.SourceType == SourceTypeHelm
+	return a.Spec.SourceType == SourceTypeHelm || a.Spec.SourceType == SourceTypeOCI
+}
+
+// IsBucket reports whether the manifests of the application come from a bucket.
+func (a *Application) IsBucket() bool {
+	return a.Spec.SourceType == SourceTypeBucket
+}
+
+// IsGitRepository reports whether the manifests of the application come from a git repository.
+// Apps created before the SourceType field existed use git.
+func (a *Application) IsGitRepository() bool {
+	return a.Spec.SourceType == SourceTypeGit || a.Spec.SourceType == ""
 }
 
 //+kubebuilder:object:root=true
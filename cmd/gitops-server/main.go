@@ -10,6 +10,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	appwatcher "github.com/weaveworks/weave-gitops/pkg/app/watcher"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
 	"github.com/weaveworks/weave-gitops/pkg/helm/watcher"
 	"github.com/weaveworks/weave-gitops/pkg/helm/watcher/cache"
@@ -17,6 +19,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/osys"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
 	"github.com/weaveworks/weave-gitops/pkg/server"
+	"github.com/weaveworks/weave-gitops/pkg/tracing"
 )
 
 func main() {
@@ -38,6 +41,12 @@ const (
 )
 
 func NewAPIServerCommand() *cobra.Command {
+	var (
+		metricsAddress          string
+		leaderElectionNamespace string
+		tracingOptions          tracing.Options
+	)
+
 	cmd := &cobra.Command{
 		Use:  "gitops-server",
 		Long: `The gitops-server handles HTTP requests for Weave GitOps Applications`,
@@ -51,6 +60,17 @@ func NewAPIServerCommand() *cobra.Command {
 				return err
 			}
 
+			shutdownTracing, err := tracing.Setup(context.Background(), tracingOptions)
+			if err != nil {
+				return fmt.Errorf("could not set up tracing: %w", err)
+			}
+
+			defer func() {
+				if err := shutdownTracing(context.Background()); err != nil {
+					appConfig.Logger.Error(err, "failed to flush traces")
+				}
+			}()
+
 			rest, clusterName, err := kube.RestConfig()
 			if err != nil {
 				return fmt.Errorf("could not create client config: %w", err)
@@ -74,7 +94,7 @@ func NewAPIServerCommand() *cobra.Command {
 			profileWatcher, err := watcher.NewWatcher(watcher.Options{
 				KubeClient:                    rawClient,
 				Cache:                         profileCache,
-				MetricsBindAddress:            metricsBindAddress,
+				MetricsBindAddress:            metricsAddress,
 				HealthzBindAddress:            healthzBindAddress,
 				NotificationControllerAddress: notificationBindAddress,
 				WatcherPort:                   watcherPort,
@@ -90,6 +110,21 @@ func NewAPIServerCommand() *cobra.Command {
 				}
 			}()
 
+			applicationWatcher, err := appwatcher.NewWatcher(appwatcher.Options{
+				LeaderElection:          true,
+				LeaderElectionNamespace: leaderElectionNamespace,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create application watcher: %w", err)
+			}
+
+			go func() {
+				if err := applicationWatcher.StartWatcher(context.Background()); err != nil {
+					appConfig.Logger.Error(err, "failed to start application watcher")
+					os.Exit(1)
+				}
+			}()
+
 			profilesConfig := server.NewProfilesConfig(kube.ClusterConfig{
 				DefaultConfig: rest,
 				ClusterName:   clusterName,
@@ -105,5 +140,14 @@ func NewAPIServerCommand() *cobra.Command {
 		},
 	}
 
+	// the metrics of the server are registered with controller-runtime, and served with the metrics of the helm watcher
+	cmd.Flags().StringVar(&metricsAddress, "metrics-bind-address", metricsBindAddress, "bind address for the metrics of the server and of its watchers, 0 disables them")
+	cmd.Flags().StringVar(&leaderElectionNamespace, "leader-election-namespace", wego.DefaultNamespace, "namespace of the lock the replicas of the application watcher elect their leader with")
+
+	tracingOptions.ServiceName = "gitops-server"
+	cmd.Flags().StringVar(&tracingOptions.Exporter, "tracing-exporter", tracing.ExporterNone, "exporter of the traces of the requests, one of none or otlp")
+	cmd.Flags().StringVar(&tracingOptions.Endpoint, "otlp-endpoint", "", "address of the OpenTelemetry collector the otlp exporter sends the traces to, defaults to $OTEL_EXPORTER_OTLP_ENDPOINT or localhost:4317")
+	cmd.Flags().BoolVar(&tracingOptions.Insecure, "otlp-insecure", false, "connect to the OpenTelemetry collector without TLS")
+
 	return cmd
 }
@@ -30,7 +30,10 @@ const (
 	SSHAuthSock = "SSH_AUTH_SOCK"
 )
 
-var params app.AddParams
+var (
+	params app.AddParams
+	prune  bool
+)
 
 var Cmd = &cobra.Command{
 	Use:   "app [--name <name>] (--url <url> | <repository directory>) [--branch <branch>] [--path <path within repository>]",
@@ -44,6 +47,24 @@ var Cmd = &cobra.Command{
 
   # Add podinfo application to gitops control from github repository
   gitops add app --url git@github.com:myorg/podinfo
+
+  # Add podinfo application from a chart in an OCI registry, the cluster needs v1beta2 HelmRepositories
+  gitops add app --url oci://ghcr.io/stefanprodan/charts --chart podinfo
+
+  # Add application from the manifests in an S3 bucket
+  gitops add app --name podinfo --url s3.amazonaws.com --bucket podinfo-manifests --bucket-provider aws --bucket-region us-east-1 --path ./deploy
+
+  # Add podinfo application with the ${cluster_env} variable in its manifests set to prod
+  gitops add app --url git@github.com:myorg/podinfo --substitute cluster_env=prod --substitute-from configmap/cluster-vars
+
+  # Add podinfo application with its SOPS encrypted secrets decrypted with the key created by 'gitops create secret sops-key'
+  gitops add app --url git@github.com:myorg/podinfo --decryption-provider sops --decryption-secret sops-age
+
+  # Add the custom resources of an operator once the operator application is ready
+  gitops add app --url git@github.com:myorg/certificates --depends-on cert-manager
+
+  # Add podinfo application to the prod-eu and prod-us clusters managed from the same config repository
+  gitops add app --url git@github.com:myorg/podinfo --cluster prod-eu --cluster prod-us
 `,
 	RunE:          runCmd,
 	SilenceUsage:  true,
@@ -63,6 +84,27 @@ func init() {
 	Cmd.Flags().StringVar(&params.HelmReleaseTargetNamespace, "helm-release-target-namespace", "", "Namespace in which to deploy a helm chart; defaults to the gitops installation namespace")
 	Cmd.Flags().BoolVar(&params.DryRun, "dry-run", false, "If set, 'gitops add app' will not make any changes to the system; it will just display the actions that would have been taken")
 	Cmd.Flags().BoolVar(&params.AutoMerge, "auto-merge", false, "If set, 'gitops add app' will merge automatically into the set --branch")
+	internal.AddPullRequestOptionsFlags(Cmd, &params.PullRequestOptions)
+	Cmd.Flags().DurationVar(&params.SourceInterval, "source-interval", 0, "How often the application source is fetched; defaults to 30s")
+	Cmd.Flags().DurationVar(&params.Interval, "interval", 0, "How often the application is reconciled; defaults to 1m for kustomize and 5m for helm deployments")
+	Cmd.Flags().DurationVar(&params.Timeout, "timeout", 0, "Timeout for applying the application manifests")
+	Cmd.Flags().BoolVar(&prune, "prune", true, "Garbage collect the resources removed from the application; kustomize deployments only")
+	Cmd.Flags().BoolVar(&params.Wait, "wait", false, "Wait for all the applied resources to become ready; kustomize deployments only")
+	Cmd.Flags().StringSliceVar(&params.HealthChecks, "health-check", nil, "Resource to wait for after applying the application, in the format '<kind>/<name>.<namespace>'; kustomize deployments only")
+	Cmd.Flags().StringSliceVar(&params.HelmValuesFiles, "values", nil, "Values file for the helm chart; can be repeated, later files take precedence")
+	Cmd.Flags().StringArrayVar(&params.HelmSetValues, "set", nil, "Value for the helm chart in the key=val format; can be repeated and takes precedence over --values")
+	Cmd.Flags().StringSliceVar(&params.HelmValuesFrom, "values-from", nil, "ConfigMap or Secret holding values for the helm chart in its values.yaml key, in the format '<kind>/<name>'")
+	Cmd.Flags().StringVar(&params.BucketName, "bucket", "", "Name of an S3 compatible bucket holding the application manifests; --url is then the endpoint of the bucket")
+	Cmd.Flags().StringVar(&params.BucketProvider, "bucket-provider", "", "Provider of the bucket storage [generic, aws, gcp]; defaults to generic")
+	Cmd.Flags().StringVar(&params.BucketRegion, "bucket-region", "", "Region of the bucket")
+	Cmd.Flags().BoolVar(&params.BucketInsecure, "bucket-insecure", false, "Connect to the bucket endpoint without TLS")
+	Cmd.Flags().StringVar(&params.BucketSecretRef, "bucket-secret-ref", "", "Name of the secret holding the credentials for the bucket, in the application namespace")
+	Cmd.Flags().StringArrayVar(&params.Substitute, "substitute", nil, "Variable substituted in the application manifests after they are built, in the key=val format; can be repeated. kustomize deployments only")
+	Cmd.Flags().StringSliceVar(&params.SubstituteFrom, "substitute-from", nil, "ConfigMap or Secret whose data keys are variables substituted in the application manifests, in the format '<kind>/<name>' with the kind in any case; kustomize deployments only")
+	Cmd.Flags().StringVar(&params.DecryptionProvider, "decryption-provider", "", "Provider decrypting the encrypted manifests of the application [sops]; kustomize deployments only")
+	Cmd.Flags().StringVar(&params.DecryptionSecret, "decryption-secret", "", "Name of the secret holding the decryption keys, in the application namespace; see 'gitops create secret sops-key'")
+	Cmd.Flags().StringSliceVar(&params.DependsOn, "depends-on", nil, "Name of an application in the same namespace that must be ready before this application is reconciled; can be repeated")
+	Cmd.Flags().StringSliceVar(&params.Clusters, "cluster", nil, "Name of a cluster in the config repository to add the application to; can be repeated. Defaults to the cluster of the current kube context")
 }
 
 func ensureUrlIsValid() error {
@@ -83,6 +125,7 @@ func ensureUrlIsValid() error {
 func runCmd(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
+	params.Prune = &prune
 
 	if params.Url != "" && len(args) > 0 {
 		return fmt.Errorf("you should choose either --url or the app directory")
@@ -124,12 +167,29 @@ func runCmd(cmd *cobra.Command, args []string) error {
 
 	params.ConfigRepo = wegoConfig.ConfigRepo
 
+	signer, err := internal.GetSigner(ctx, kubeClient, params.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get commit signer: %w", err)
+	}
+
+	author, err := internal.GetCommitAuthor()
+	if err != nil {
+		return err
+	}
+
+	if params.CommitTemplates, err = internal.GetCommitTemplates(); err != nil {
+		return err
+	}
+
 	gitClient, gitProvider, err := factory.GetGitClients(ctx, kubeClient, providerClient, services.GitConfigParams{
 		URL:              params.Url,
 		ConfigRepo:       params.ConfigRepo,
 		Namespace:        params.Namespace,
 		IsHelmRepository: params.IsHelmRepository(),
+		IsBucket:         params.IsBucket(),
 		DryRun:           params.DryRun,
+		Signer:           signer,
+		Author:           author,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to get git clients: %w", err)
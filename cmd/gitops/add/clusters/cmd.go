@@ -14,21 +14,23 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/adapters"
 	"github.com/weaveworks/weave-gitops/pkg/capi"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/models"
 	"github.com/weaveworks/weave-gitops/pkg/services/auth"
 )
 
 type clusterCommandFlags struct {
-	DryRun          bool
-	Template        string
-	ParameterValues []string
-	RepositoryURL   string
-	BaseBranch      string
-	HeadBranch      string
-	Title           string
-	Description     string
-	CommitMessage   string
-	Credentials     string
-	Profiles        []string
+	DryRun             bool
+	Template           string
+	ParameterValues    []string
+	RepositoryURL      string
+	BaseBranch         string
+	HeadBranch         string
+	Title              string
+	Description        string
+	CommitMessage      string
+	Credentials        string
+	Profiles           []string
+	PullRequestOptions gitproviders.PullRequestOptions
 }
 
 var flags clusterCommandFlags
@@ -62,6 +64,7 @@ gitops add cluster --from-template <template-name> \
 	cmd.Flags().StringVar(&flags.Credentials, "set-credentials", "", "The CAPI credentials to use")
 	cmd.Flags().StringArrayVar(&flags.Profiles, "profile", []string{}, "Set profiles values files on the command line (--profile 'name=foo-profile,version=0.0.1' --profile 'name=bar-profile,values=bar-values.yaml')")
 	internal.AddPRFlags(cmd, &flags.HeadBranch, &flags.BaseBranch, &flags.Description, &flags.CommitMessage, &flags.Title)
+	internal.AddPullRequestOptionsFlags(cmd, &flags.PullRequestOptions)
 
 	return cmd
 }
@@ -118,6 +121,25 @@ func getClusterCmdRunE(endpoint *string, client *resty.Client) func(*cobra.Comma
 			return fmt.Errorf("cannot parse url: %w", err)
 		}
 
+		templates, err := internal.GetCommitTemplates()
+		if err != nil {
+			return err
+		}
+
+		data := models.CommitData{Action: models.ActionAddCluster}
+		if name, ok := vals["CLUSTER_NAME"]; ok {
+			data.Clusters = []string{name}
+		}
+
+		info, err := templates.Apply(gitproviders.PullRequestInfo{
+			Title:         flags.Title,
+			Description:   flags.Description,
+			CommitMessage: flags.CommitMessage,
+		}, data)
+		if err != nil {
+			return err
+		}
+
 		token, err := internal.GetToken(url, os.Stdout, os.LookupEnv, auth.NewAuthCLIHandler, internal.NewCLILogger(os.Stdout))
 		if err != nil {
 			return err
@@ -130,11 +152,15 @@ func getClusterCmdRunE(endpoint *string, client *resty.Client) func(*cobra.Comma
 			RepositoryURL:    flags.RepositoryURL,
 			HeadBranch:       flags.HeadBranch,
 			BaseBranch:       flags.BaseBranch,
-			Title:            flags.Title,
-			Description:      flags.Description,
-			CommitMessage:    flags.CommitMessage,
+			Title:            info.Title,
+			Description:      info.Description,
+			CommitMessage:    info.CommitMessage,
 			Credentials:      creds,
 			ProfileValues:    profilesValues,
+			Reviewers:        flags.PullRequestOptions.Reviewers,
+			Labels:           flags.PullRequestOptions.Labels,
+			Assignees:        flags.PullRequestOptions.Assignees,
+			Draft:            flags.PullRequestOptions.Draft,
 		}
 
 		return capi.CreatePullRequestFromTemplate(params, r, os.Stdout)
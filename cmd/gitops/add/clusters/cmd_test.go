@@ -180,6 +180,45 @@ func TestGitProviderToken(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCommitTemplates(t *testing.T) {
+	t.Cleanup(testutils.Setenv("GITHUB_TOKEN", "test-token"))
+
+	client := resty.New()
+
+	httpmock.ActivateNonDefault(client.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		http.MethodPost,
+		"http://localhost:8000/v1/clusters",
+		func(r *http.Request) (*http.Response, error) {
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "feat(dev): add-cluster", body["title"])
+			assert.Equal(t, "Creates cluster dev", body["description"])
+			assert.Equal(t, "feat: Add dev", body["commitMessage"])
+
+			return httpmock.NewJsonResponse(http.StatusOK, httpmock.File("../../../../pkg/adapters/testdata/pull_request_created.json"))
+		},
+	)
+
+	cmd := root.RootCmd(client)
+	cmd.SetArgs([]string{
+		"add", "cluster",
+		"--from-template=cluster-template-eks-fargate",
+		"--url=https://github.com/weaveworks/test-repo",
+		"--set=CLUSTER_NAME=dev",
+		"--commit-message=Add dev",
+		"--description=Creates cluster dev",
+		"--commit-message-template=feat: {{ .Default }}",
+		"--pull-request-title-template=feat({{ join .Clusters \",\" }}): {{ .Action }}",
+		"--endpoint", "http://localhost:8000",
+	})
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
 func TestGitProviderToken_NoURL(t *testing.T) {
 	client := resty.New()
 
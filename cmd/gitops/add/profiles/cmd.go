@@ -47,6 +47,7 @@ func AddCommand() *cobra.Command {
 	cmd.Flags().BoolVar(&opts.AutoMerge, "auto-merge", false, "If set, 'gitops add profile' will merge automatically into the repository's branch")
 	cmd.Flags().StringVar(&opts.Kubeconfig, "kubeconfig", filepath.Join(homedir.HomeDir(), ".kube", "config"), "Absolute path to the kubeconfig file")
 	internal.AddPRFlags(cmd, &opts.HeadBranch, &opts.BaseBranch, &opts.Description, &opts.Message, &opts.Title)
+	internal.AddPullRequestOptionsFlags(cmd, &opts.PullRequestOptions)
 
 	requiredFlags := []string{"name", "config-repo", "cluster"}
 	for _, f := range requiredFlags {
@@ -89,6 +90,15 @@ func addProfileCmdRunE() func(*cobra.Command, []string) error {
 			return fmt.Errorf("failed to create kube client: %w", err)
 		}
 
+		templates, err := internal.GetCommitTemplates()
+		if err != nil {
+			return err
+		}
+
+		if opts.Templates, err = models.GetCommitTemplates(context.Background(), kubeClient, opts.Namespace, templates); err != nil {
+			return err
+		}
+
 		_, gitProvider, err := factory.GetGitClients(context.Background(), kubeClient, providerClient, services.GitConfigParams{
 			ConfigRepo:       opts.ConfigRepo,
 			Namespace:        opts.Namespace,
@@ -0,0 +1,23 @@
+package app
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/app/status"
+)
+
+func GetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "app",
+		Short: "Inspect the applications under gitops control",
+		Example: `
+# Get the status of an application
+gitops app status <app-name>
+
+# Wait for an application to be ready, printing its reconciliation events
+gitops app status <app-name> --watch --timeout 10m`,
+	}
+
+	cmd.AddCommand(status.Cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/weaveworks/weave-gitops/cmd/internal"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
@@ -11,18 +12,30 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/osys"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
 	"github.com/weaveworks/weave-gitops/pkg/services"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
 )
 
+var (
+	watch   bool
+	timeout time.Duration
+)
+
 var Cmd = &cobra.Command{
 	Use:           "status <app-name>",
 	Short:         "Get status of a workload under gitops control",
 	Args:          cobra.MinimumNArgs(1),
 	SilenceUsage:  true,
 	SilenceErrors: true,
-	Example:       "gitops app status podinfo",
+	Example: `
+# Get the status of podinfo
+gitops app status podinfo
+
+# Wait for podinfo to be ready, failing if it is not ready within 10 minutes
+gitops app status podinfo --watch --timeout 10m`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := context.Background()
 
@@ -44,14 +57,53 @@ var Cmd = &cobra.Command{
 			return fmt.Errorf("failed to create app service: %w", err)
 		}
 
-		fluxOutput, lastSuccessReconciliation, err := appService.Status(params)
+		if watch {
+			return watchStatus(ctx, kubeClient, appService, params)
+		}
+
+		status, lastSuccessReconciliation, err := appService.Status(params)
 		if err != nil {
 			return fmt.Errorf("failed getting application status: %w", err)
 		}
 
 		log.Printf("Last successful reconciliation: %s\n\n", lastSuccessReconciliation)
-		log.Println(fluxOutput)
+		log.Println(status)
 
 		return nil
 	},
 }
+
+func init() {
+	Cmd.Flags().BoolVar(&watch, "watch", false, "Report the reconciliation of the application until it is ready, exiting with an error if it is not ready before the timeout")
+	Cmd.Flags().DurationVar(&timeout, "timeout", 5*time.Minute, "How long to wait for the application to be ready with --watch")
+}
+
+func watchStatus(ctx context.Context, kubeClient kube.Kube, appService app.AppService, params app.StatusParams) error {
+	config, _, err := kube.RestConfig()
+	if err != nil {
+		return fmt.Errorf("failed to create kube config: %w", err)
+	}
+
+	application, err := kubeClient.GetApplication(ctx, types.NamespacedName{Name: params.Name, Namespace: params.Namespace})
+	if err != nil {
+		return fmt.Errorf("failed getting application: %w", err)
+	}
+
+	// only the namespace of the application and the one its chart is deployed to are watched,
+	// the events of the objects deployed to other namespaces are not reported
+	newCache := cache.New
+	if target := application.Spec.HelmTargetNamespace; target != "" && target != params.Namespace {
+		newCache = cache.MultiNamespacedCacheBuilder([]string{params.Namespace, target})
+	}
+
+	informers, err := newCache(config, cache.Options{Scheme: kube.CreateScheme(), Namespace: params.Namespace})
+	if err != nil {
+		return fmt.Errorf("failed to create informers: %w", err)
+	}
+
+	return appService.Watch(ctx, informers, app.WatchParams{
+		Name:      params.Name,
+		Namespace: params.Namespace,
+		Timeout:   timeout,
+	})
+}
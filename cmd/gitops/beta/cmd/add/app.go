@@ -79,12 +79,28 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create app service: %w", err)
 	}
 
+	signer, err := internal.GetSigner(ctx, kubeClient, params.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get commit signer: %w", err)
+	}
+
+	author, err := internal.GetCommitAuthor()
+	if err != nil {
+		return err
+	}
+
+	if params.CommitTemplates, err = internal.GetCommitTemplates(); err != nil {
+		return err
+	}
+
 	gitClient, gitProvider, err := factory.GetGitClients(ctx, kubeClient, providerClient, services.GitConfigParams{
 		URL:              params.Url,
 		ConfigRepo:       params.ConfigRepo,
 		Namespace:        params.Namespace,
 		IsHelmRepository: params.IsHelmRepository(),
 		DryRun:           params.DryRun,
+		Signer:           signer,
+		Author:           author,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to get git clients: %w", err)
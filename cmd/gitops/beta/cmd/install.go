@@ -60,6 +60,7 @@ func installRunCmd(cmd *cobra.Command, args []string) error {
 
 	log := internal.NewCLILogger(os.Stdout)
 	fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+	fluxClient.SetupBin()
 
 	k, _, err := kube.NewKubeHTTPClient()
 	if err != nil {
@@ -82,10 +83,22 @@ func installRunCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	signer, err := internal.GetSigner(context.Background(), k, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get commit signer: %w", err)
+	}
+
+	author, err := internal.GetCommitAuthor()
+	if err != nil {
+		return err
+	}
+
 	gitClient, gitProvider, err := factory.GetGitClients(context.Background(), k, providerClient, services.GitConfigParams{
 		ConfigRepo: installParams.ConfigRepo,
 		Namespace:  namespace,
 		DryRun:     installParams.DryRun,
+		Signer:     signer,
+		Author:     author,
 	})
 	if err != nil {
 		return fmt.Errorf("error creating git clients: %w", err)
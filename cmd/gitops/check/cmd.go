@@ -3,7 +3,11 @@ package check
 import (
 	"context"
 	"fmt"
+	"os"
 
+	"github.com/weaveworks/weave-gitops/cmd/internal"
+	"github.com/weaveworks/weave-gitops/pkg/printer"
+	"github.com/weaveworks/weave-gitops/pkg/services/auth"
 	"github.com/weaveworks/weave-gitops/pkg/services/check"
 	"github.com/weaveworks/weave-gitops/pkg/version"
 
@@ -14,10 +18,12 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"k8s.io/cli-runtime/pkg/printers"
 )
 
 var (
-	pre bool
+	pre  bool
+	post bool
 )
 
 var Cmd = &cobra.Command{
@@ -26,18 +32,27 @@ var Cmd = &cobra.Command{
 	Example: `
 # Validate flux and kubernetes compatibility
 gitops check --pre
+
+# Validate that gitops is installed, that its config repository is reconciled and that the flux controllers and the gitops components are healthy
+gitops check --post
+
+# Print the results of the post-installation checks as json
+gitops check --post -o json
 `,
 	RunE: runCmd,
 }
 
 func init() {
 	Cmd.Flags().BoolVarP(&pre, "pre", "p", true, "perform only the pre-installation checks")
+	Cmd.Flags().BoolVar(&post, "post", false, "perform only the post-installation checks")
+	internal.AddOutputFlag(Cmd)
 }
 
-func runCmd(_ *cobra.Command, _ []string) error {
+func runCmd(cmd *cobra.Command, _ []string) error {
 	ctx := context.Background()
 
 	fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+	fluxClient.SetupBin()
 
 	rest, clusterName, err := kube.RestConfig()
 	if err != nil {
@@ -53,6 +68,10 @@ func runCmd(_ *cobra.Command, _ []string) error {
 		Client: k8sClient,
 	}
 
+	if post {
+		return runPost(ctx, cmd, kubeClient)
+	}
+
 	output, err := check.Pre(ctx, kubeClient, fluxClient, version.FluxVersion)
 	if err != nil {
 		return err
@@ -62,3 +81,49 @@ func runCmd(_ *cobra.Command, _ []string) error {
 
 	return nil
 }
+
+func runPost(ctx context.Context, cmd *cobra.Command, kubeClient kube.Kube) error {
+	p, err := internal.GetPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
+	namespace, _ := cmd.Parent().Flags().GetString("namespace")
+
+	// keep the output parseable when the auth flow prints instructions
+	out := os.Stdout
+	if !p.IsTable() {
+		out = os.Stderr
+	}
+
+	providerClient := internal.NewGitProviderClient(out, os.LookupEnv, auth.NewAuthCLIHandler, internal.NewCLILogger(out))
+
+	results, err := check.Post(ctx, kubeClient, providerClient, namespace)
+	if err != nil {
+		return err
+	}
+
+	table := printer.Table{
+		Columns: []printer.Column{{Header: "STATUS"}, {Header: "CHECK"}, {Header: "MESSAGE"}},
+	}
+
+	for _, r := range results {
+		table.Rows = append(table.Rows, []string{r.Status.Symbol(), r.Check, r.Message})
+	}
+
+	w := printers.GetNewTabWriter(os.Stdout)
+
+	if err := p.Print(w, results, table); err != nil {
+		return err
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if results.Failed() {
+		return check.ErrNotHealthy
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package create
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/create/secret"
+)
+
+func GetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a Weave GitOps resource",
+		Example: `
+# Create an age key to decrypt the SOPS encrypted manifests of applications
+gitops create secret sops-key`,
+	}
+
+	cmd.AddCommand(secret.Cmd)
+
+	return cmd
+}
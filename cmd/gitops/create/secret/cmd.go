@@ -0,0 +1,18 @@
+package secret
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/create/secret/sopskey"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "secret",
+	Short: "Create a secret used by gitops applications",
+	Example: `
+# Create an age key to decrypt the SOPS encrypted manifests of applications
+gitops create secret sops-key`,
+}
+
+func init() {
+	Cmd.AddCommand(sopskey.Cmd)
+}
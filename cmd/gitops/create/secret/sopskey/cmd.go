@@ -0,0 +1,82 @@
+package sopskey
+
+// Provides support for creating the key used to decrypt SOPS encrypted manifests.
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lithammer/dedent"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
+	"github.com/weaveworks/weave-gitops/cmd/internal"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/services/sops"
+)
+
+const DefaultSecretName = "sops-age"
+
+var secretName string
+
+var Cmd = &cobra.Command{
+	Use:   "sops-key",
+	Short: "Create an age key to decrypt SOPS encrypted manifests",
+	Long: strings.TrimSpace(dedent.Dedent(`
+        Generates an age key and stores it as a secret in the gitops namespace, then prints the public key
+        to encrypt secrets with. Applications added with '--decryption-provider sops --decryption-secret <name>'
+        are decrypted with the key before being applied.
+    `)),
+	Example: `
+  # Create the sops-age secret in the wego-system namespace
+  gitops create secret sops-key
+
+  # Create a key in a secret with another name
+  gitops create secret sops-key --name team-a-sops
+`,
+	Args:          cobra.NoArgs,
+	RunE:          runCmd,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PostRun: func(cmd *cobra.Command, args []string) {
+		version.CheckVersion(version.CheckpointParamsWithFlags(version.CheckpointParams(), cmd))
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVar(&secretName, "name", DefaultSecretName, "Name of the secret holding the key")
+}
+
+func runCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+
+	log := internal.NewCLILogger(os.Stdout)
+
+	kubeClient, _, err := kube.NewKubeHTTPClient()
+	if err != nil {
+		return fmt.Errorf("failed to create kube client: %w", err)
+	}
+
+	log.Actionf("Creating secret %s in namespace %s", secretName, namespace)
+
+	key, err := sops.CreateAgeKeySecret(ctx, kubeClient, secretName, namespace)
+	if err != nil {
+		return err
+	}
+
+	log.Successf("Created the age key with public key %s", key.Recipient)
+	log.Println(`
+To encrypt the secrets of an application with the key, add this rule to the .sops.yaml file of its repository:
+
+creation_rules:
+  - path_regex: .*.yaml
+    encrypted_regex: ^(data|stringData)$
+    age: %s
+
+Then add the application with '--decryption-provider sops --decryption-secret %s'`, key.Recipient, secretName)
+
+	return nil
+}
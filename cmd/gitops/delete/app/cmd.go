@@ -35,6 +35,12 @@ var Cmd = &cobra.Command{
 	Example: `
   # Delete application from gitops control via immediate commit
   gitops delete app podinfo
+
+  # Delete application from the prod-eu cluster only, keeping it on the other clusters
+  gitops delete app podinfo --cluster prod-eu
+
+  # Delete the cert-manager application even though other applications depend on it
+  gitops delete app cert-manager --force
 `,
 	Args:          cobra.MinimumNArgs(1),
 	RunE:          runCmd,
@@ -48,6 +54,9 @@ var Cmd = &cobra.Command{
 func init() {
 	Cmd.Flags().BoolVar(&params.DryRun, "dry-run", false, "If set, 'gitops delete app' will not make any changes to the system; it will just display the actions that would have been taken")
 	Cmd.Flags().BoolVar(&params.AutoMerge, "auto-merge", false, "If set, 'gitops delete app' will merge changes automatically to the config repository")
+	internal.AddPullRequestOptionsFlags(Cmd, &params.PullRequestOptions)
+	Cmd.Flags().BoolVar(&params.Force, "force", false, "Delete the application even if other applications depend on it")
+	Cmd.Flags().StringSliceVar(&params.Clusters, "cluster", nil, "Name of a cluster in the config repository to delete the application from; can be repeated. Defaults to the cluster of the current kube context")
 }
 
 func runCmd(cmd *cobra.Command, args []string) error {
@@ -76,7 +85,25 @@ func runCmd(cmd *cobra.Command, args []string) error {
 
 	providerClient := internal.NewGitProviderClient(os.Stdout, os.LookupEnv, auth.NewAuthCLIHandler, log)
 
-	gitClient, gitProvider, err := factory.GetGitClients(ctx, kubeClient, providerClient, services.NewGitConfigParamsFromApp(appContent, params.DryRun))
+	signer, err := internal.GetSigner(ctx, kubeClient, params.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get commit signer: %w", err)
+	}
+
+	author, err := internal.GetCommitAuthor()
+	if err != nil {
+		return err
+	}
+
+	if params.CommitTemplates, err = internal.GetCommitTemplates(); err != nil {
+		return err
+	}
+
+	gitConfigParams := services.NewGitConfigParamsFromApp(appContent, params.DryRun)
+	gitConfigParams.Signer = signer
+	gitConfigParams.Author = author
+
+	gitClient, gitProvider, err := factory.GetGitClients(ctx, kubeClient, providerClient, gitConfigParams)
 	if err != nil {
 		return fmt.Errorf("failed to get git clients: %w", err)
 	}
@@ -1,6 +1,7 @@
 package flux
 
 import (
+	"context"
 	"fmt"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
 	"os"
@@ -8,7 +9,9 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
+	"github.com/weaveworks/weave-gitops/pkg/services/check"
 )
 
 var Cmd = &cobra.Command{
@@ -21,7 +24,7 @@ var Cmd = &cobra.Command{
 
 var StatusCmd = &cobra.Command{
 	Use:   "status",
-	Short: "Check the last known status of flux namespaces",
+	Short: "Check the health of the flux controllers",
 	Run:   runStatusCmd,
 }
 
@@ -33,6 +36,7 @@ func init() {
 func runCmd(cmd *cobra.Command, args []string) {
 	cliRunner := &runner.CLIRunner{}
 	fluxClient := flux.New(osys.New(), cliRunner)
+	fluxClient.SetupBin()
 
 	exePath, err := fluxClient.GetExePath()
 	if err != nil {
@@ -50,14 +54,29 @@ func runCmd(cmd *cobra.Command, args []string) {
 }
 
 func runStatusCmd(cmd *cobra.Command, args []string) {
-	cliRunner := &runner.CLIRunner{}
-	fluxClient := flux.New(osys.New(), cliRunner)
+	kubeClient, _, err := kube.NewKubeHTTPClient()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	namespace, _ := cmd.Parent().Parent().Flags().GetString("namespace")
 
-	status, err := fluxClient.GetLatestStatusAllNamespaces()
+	report, err := check.Health(context.Background(), kubeClient, namespace)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
 
-	fmt.Printf("Status: %s\n", status)
+	for _, c := range report.Components {
+		if c.PartOf != check.PartOfFlux {
+			continue
+		}
+
+		if c.Ready {
+			fmt.Printf("%s: ready (%s, %d restarts)\n", c.Name, c.Version, c.Restarts)
+		} else {
+			fmt.Printf("%s: not ready (%s)\n", c.Name, c.Message)
+		}
+	}
 }
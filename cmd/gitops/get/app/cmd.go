@@ -4,16 +4,26 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/spf13/cobra"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/cmd/internal"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/models"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
+	"github.com/weaveworks/weave-gitops/pkg/printer"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
 	"github.com/weaveworks/weave-gitops/pkg/services"
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
 	"github.com/weaveworks/weave-gitops/pkg/services/applicationv2"
+	"github.com/weaveworks/weave-gitops/pkg/services/automation"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/printers"
 )
 
 var Cmd = &cobra.Command{
@@ -29,16 +39,34 @@ gitops get apps
 
 # Get status of an application under gitops control
 gitops get app <app-name>
+
+# Get the deployment type, source and path of all applications
+gitops get apps -o wide
+
+# Get the deployment type, source, path and status of an application
+gitops get app <app-name> -o wide
+
+# Get an application as yaml
+gitops get app <app-name> -o yaml
 `,
 	RunE: runCmd,
 }
 
 func runCmd(cmd *cobra.Command, args []string) error {
+	p, err := internal.GetPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
 	if len(args) == 1 {
-		return getApplicationStatus(cmd, args)
+		if p.IsTable() && !p.IsWide() {
+			return getApplicationStatus(cmd, args)
+		}
+
+		return getApplication(cmd, args[0], p)
 	}
 
-	return getApplications(cmd)
+	return getApplications(cmd, p)
 }
 
 func getApplicationStatus(cmd *cobra.Command, args []string) error {
@@ -63,18 +91,59 @@ func getApplicationStatus(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create app service: %w", err)
 	}
 
-	fluxOutput, lastSuccessReconciliation, err := appService.Status(params)
+	status, lastSuccessReconciliation, err := appService.Status(params)
 	if err != nil {
 		return fmt.Errorf("failed getting application status: %w", err)
 	}
 
 	log.Printf("Last successful reconciliation: %s\n\n", lastSuccessReconciliation)
-	log.Println(fluxOutput)
+	log.Println(status)
 
 	return nil
 }
 
-func getApplications(cmd *cobra.Command) error {
+func getApplication(cmd *cobra.Command, name string, p printer.Printer) error {
+	_, k8s, err := kube.NewKubeHTTPClient()
+	if err != nil {
+		return fmt.Errorf("error initializing kubernetes client: %w", err)
+	}
+
+	ns, err := cmd.Parent().Parent().Flags().GetString("namespace")
+	if err != nil {
+		return err
+	}
+
+	wegoApp := &wego.Application{}
+	if err := k8s.Get(cmd.Context(), types.NamespacedName{Name: name, Namespace: ns}, wegoApp); err != nil {
+		return fmt.Errorf("failed getting application: %w", err)
+	}
+
+	a, err := automation.WegoAppToApp(*wegoApp)
+	if err != nil {
+		return fmt.Errorf("failed getting application: %w", err)
+	}
+
+	application := toApplication(a)
+	application.Conditions = toConditions(wegoApp.Status.Conditions)
+	application.SourceRevision = wegoApp.Status.SourceRevision
+	application.LastAppliedRevision = wegoApp.Status.LastAppliedRevision
+	application.Suspended = wegoApp.Status.Suspended
+
+	if t := wegoApp.Status.LastReconcileTime; t != nil {
+		application.LastReconcileTime = int32(t.Unix())
+	}
+
+	table := applicationsTable()
+	table.Columns = append(table.Columns, applicationStatusColumns...)
+	table.Rows = append(table.Rows, append(applicationRow(a), applicationStatusRow(wegoApp)...))
+
+	w := printers.GetNewTabWriter(os.Stdout)
+	defer w.Flush()
+
+	return p.Print(w, &pb.GetApplicationResponse{Application: application}, table)
+}
+
+func getApplications(cmd *cobra.Command, p printer.Printer) error {
 	_, k8s, err := kube.NewKubeHTTPClient()
 	if err != nil {
 		return fmt.Errorf("error initializing kubernetes client: %w", err)
@@ -87,16 +156,97 @@ func getApplications(cmd *cobra.Command) error {
 		return err
 	}
 
-	fmt.Println("NAME")
-
 	apps, err := fetcher.List(cmd.Context(), ns)
 	if err != nil {
 		return err
 	}
 
-	for _, app := range apps {
-		fmt.Println(app.Name)
+	res := &pb.ListApplicationsResponse{Applications: []*pb.Application{}}
+	table := applicationsTable()
+
+	for _, a := range apps {
+		res.Applications = append(res.Applications, toApplication(a))
+		table.Rows = append(table.Rows, applicationRow(a))
 	}
 
-	return nil
+	w := printers.GetNewTabWriter(os.Stdout)
+	defer w.Flush()
+
+	return p.Print(w, res, table)
+}
+
+// applicationsTable lists the names of applications, and where they are deployed from in the wide format
+func applicationsTable() printer.Table {
+	return printer.Table{
+		Columns: []printer.Column{
+			{Header: "NAME"},
+			{Header: "NAMESPACE", Wide: true},
+			{Header: "DEPLOYMENT_TYPE", Wide: true},
+			{Header: "SOURCE", Wide: true},
+			{Header: "PATH", Wide: true},
+		},
+	}
+}
+
+func applicationRow(a models.Application) []string {
+	return []string{
+		a.Name,
+		a.Namespace,
+		string(a.AutomationType),
+		automation.AppToWegoApp(a).Spec.URL,
+		a.Path,
+	}
+}
+
+// applicationStatusColumns are the wide columns describing the status of an application, which is only read when
+// a single application is printed
+var applicationStatusColumns = []printer.Column{
+	{Header: "READY", Wide: true},
+	{Header: "STATUS", Wide: true},
+	{Header: "LAST_APPLIED_REVISION", Wide: true},
+	{Header: "SUSPENDED", Wide: true},
+}
+
+func applicationStatusRow(a *wego.Application) []string {
+	ready, message := "", ""
+
+	if c := apimeta.FindStatusCondition(a.Status.Conditions, wego.ReadyCondition); c != nil {
+		ready, message = string(c.Status), c.Message
+	}
+
+	return []string{ready, message, a.Status.LastAppliedRevision, strconv.FormatBool(a.Status.Suspended)}
+}
+
+// toConditions maps the conditions of an application to the ones the API returns for it
+func toConditions(conditions []metav1.Condition) []*pb.Condition {
+	out := []*pb.Condition{}
+
+	for _, c := range conditions {
+		out = append(out, &pb.Condition{
+			Type:      c.Type,
+			Status:    string(c.Status),
+			Reason:    c.Reason,
+			Message:   c.Message,
+			Timestamp: int32(c.LastTransitionTime.Unix()),
+		})
+	}
+
+	return out
+}
+
+// toApplication maps an application to the message the API returns for it
+func toApplication(a models.Application) *pb.Application {
+	deploymentType := pb.AutomationKind_Kustomize
+	if a.AutomationType == models.AutomationTypeHelm {
+		deploymentType = pb.AutomationKind_Helm
+	}
+
+	return &pb.Application{
+		Name:           a.Name,
+		Namespace:      a.Namespace,
+		Url:            automation.AppToWegoApp(a).Spec.URL,
+		Path:           a.Path,
+		DeploymentType: deploymentType,
+		DependsOn:      a.DependsOn,
+	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/go-resty/resty/v2"
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/internal"
 	"github.com/weaveworks/weave-gitops/pkg/adapters"
 	"github.com/weaveworks/weave-gitops/pkg/clusters"
 	"k8s.io/cli-runtime/pkg/printers"
@@ -31,7 +32,10 @@ gitops get clusters
 gitops get cluster <cluster-name>
 
 # Get the Kubeconfig of a cluster
-gitops get cluster <cluster-name> --kubeconfig`,
+gitops get cluster <cluster-name> --kubeconfig
+
+# Get all CAPI clusters as json
+gitops get clusters -o json`,
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		PreRunE:       getClusterCmdPreRunE(endpoint, client),
@@ -60,6 +64,11 @@ func getClusterCmdRunE(endpoint *string, client *resty.Client) func(*cobra.Comma
 			return err
 		}
 
+		p, err := internal.GetPrinter(cmd)
+		if err != nil {
+			return err
+		}
+
 		w := printers.GetNewTabWriter(os.Stdout)
 
 		defer w.Flush()
@@ -73,9 +82,9 @@ func getClusterCmdRunE(endpoint *string, client *resty.Client) func(*cobra.Comma
 		}
 
 		if len(args) == 1 {
-			return clusters.GetClusterByName(args[0], r, w)
+			return clusters.GetClusterByName(args[0], r, w, p)
 		}
 
-		return clusters.GetClusters(r, w)
+		return clusters.GetClusters(r, w, p)
 	}
 }
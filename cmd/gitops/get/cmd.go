@@ -9,6 +9,7 @@ import (
 	"github.com/weaveworks/weave-gitops/cmd/gitops/get/credentials"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/get/profiles"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/get/templates"
+	"github.com/weaveworks/weave-gitops/cmd/internal"
 )
 
 func GetCommand(endpoint *string, client *resty.Client) *cobra.Command {
@@ -29,9 +30,17 @@ gitops get templates
 gitops get credentials
 
 # Get all CAPI clusters
-gitops get clusters`,
+gitops get clusters
+
+# Get all applications under gitops control as json
+gitops get apps -o json
+
+# Get the URLs of the last commits of an application
+gitops get commits <app-name> -o jsonpath='{.commits[*].url}'`,
 	}
 
+	internal.AddOutputFlag(cmd)
+
 	cmd.AddCommand(app.Cmd)
 	cmd.AddCommand(commits.Cmd)
 	cmd.AddCommand(templates.TemplateCommand(endpoint, client))
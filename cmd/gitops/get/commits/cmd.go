@@ -3,16 +3,20 @@ package commits
 import (
 	"context"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
+	"strconv"
 
-	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/internal"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
-	"github.com/weaveworks/weave-gitops/pkg/logger"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
+	"github.com/weaveworks/weave-gitops/pkg/printer"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
 	"github.com/weaveworks/weave-gitops/pkg/services"
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
@@ -20,6 +24,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/utils"
 
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/printers"
 )
 
 var Cmd = &cobra.Command{
@@ -27,22 +32,59 @@ var Cmd = &cobra.Command{
 	Short: "Get most recent commits for an application",
 	Example: `
 # Get last 10 commits for an application
-gitops get commits <app-name>`,
+gitops get commits <app-name>
+
+# Get last 10 commits for an application with their full hashes
+gitops get commits <app-name> -o wide
+
+# Get last 10 commits for an application and its automation in the config repository
+gitops get commits <app-name> --include-automation -o wide
+
+# Get last 10 commits for an application and verify their signatures with a set of trusted GPG and SSH public keys
+gitops get commits <app-name> --verify-signatures trusted-keys.txt`,
 	SilenceUsage:  true,
 	SilenceErrors: true,
 	Args:          cobra.ExactArgs(1),
 	RunE:          runCmd,
 }
 
+var (
+	includeAutomation bool
+	verifySignatures  string
+)
+
+func init() {
+	Cmd.Flags().BoolVar(&includeAutomation, "include-automation", false, "Include the commits to the automation of the application in the config repository")
+	Cmd.Flags().StringVar(&verifySignatures, "verify-signatures", "", "A file of the GPG and SSH public keys the commits are trusted to be signed with")
+}
+
 func runCmd(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
+	p, err := internal.GetPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
 	params := app.CommitParams{}
 	params.Name = args[0]
 	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
 	// Hardcode PageSize and PageToken until there is a plan around pagination for cli
 	params.PageSize = 10
 	params.PageToken = 0
+	params.IncludeAutomation = includeAutomation
+
+	if verifySignatures != "" {
+		publicKeys, err := ioutil.ReadFile(verifySignatures)
+		if err != nil {
+			return fmt.Errorf("failed reading public keys: %w", err)
+		}
+
+		params.Verifier, err = git.NewVerifier(publicKeys)
+		if err != nil {
+			return fmt.Errorf("failed reading public keys: %w", err)
+		}
+	}
 
 	log := internal.NewCLILogger(os.Stdout)
 	fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
@@ -70,30 +112,78 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get git clients: %w", err)
 	}
 
-	commits, err := appService.GetCommits(gitProvider, params, appContent)
+	history, err := appService.GetCommitHistory(gitProvider, params, appContent)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get commits for app %s", params.Name)
 	}
 
-	printCommitTable(log, commits)
+	w := printers.GetNewTabWriter(os.Stdout)
+	defer w.Flush()
+
+	return printCommits(w, p, history, params.Verifier != nil)
+}
+
+func printCommits(w io.Writer, p printer.Printer, history app.CommitHistory, showSignatures bool) error {
+	res := &pb.ListCommitsResponse{
+		Commits:                       []*pb.Commit{},
+		LastAppliedRevision:           history.SourceRevision,
+		AutomationLastAppliedRevision: history.AutomationRevision,
+	}
+
+	for _, c := range history.Commits {
+		res.Commits = append(res.Commits, &pb.Commit{
+			Hash:       utils.ConvertCommitHashToShort(c.Sha),
+			Date:       utils.CleanCommitCreatedAt(c.CreatedAt),
+			Author:     c.Author,
+			Message:    utils.CleanCommitMessage(c.Message),
+			Url:        utils.ConvertCommitURLToShort(c.URL),
+			Repository: c.Repository,
+			Applied:    c.Applied,
+			Signature:  c.Signature,
+		})
+	}
 
-	return nil
+	return p.Print(w, res, commitsTable(history, showSignatures))
 }
 
-func printCommitTable(log logger.Logger, commits []gitprovider.Commit) {
-	header := []string{"Commit Hash", "Created At", "Author", "Message", "URL"}
-	rows := [][]string{}
+// commitsTable lists the commits in the table the command always printed, the wide format adds
+// the full hashes, the repositories and whether the commits are applied
+func commitsTable(history app.CommitHistory, showSignatures bool) printer.Table {
+	table := printer.Table{
+		Columns: []printer.Column{
+			{Header: "COMMIT HASH"},
+			{Header: "CREATED AT"},
+			{Header: "AUTHOR"},
+			{Header: "MESSAGE"},
+			{Header: "URL"},
+			{Header: "FULL HASH", Wide: true},
+			{Header: "REPOSITORY", Wide: true},
+			{Header: "APPLIED", Wide: true},
+		},
+	}
+
+	if showSignatures {
+		table.Columns = append(table.Columns, printer.Column{Header: "SIGNATURE"})
+	}
 
-	for _, commit := range commits {
-		c := commit.Get()
-		rows = append(rows, []string{
+	for _, c := range history.Commits {
+		row := []string{
 			utils.ConvertCommitHashToShort(c.Sha),
 			utils.CleanCommitCreatedAt(c.CreatedAt),
 			c.Author,
 			utils.CleanCommitMessage(c.Message),
 			utils.ConvertCommitURLToShort(c.URL),
-		})
+			c.Sha,
+			c.Repository,
+			strconv.FormatBool(c.Applied),
+		}
+
+		if showSignatures {
+			row = append(row, c.Signature)
+		}
+
+		table.Rows = append(table.Rows, row)
 	}
 
-	utils.PrintTable(log, header, rows)
+	return table
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/go-resty/resty/v2"
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/internal"
 	"github.com/weaveworks/weave-gitops/pkg/adapters"
 	"github.com/weaveworks/weave-gitops/pkg/capi"
 	"k8s.io/cli-runtime/pkg/printers"
@@ -19,6 +20,9 @@ func CredentialCommand(endpoint *string, client *resty.Client) *cobra.Command {
 		Example: `
 # Get all CAPI credentials
 gitops get credentials
+
+# Get the names of all CAPI credentials
+gitops get credentials -o jsonpath='{[*].name}'
 		`,
 		SilenceUsage:  true,
 		SilenceErrors: true,
@@ -46,9 +50,14 @@ func getCredentialCmdRunE(endpoint *string, client *resty.Client) func(*cobra.Co
 			return err
 		}
 
+		p, err := internal.GetPrinter(cmd)
+		if err != nil {
+			return err
+		}
+
 		w := printers.GetNewTabWriter(os.Stdout)
 		defer w.Flush()
 
-		return capi.GetCredentials(r, w)
+		return capi.GetCredentials(r, w, p)
 	}
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/weaveworks/weave-gitops/cmd/internal"
 	"github.com/weaveworks/weave-gitops/pkg/server"
 	"github.com/weaveworks/weave-gitops/pkg/services/profiles"
+	"k8s.io/cli-runtime/pkg/printers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
@@ -29,6 +30,9 @@ var Cmd = &cobra.Command{
 	Example: `
 # Get all profiles
 gitops get profiles
+
+# Get all profiles with their helm repository
+gitops get profiles -o wide
 `,
 	RunE: runCmd,
 }
@@ -38,6 +42,11 @@ func init() {
 }
 
 func runCmd(cmd *cobra.Command, args []string) error {
+	p, err := internal.GetPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
 	config, err := clientcmd.BuildConfigFromFlags("", filepath.Join(homedir.HomeDir(), ".kube", "config"))
 	if err != nil {
 		return fmt.Errorf("error initializing kubernetes config: %w", err)
@@ -53,9 +62,13 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	w := printers.GetNewTabWriter(os.Stdout)
+	defer w.Flush()
+
 	return profiles.NewService(clientSet, internal.NewCLILogger(os.Stdout)).Get(context.Background(), profiles.GetOptions{
 		Namespace: ns,
-		Writer:    os.Stdout,
+		Writer:    w,
+		Printer:   p,
 		Port:      port,
 	})
 }
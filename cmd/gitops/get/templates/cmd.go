@@ -9,6 +9,7 @@ import (
 	"github.com/go-resty/resty/v2"
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/internal"
 	"github.com/weaveworks/weave-gitops/pkg/adapters"
 	"github.com/weaveworks/weave-gitops/pkg/capi"
 	"k8s.io/cli-runtime/pkg/printers"
@@ -46,6 +47,9 @@ gitops get templates --provider aws
 
 # Show the parameters of a CAPI template
 gitops get template <template-name> --list-parameters
+
+# Get all CAPI templates as yaml
+gitops get templates -o yaml
 		`,
 		SilenceUsage:  true,
 		SilenceErrors: true,
@@ -82,6 +86,11 @@ func getTemplateCmdRunE(endpoint *string, client *resty.Client) func(*cobra.Comm
 			return err
 		}
 
+		p, err := internal.GetPrinter(cmd)
+		if err != nil {
+			return err
+		}
+
 		w := printers.GetNewTabWriter(os.Stdout)
 		defer w.Flush()
 
@@ -90,7 +99,7 @@ func getTemplateCmdRunE(endpoint *string, client *resty.Client) func(*cobra.Comm
 				return errors.New("template name is required")
 			}
 
-			return capi.GetTemplateParameters(args[0], r, w)
+			return capi.GetTemplateParameters(args[0], r, w, p)
 		}
 
 		if flags.ListTemplateProfiles {
@@ -98,15 +107,15 @@ func getTemplateCmdRunE(endpoint *string, client *resty.Client) func(*cobra.Comm
 				return errors.New("template name is required")
 			}
 
-			return capi.GetTemplateProfiles(args[0], r, w)
+			return capi.GetTemplateProfiles(args[0], r, w, p)
 		}
 
 		if len(args) == 0 {
 			if flags.Provider != "" {
-				return capi.GetTemplatesByProvider(flags.Provider, r, w)
+				return capi.GetTemplatesByProvider(flags.Provider, r, w, p)
 			}
 
-			return capi.GetTemplates(r, w)
+			return capi.GetTemplates(r, w, p)
 		}
 
 		return nil
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
 	"github.com/weaveworks/weave-gitops/cmd/internal"
@@ -29,9 +30,10 @@ import (
 )
 
 type params struct {
-	DryRun     bool
-	AutoMerge  bool
-	ConfigRepo string
+	DryRun             bool
+	AutoMerge          bool
+	ConfigRepo         string
+	PullRequestOptions gitproviders.PullRequestOptions
 }
 
 var (
@@ -58,6 +60,7 @@ func init() {
 	Cmd.Flags().BoolVar(&installParams.DryRun, "dry-run", false, "Outputs all the manifests that would be installed")
 	Cmd.Flags().BoolVar(&installParams.AutoMerge, "auto-merge", false, "If set, 'gitops install' will automatically update the default branch for the configuration repository")
 	Cmd.Flags().StringVar(&installParams.ConfigRepo, "config-repo", "", "URL of external repository that will hold automation manifests")
+	internal.AddPullRequestOptionsFlags(Cmd, &installParams.PullRequestOptions)
 	cobra.CheckErr(Cmd.MarkFlagRequired("config-repo"))
 }
 
@@ -72,6 +75,7 @@ func installRunCmd(cmd *cobra.Command, args []string) error {
 
 	osysClient := osys.New()
 	fluxClient := flux.New(osysClient, &runner.CLIRunner{})
+	fluxClient.SetupBin()
 
 	kubeClient, rawK8sClient, err := kube.NewKubeHTTPClient()
 	if err != nil {
@@ -91,19 +95,33 @@ func installRunCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	gitProvider, err := gitproviders.New(gitproviders.Config{
-		Provider: configURL.Provider(),
-		Token:    token,
-		Hostname: configURL.URL().Host,
+		Provider:       configURL.Provider(),
+		Token:          token,
+		Hostname:       configURL.URL().Host,
+		PrivateKeyFile: viper.GetString("git-private-key-file"),
 	}, configURL.Owner(), gitproviders.GetAccountType)
 	if err != nil {
 		return fmt.Errorf("error creating git provider client: %w", err)
 	}
 
+	author, err := internal.GetCommitAuthor()
+	if err != nil {
+		return err
+	}
+
+	templates, err := internal.GetCommitTemplates()
+	if err != nil {
+		return err
+	}
+
 	if installParams.DryRun {
 		manifests, err := models.BootstrapManifests(ctx, fluxClient, gitProvider, kubeClient, models.ManifestsParams{
-			ClusterName:   clusterName,
-			WegoNamespace: namespace,
-			ConfigRepo:    configURL,
+			ClusterName:      clusterName,
+			WegoNamespace:    namespace,
+			ConfigRepo:       configURL,
+			SigningKeySecret: viper.GetString("signing-key-secret"),
+			CommitAuthor:     author,
+			CommitTemplates:  templates,
 		})
 		if err != nil {
 			return fmt.Errorf("failed getting gitops manifests: %w", err)
@@ -135,12 +153,32 @@ func installRunCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	gitClient := git.New(deployKey, wrapper.NewGoGit())
+	signer, err := internal.GetSigner(ctx, kubeClient, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get commit signer: %w", err)
+	}
+
+	gitOpts := []git.Option{git.WithAuthor(author)}
+	if signer != nil {
+		gitOpts = append(gitOpts, git.WithSigner(signer))
+	}
+
+	gitClient := git.New(deployKey, wrapper.NewGoGit(), gitOpts...)
+
+	// the providers without an API make the commits of the pull requests like the git client does
+	if c, ok := gitProvider.(gitproviders.CommitterProvider); ok {
+		gitProvider = c.WithCommitter(author, signer)
+	}
 
 	repoWriter := gitopswriter.NewRepoWriter(log, gitClient, gitProvider)
 	installer := install.NewInstaller(fluxClient, kubeClient, gitClient, gitProvider, log, repoWriter)
 
-	if err = installer.Install(namespace, configURL, installParams.AutoMerge); err != nil {
+	if err = installer.Install(namespace, configURL, installParams.AutoMerge, install.Options{
+		SigningKeySecret:   viper.GetString("signing-key-secret"),
+		CommitAuthor:       author,
+		CommitTemplates:    templates,
+		PullRequestOptions: installParams.PullRequestOptions,
+	}); err != nil {
 		return fmt.Errorf("failed installing: %w", err)
 	}
 
@@ -0,0 +1,62 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/internal"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/services/logs"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+var params logs.Options
+
+var Cmd = &cobra.Command{
+	Use:           "app <app-name>",
+	Short:         "Print the logs of the pods of an application",
+	Args:          cobra.ExactArgs(1),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Example: `
+# Print the logs of the containers of the pods of podinfo
+gitops logs app podinfo
+
+# Follow the last 20 lines of the logs of the podinfo container
+gitops logs app podinfo --container podinfo --tail 20 --follow`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		namespace, _ := cmd.Parent().Parent().Flags().GetString("namespace")
+
+		config, contextName, err := kube.RestConfig()
+		if err != nil {
+			return fmt.Errorf("failed to create kube config: %w", err)
+		}
+
+		kubeClient, _, err := kube.NewKubeHTTPClientWithConfig(config, contextName)
+		if err != nil {
+			return fmt.Errorf("failed to create kube client: %w", err)
+		}
+
+		clientset, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			return fmt.Errorf("failed to create kube clientset: %w", err)
+		}
+
+		log := internal.NewCLILogger(os.Stdout)
+
+		return logs.ApplicationLogs(context.Background(), kubeClient, clientset, types.NamespacedName{Name: args[0], Namespace: namespace}, params, func(line logs.Line) error {
+			log.Println("[%s/%s] %s", line.Pod, line.Container, line.Text)
+			return nil
+		})
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVar(&params.Pod, "pod", "", "Only print the logs of this pod")
+	Cmd.Flags().StringVarP(&params.Container, "container", "c", "", "Only print the logs of this container")
+	Cmd.Flags().BoolVarP(&params.Follow, "follow", "f", false, "Keep printing the logs as they are written")
+	Cmd.Flags().Int64Var(&params.TailLines, "tail", 0, "The number of lines to print from the end of the logs of each container, all the lines when 0")
+}
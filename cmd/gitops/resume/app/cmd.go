@@ -39,7 +39,10 @@ func runCmd(cmd *cobra.Command, args []string) error {
 	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
 	params.Name = args[0]
 
-	appFactory := services.NewFactory(flux.New(osys.New(), &runner.CLIRunner{}), internal.NewCLILogger(os.Stdout))
+	fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+	fluxClient.SetupBin()
+
+	appFactory := services.NewFactory(fluxClient, internal.NewCLILogger(os.Stdout))
 
 	kubeClient, _, err := kube.NewKubeHTTPClient()
 	if err != nil {
@@ -14,22 +14,22 @@ import (
 	"github.com/spf13/viper"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/add"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/app"
 	beta "github.com/weaveworks/weave-gitops/cmd/gitops/beta/cmd"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/create"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/delete"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/docs"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/flux"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/get"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/install"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/logs"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/resume"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/suspend"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/ui"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/uninstall"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/upgrade"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
-	fluxBin "github.com/weaveworks/weave-gitops/pkg/flux"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
-	"github.com/weaveworks/weave-gitops/pkg/osys"
-	"github.com/weaveworks/weave-gitops/pkg/runner"
 	"github.com/weaveworks/weave-gitops/pkg/utils"
 	"k8s.io/client-go/rest"
 )
@@ -53,11 +53,6 @@ func init() {
 }
 
 func RootCmd(client *resty.Client) *cobra.Command {
-	cliRunner := &runner.CLIRunner{}
-	osysClient := osys.New()
-	fluxClient := fluxBin.New(osysClient, cliRunner)
-	fluxClient.SetupBin()
-
 	var rootCmd = &cobra.Command{
 		Use:           "gitops",
 		SilenceUsage:  true,
@@ -129,7 +124,15 @@ func RootCmd(client *resty.Client) *cobra.Command {
 	rootCmd.PersistentFlags().String("namespace", wego.DefaultNamespace, "The namespace scope for this operation")
 	rootCmd.PersistentFlags().StringVarP(&options.endpoint, "endpoint", "e", os.Getenv("WEAVE_GITOPS_ENTERPRISE_API_URL"), "The Weave GitOps Enterprise HTTP API endpoint")
 	rootCmd.PersistentFlags().BoolVar(&options.overrideInCluster, "override-in-cluster", false, "override running in cluster check")
-	rootCmd.PersistentFlags().StringToStringVar(&options.gitHostTypes, "git-host-types", map[string]string{}, "Specify which custom domains are running what (github or gitlab)")
+	rootCmd.PersistentFlags().StringToStringVar(&options.gitHostTypes, "git-host-types", map[string]string{}, "Specify which custom domains are running what (github, gitlab, bitbucket-server, gitea or git)")
+	rootCmd.PersistentFlags().String("git-private-key-file", "", "The SSH private key the repositories of the plain git servers are accessed with")
+	rootCmd.PersistentFlags().String("signing-key-file", "", "The GPG or SSH private key the commits of gitops are signed with, its passphrase is read from GITOPS_SIGNING_KEY_PASSPHRASE")
+	rootCmd.PersistentFlags().String("signing-key-secret", "", "The secret, in the namespace of gitops, holding the GPG or SSH private key the commits of gitops are signed with")
+	rootCmd.PersistentFlags().String("commit-author-name", "", "The name of the author of the commits of gitops, the one saved by 'gitops install' or Weave Gitops by default")
+	rootCmd.PersistentFlags().String("commit-author-email", "", "The email of the author of the commits of gitops, set with --commit-author-name")
+	rootCmd.PersistentFlags().String("commit-message-template", "", "The Go template of the commit messages of gitops, executed with the .Action, .Default, .App, .Clusters and .Profile of the commit")
+	rootCmd.PersistentFlags().String("pull-request-title-template", "", "The Go template of the titles of the pull requests of gitops, executed with the data of --commit-message-template")
+	rootCmd.PersistentFlags().String("pull-request-body-template", "", "The Go template of the bodies of the pull requests of gitops, executed with the data of --commit-message-template")
 	cobra.CheckErr(rootCmd.PersistentFlags().MarkHidden("override-in-cluster"))
 	cobra.CheckErr(rootCmd.PersistentFlags().MarkHidden("git-host-types"))
 
@@ -140,7 +143,10 @@ func RootCmd(client *resty.Client) *cobra.Command {
 	rootCmd.AddCommand(flux.Cmd)
 	rootCmd.AddCommand(ui.NewCommand())
 	rootCmd.AddCommand(get.GetCommand(&options.endpoint, client))
+	rootCmd.AddCommand(app.GetCommand())
+	rootCmd.AddCommand(logs.GetCommand())
 	rootCmd.AddCommand(add.GetCommand(&options.endpoint, client))
+	rootCmd.AddCommand(create.GetCommand())
 	rootCmd.AddCommand(update.UpdateCommand(&options.endpoint, client))
 	rootCmd.AddCommand(delete.DeleteCommand(&options.endpoint, client))
 	rootCmd.AddCommand(resume.GetCommand())
@@ -40,6 +40,7 @@ func runCmd(cmd *cobra.Command, args []string) error {
 	params.Name = args[0]
 
 	fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+	fluxClient.SetupBin()
 	factory := services.NewFactory(fluxClient, internal.NewCLILogger(os.Stdout))
 
 	kubeClient, _, err := kube.NewKubeHTTPClient()
@@ -22,11 +22,13 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	appwatcher "github.com/weaveworks/weave-gitops/pkg/app/watcher"
 	"github.com/weaveworks/weave-gitops/pkg/helm/watcher"
 	"github.com/weaveworks/weave-gitops/pkg/helm/watcher/cache"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/server"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"github.com/weaveworks/weave-gitops/pkg/tracing"
 )
 
 // Options contains all the options for the `ui run` command.
@@ -42,6 +44,7 @@ type Options struct {
 	LoggingEnabled                bool
 	OIDC                          OIDCAuthenticationOptions
 	NotificationControllerAddress string
+	Tracing                       tracing.Options
 }
 
 // OIDCAuthenticationOptions contains the OIDC authentication options for the
@@ -74,9 +77,12 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().StringVar(&options.HelmRepoName, "helm-repo-name", "weaveworks-charts", "the name of the Helm Repository resource to scan for profiles")
 	cmd.Flags().StringVar(&options.ProfileCacheLocation, "profile-cache-location", "/tmp/helm-cache", "the location where the cache Profile data lives")
 	cmd.Flags().StringVar(&options.WatcherHealthzBindAddress, "watcher-healthz-bind-address", ":9981", "bind address for the healthz service of the watcher")
-	cmd.Flags().StringVar(&options.WatcherMetricsBindAddress, "watcher-metrics-bind-address", ":9980", "bind address for the metrics service of the watcher")
+	cmd.Flags().StringVar(&options.WatcherMetricsBindAddress, "watcher-metrics-bind-address", ":9980", "bind address for the metrics of the server and of the watchers")
 	cmd.Flags().StringVar(&options.NotificationControllerAddress, "notification-controller-address", "", "the address of the notification-controller running in the cluster")
 	cmd.Flags().IntVar(&options.WatcherPort, "watcher-port", 9443, "the port on which the watcher is running")
+	cmd.Flags().StringVar(&options.Tracing.Exporter, "tracing-exporter", tracing.ExporterNone, "exporter of the traces of the requests, one of none or otlp")
+	cmd.Flags().StringVar(&options.Tracing.Endpoint, "otlp-endpoint", "", "address of the OpenTelemetry collector the otlp exporter sends the traces to, defaults to $OTEL_EXPORTER_OTLP_ENDPOINT or localhost:4317")
+	cmd.Flags().BoolVar(&options.Tracing.Insecure, "otlp-insecure", false, "connect to the OpenTelemetry collector without TLS")
 
 	if server.AuthEnabled() {
 		cmd.Flags().StringVar(&options.OIDC.IssuerURL, "oidc-issuer-url", "", "The URL of the OpenID Connect issuer")
@@ -157,8 +163,9 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create cacher: %w", err)
 	}
 
+	namespace, _ := cmd.Flags().GetString("namespace")
+
 	if options.NotificationControllerAddress == "" {
-		namespace, _ := cmd.Flags().GetString("namespace")
 		options.NotificationControllerAddress = fmt.Sprintf("http://notification-controller.%s.svc.cluster.local./", namespace)
 	}
 
@@ -181,6 +188,21 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
+	applicationWatcher, err := appwatcher.NewWatcher(appwatcher.Options{
+		LeaderElection:          true,
+		LeaderElectionNamespace: namespace,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start the application watcher: %w", err)
+	}
+
+	go func() {
+		if err := applicationWatcher.StartWatcher(context.Background()); err != nil {
+			log.Error(err, "failed to start application watcher")
+			os.Exit(1)
+		}
+	}()
+
 	profilesConfig := server.NewProfilesConfig(kube.ClusterConfig{
 		DefaultConfig: rest,
 		ClusterName:   clusterName,
@@ -225,6 +247,13 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		authServer = srv
 	}
 
+	options.Tracing.ServiceName = "gitops-ui"
+
+	shutdownTracing, err := tracing.Setup(context.Background(), options.Tracing)
+	if err != nil {
+		return fmt.Errorf("could not set up tracing: %w", err)
+	}
+
 	appAndProfilesHandlers, err := server.NewHandlers(context.Background(), &server.Config{AppConfig: appConfig, ProfilesConfig: profilesConfig, AuthServer: authServer})
 	if err != nil {
 		return fmt.Errorf("could not create handler: %w", err)
@@ -285,6 +314,10 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("Server Shutdown Failed: %w", err)
 	}
 
+	if err := shutdownTracing(ctx); err != nil {
+		return fmt.Errorf("failed to flush traces: %w", err)
+	}
+
 	return nil
 }
 
@@ -69,6 +69,7 @@ func uninstallRunCmd(cmd *cobra.Command, args []string) error {
 
 	log := internal.NewCLILogger(os.Stdout)
 	fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+	fluxClient.SetupBin()
 
 	k, _, err := kube.NewKubeHTTPClient()
 	if err != nil {
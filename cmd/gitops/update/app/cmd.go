@@ -0,0 +1,131 @@
+package app
+
+// Provides support for changing an application under gitops management.
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/weaveworks/weave-gitops/cmd/internal"
+	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/osys"
+	"github.com/weaveworks/weave-gitops/pkg/runner"
+	"github.com/weaveworks/weave-gitops/pkg/services"
+	"github.com/weaveworks/weave-gitops/pkg/services/auth"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/lithammer/dedent"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
+)
+
+var (
+	params app.UpdateParams
+	prune  bool
+)
+
+var Cmd = &cobra.Command{
+	Use:   "app <app name>",
+	Short: "Update an app in a gitops cluster",
+	Long: strings.TrimSpace(dedent.Dedent(`
+        Changes the spec of an application managed via GitOps and regenerates its automation in the config repository,
+        without removing the application from the cluster
+    `)),
+	Example: `
+  # Watch a different branch of the podinfo application
+  gitops update app podinfo --branch dev
+
+  # Deploy a different chart version and reconcile it less often
+  gitops update app loki --chart loki --interval 10m --auto-merge
+
+  # Watch a different branch of the podinfo application on the prod-eu and prod-us clusters
+  gitops update app podinfo --branch dev --cluster prod-eu --cluster prod-us
+`,
+	Args:          cobra.ExactArgs(1),
+	RunE:          runCmd,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PostRun: func(cmd *cobra.Command, args []string) {
+		version.CheckVersion(version.CheckpointParamsWithFlags(version.CheckpointParams(), cmd))
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVar(&params.Branch, "branch", "", "Branch to watch within the git repository")
+	Cmd.Flags().StringVar(&params.Path, "path", "", "Path of files within the git repository")
+	Cmd.Flags().StringVar(&params.Chart, "chart", "", "Chart to deploy from the helm repository")
+	Cmd.Flags().StringVar(&params.HelmReleaseTargetNamespace, "helm-release-target-namespace", "", "Namespace in which to deploy the helm chart")
+	Cmd.Flags().DurationVar(&params.SourceInterval, "source-interval", 0, "How often the application source is fetched")
+	Cmd.Flags().DurationVar(&params.Interval, "interval", 0, "How often the application is reconciled")
+	Cmd.Flags().DurationVar(&params.Timeout, "timeout", 0, "Timeout for applying the application manifests")
+	Cmd.Flags().BoolVar(&prune, "prune", true, "Garbage collect the resources removed from the application; kustomize deployments only")
+	Cmd.Flags().StringSliceVar(&params.Clusters, "cluster", nil, "Name of a cluster in the config repository the application is updated in; can be repeated. Defaults to the cluster of the current kube context")
+	Cmd.Flags().BoolVar(&params.DryRun, "dry-run", false, "If set, 'gitops update app' will not make any changes to the system; it will just display the actions that would have been taken")
+	Cmd.Flags().BoolVar(&params.AutoMerge, "auto-merge", false, "If set, 'gitops update app' will merge changes automatically to the config repository")
+	internal.AddPullRequestOptionsFlags(Cmd, &params.PullRequestOptions)
+}
+
+func runCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	params.Name = args[0]
+	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
+
+	if cmd.Flags().Changed("prune") {
+		params.Prune = &prune
+	}
+
+	log := internal.NewCLILogger(os.Stdout)
+	factory := services.NewFactory(flux.New(osys.New(), &runner.CLIRunner{}), log)
+
+	kubeClient, _, err := kube.NewKubeHTTPClient()
+	if err != nil {
+		return fmt.Errorf("failed to create kube client: %w", err)
+	}
+
+	appService, err := factory.GetAppService(ctx, kubeClient)
+	if err != nil {
+		return fmt.Errorf("failed to create app service: %w", err)
+	}
+
+	appContent, err := appService.Get(types.NamespacedName{Name: params.Name, Namespace: params.Namespace})
+	if err != nil {
+		return fmt.Errorf("unable to get application for %s %w", params.Name, err)
+	}
+
+	providerClient := internal.NewGitProviderClient(os.Stdout, os.LookupEnv, auth.NewAuthCLIHandler, log)
+
+	signer, err := internal.GetSigner(ctx, kubeClient, params.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get commit signer: %w", err)
+	}
+
+	author, err := internal.GetCommitAuthor()
+	if err != nil {
+		return err
+	}
+
+	if params.CommitTemplates, err = internal.GetCommitTemplates(); err != nil {
+		return err
+	}
+
+	gitConfigParams := services.NewGitConfigParamsFromApp(appContent, params.DryRun)
+	gitConfigParams.Signer = signer
+	gitConfigParams.Author = author
+
+	gitClient, gitProvider, err := factory.GetGitClients(ctx, kubeClient, providerClient, gitConfigParams)
+	if err != nil {
+		return fmt.Errorf("failed to get git clients: %w", err)
+	}
+
+	if err := appService.Update(gitClient, gitProvider, params); err != nil {
+		return errors.Wrapf(err, "failed to update the app %s", params.Name)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package update
 
 import (
+	"github.com/weaveworks/weave-gitops/cmd/gitops/update/app"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/update/profiles"
 
 	"github.com/go-resty/resty/v2"
@@ -12,12 +13,16 @@ func UpdateCommand(endpoint *string, client *resty.Client) *cobra.Command {
 		Use:   "update",
 		Short: "Update a Weave GitOps resource",
 		Example: `
+	# Update the branch watched by an application
+	gitops update app podinfo --branch=dev
+
 	# Update a profile that is installed on a cluster
 	gitops update profile --name=podinfo --cluster=prod --config-repo=ssh://git@github.com/owner/config-repo.git  --version=1.0.0
 		`,
 	}
 
 	cmd.AddCommand(profiles.UpdateCommand())
+	cmd.AddCommand(app.Cmd)
 
 	return cmd
 }
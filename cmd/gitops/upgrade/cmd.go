@@ -10,6 +10,7 @@ import (
 	"github.com/weaveworks/weave-gitops/cmd/internal"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/models"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
 	"github.com/weaveworks/weave-gitops/pkg/services"
@@ -43,6 +44,7 @@ func init() {
 	Cmd.PersistentFlags().StringVar(&upgradeCmdFlags.CommitMessage, "commit-message", "Upgrade to WGE", "The commit message")
 	Cmd.PersistentFlags().StringArrayVar(&upgradeCmdFlags.Values, "set", []string{}, "set profile values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
 	Cmd.PersistentFlags().BoolVar(&upgradeCmdFlags.DryRun, "dry-run", false, "Output the generated profile without creating a pull request")
+	internal.AddPullRequestOptionsFlags(Cmd, &upgradeCmdFlags.PullRequestOptions)
 
 	cobra.CheckErr(Cmd.MarkPersistentFlagRequired("version"))
 }
@@ -75,12 +77,31 @@ func upgradeCmdRunE() func(*cobra.Command, []string) error {
 
 		upgradeCmdFlags.ConfigRepo = wegoConfig.ConfigRepo
 
+		templates, err := internal.GetCommitTemplates()
+		if err != nil {
+			return err
+		}
+
+		upgradeCmdFlags.Templates = templates.Or(models.CommitTemplatesFromConfig(wegoConfig))
+
 		providerClient := internal.NewGitProviderClient(os.Stdout, os.LookupEnv, auth.NewAuthCLIHandler, log)
 
+		signer, err := internal.GetSigner(ctx, kubeClient, namespace)
+		if err != nil {
+			return fmt.Errorf("failed to get commit signer: %w", err)
+		}
+
+		author, err := internal.GetCommitAuthor()
+		if err != nil {
+			return err
+		}
+
 		gitClient, gitProvider, err := factory.GetGitClients(ctx, kubeClient, providerClient, services.GitConfigParams{
 			ConfigRepo: upgradeCmdFlags.ConfigRepo,
 			Namespace:  upgradeCmdFlags.Namespace,
 			DryRun:     upgradeCmdFlags.DryRun,
+			Signer:     signer,
+			Author:     author,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to get git clients: %w", err)
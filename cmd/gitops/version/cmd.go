@@ -78,6 +78,7 @@ func CheckpointParamsWithFlags(params *checkpoint.CheckParams, c *cobra.Command)
 func CheckFluxVersion() (string, error) {
 	cliRunner := &runner.CLIRunner{}
 	fluxClient := flux.New(osys.New(), cliRunner)
+	fluxClient.SetupBin()
 
 	return fluxClient.GetVersion()
 }
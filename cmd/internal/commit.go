@@ -0,0 +1,40 @@
+package internal
+
+import (
+	"errors"
+
+	"github.com/spf13/viper"
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+)
+
+// GetCommitAuthor returns the author set with the --commit-author-name and --commit-author-email flags,
+// or an empty author when they aren't set
+func GetCommitAuthor() (git.Author, error) {
+	author := git.Author{
+		Name:  viper.GetString("commit-author-name"),
+		Email: viper.GetString("commit-author-email"),
+	}
+
+	if (author.Name == "") != (author.Email == "") {
+		return git.Author{}, errors.New("--commit-author-name and --commit-author-email must be set together")
+	}
+
+	return author, nil
+}
+
+// GetCommitTemplates returns the templates set with the --commit-message-template, --pull-request-title-template
+// and --pull-request-body-template flags
+func GetCommitTemplates() (models.CommitTemplates, error) {
+	templates := models.CommitTemplates{
+		CommitMessage:    viper.GetString("commit-message-template"),
+		PullRequestTitle: viper.GetString("pull-request-title-template"),
+		PullRequestBody:  viper.GetString("pull-request-body-template"),
+	}
+
+	if err := templates.Validate(); err != nil {
+		return models.CommitTemplates{}, err
+	}
+
+	return templates, nil
+}
@@ -0,0 +1,56 @@
+package internal
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+)
+
+var _ = Describe("Commit flags", func() {
+	AfterEach(func() {
+		for _, flag := range []string{"commit-author-name", "commit-author-email", "commit-message-template", "pull-request-title-template", "pull-request-body-template"} {
+			viper.Set(flag, "")
+		}
+	})
+
+	It("returns the author of the flags", func() {
+		author, err := GetCommitAuthor()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(author).To(Equal(git.Author{}))
+
+		viper.Set("commit-author-name", "bot")
+		viper.Set("commit-author-email", "bot@example.com")
+
+		author, err = GetCommitAuthor()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(author).To(Equal(git.Author{Name: "bot", Email: "bot@example.com"}))
+	})
+
+	It("requires both the name and the email of the author", func() {
+		viper.Set("commit-author-name", "bot")
+
+		_, err := GetCommitAuthor()
+		Expect(err).To(MatchError("--commit-author-name and --commit-author-email must be set together"))
+	})
+
+	It("returns the templates of the flags", func() {
+		viper.Set("commit-message-template", "chore: {{ .Default }}")
+		viper.Set("pull-request-body-template", "{{ .Default }}")
+
+		templates, err := GetCommitTemplates()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(templates).To(Equal(models.CommitTemplates{
+			CommitMessage:   "chore: {{ .Default }}",
+			PullRequestBody: "{{ .Default }}",
+		}))
+	})
+
+	It("fails on invalid templates", func() {
+		viper.Set("pull-request-title-template", "{{ .Default ")
+
+		_, err := GetCommitTemplates()
+		Expect(err).To(MatchError(ContainSubstring("invalid pull request title template")))
+	})
+})
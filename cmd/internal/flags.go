@@ -1,6 +1,13 @@
 package internal
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/printer"
+)
 
 func AddPRFlags(cmd *cobra.Command, headBranch, baseBranch, description, message, title *string) {
 	cmd.Flags().StringVar(headBranch, "branch", "", "The branch to create the pull request from")
@@ -9,3 +16,27 @@ func AddPRFlags(cmd *cobra.Command, headBranch, baseBranch, description, message
 	cmd.Flags().StringVar(baseBranch, "base", "", "The base branch of the remote repository")
 	cmd.Flags().StringVar(description, "description", "", "The description of the pull request")
 }
+
+// AddPullRequestOptionsFlags adds the flags setting the reviewers, labels, assignees and draft status of the pull request
+// a command creates, which are supported by GitHub and GitLab
+func AddPullRequestOptionsFlags(cmd *cobra.Command, opts *gitproviders.PullRequestOptions) {
+	cmd.Flags().StringSliceVar(&opts.Reviewers, "reviewer", nil, "Username to request a review of the pull request from, or GitHub team in the format '<org>/<team>'; can be repeated")
+	cmd.Flags().StringSliceVar(&opts.Labels, "label", nil, "Label to add to the pull request; can be repeated")
+	cmd.Flags().StringSliceVar(&opts.Assignees, "assignee", nil, "Username to assign the pull request to; can be repeated")
+	cmd.Flags().BoolVar(&opts.Draft, "draft", false, "If set, the pull request is created as a draft")
+}
+
+// AddOutputFlag adds the --output flag selecting the format in which the get commands print resources
+func AddOutputFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringP("output", "o", printer.FormatTable, fmt.Sprintf("Output format, one of: %s", strings.Join(printer.Formats, ", ")))
+}
+
+// GetPrinter returns the printer for the format selected with the --output flag
+func GetPrinter(cmd *cobra.Command) (printer.Printer, error) {
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return printer.Printer{}, err
+	}
+
+	return printer.New(output)
+}
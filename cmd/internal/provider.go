@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 
+	"github.com/spf13/viper"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/logger"
 )
@@ -37,9 +38,10 @@ func (c *gitProviderClient) GetProvider(repoUrl gitproviders.RepoURL, getAccount
 	}
 
 	provider, err := gitproviders.New(gitproviders.Config{
-		Provider: repoUrl.Provider(),
-		Token:    token,
-		Hostname: repoUrl.URL().Host,
+		Provider:       repoUrl.Provider(),
+		Token:          token,
+		Hostname:       repoUrl.URL().Host,
+		PrivateKeyFile: viper.GetString("git-private-key-file"),
 	}, repoUrl.Owner(), getAccountType)
 	if err != nil {
 		return nil, fmt.Errorf("error creating git provider client: %w", err)
@@ -54,14 +56,22 @@ func getTokenVarName(providerName gitproviders.GitProviderName) (string, error)
 		return "GITHUB_TOKEN", nil
 	case gitproviders.GitProviderGitLab:
 		return "GITLAB_TOKEN", nil
+	case gitproviders.GitProviderBitbucketServer:
+		return "BITBUCKET_SERVER_TOKEN", nil
+	case gitproviders.GitProviderGitea:
+		return "GITEA_TOKEN", nil
 	default:
 		return "", fmt.Errorf("unknown git provider: %q", providerName)
 	}
 }
 
 // GetToken returns either the token stored in the <git provider>_TOKEN env var
-// or a token retrieved via the CLI auth flow
+// or a token retrieved via the CLI auth flow. Plain git servers don't need a token.
 func GetToken(repoUrl gitproviders.RepoURL, w io.Writer, lookupEnvFunc func(key string) (string, bool), authHandlerFunc GetAuthHandler, log logger.Logger) (string, error) {
+	if repoUrl.Provider() == gitproviders.GitProviderGit {
+		return "", nil
+	}
+
 	tokenVarName, err := getTokenVarName(repoUrl.Provider())
 	if err != nil {
 		return "", fmt.Errorf("could not determine git provider token name: %w", err)
@@ -11,6 +11,7 @@ import (
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/logger/loggerfakes"
 	"github.com/weaveworks/weave-gitops/pkg/services/auth"
@@ -19,6 +20,7 @@ import (
 const (
 	githubToken = "github-token-123"
 	gitlabToken = "gitlab-token-abc"
+	giteaToken  = "gitea-token-xyz"
 )
 
 func fakeBlockingCLIHandlerSuccess(_ context.Context, _ io.Writer) (string, error) {
@@ -54,6 +56,8 @@ func fakeEnvLookupExists(key string) (string, bool) {
 		return githubToken, true
 	} else if key == "GITLAB_TOKEN" {
 		return gitlabToken, true
+	} else if key == "GITEA_TOKEN" {
+		return giteaToken, true
 	} else {
 		return "", false
 	}
@@ -128,6 +132,43 @@ var _ = Describe("Get git provider", func() {
 				Expect(provider.GetProviderDomain()).To(Equal("gitlab.com"))
 			})
 		})
+
+		Describe("gitea token", func() {
+			BeforeEach(func() {
+				viper.Set("git-host-types", "gitea.example.com=gitea")
+
+				fakeLogger = &loggerfakes.FakeLogger{}
+				client = NewGitProviderClient(os.Stdout, fakeEnvLookupExists, fakeAuthHandlerFuncError, fakeLogger)
+				repoUrl, _ = gitproviders.NewRepoURL("ssh://git@gitea.example.com:2222/weaveworks/weave-gitops.git")
+			})
+
+			It("success without the account type", func() {
+				provider, err := client.GetProvider(repoUrl, fakeAccountGetterError)
+
+				Expect(err).To(BeNil())
+				Expect(provider.GetProviderDomain()).To(Equal("gitea.example.com"))
+			})
+		})
+	})
+
+	Describe("plain git server", func() {
+		BeforeEach(func() {
+			viper.Set("git-host-types", "git.example.com=git")
+
+			fakeLogger = &loggerfakes.FakeLogger{}
+			client = NewGitProviderClient(os.Stdout, fakeEnvLookupDoesNotExist, fakeAuthHandlerFuncError, fakeLogger)
+			repoUrl, _ = gitproviders.NewRepoURL("ssh://git@git.example.com/srv/git/weave-gitops.git")
+		})
+
+		It("success without a token", func() {
+			provider, err := client.GetProvider(repoUrl, fakeAccountGetterError)
+
+			Expect(err).To(BeNil())
+			Expect(provider.GetProviderDomain()).To(Equal("git.example.com"))
+			_, ok := provider.(gitproviders.UserKeyProvider)
+			Expect(ok).To(BeTrue(), "the repositories should be accessed with the key of the user")
+			Expect(fakeLogger.WarningfCallCount()).To(Equal(0))
+		})
 	})
 
 	Describe("auth flow since token is not in an env variable", func() {
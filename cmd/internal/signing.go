@@ -0,0 +1,32 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/viper"
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/services"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// GetSigner returns the Signer of the key set with the --signing-key-file or the --signing-key-secret flags,
+// or nil when neither is set. The passphrase of a key file is read from the GITOPS_SIGNING_KEY_PASSPHRASE env var.
+func GetSigner(ctx context.Context, kubeClient kube.Kube, namespace string) (git.Signer, error) {
+	if keyFile := viper.GetString("signing-key-file"); keyFile != "" {
+		key, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading signing key: %w", err)
+		}
+
+		return git.NewSigner(key, viper.GetString("signing-key-passphrase"))
+	}
+
+	if secretName := viper.GetString("signing-key-secret"); secretName != "" {
+		return services.NewSignerFromSecret(ctx, kubeClient, types.NamespacedName{Name: secretName, Namespace: namespace})
+	}
+
+	return nil, nil
+}
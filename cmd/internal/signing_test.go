@@ -0,0 +1,86 @@
+package internal
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"github.com/weaveworks/weave-gitops/pkg/kube/kubefakes"
+	"github.com/weaveworks/weave-gitops/pkg/services"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Get signer", func() {
+	var (
+		ctx      context.Context
+		fakeKube *kubefakes.FakeKube
+		key      []byte
+		dir      string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeKube = &kubefakes.FakeKube{}
+
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		key = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+		dir, err = ioutil.TempDir("", "signing-key")
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		viper.Set("signing-key-file", "")
+		viper.Set("signing-key-secret", "")
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("doesn't sign when no key is set", func() {
+		signer, err := GetSigner(ctx, fakeKube, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(signer).To(BeNil())
+	})
+
+	It("reads the key file", func() {
+		keyFile := filepath.Join(dir, "id_ed25519")
+		Expect(ioutil.WriteFile(keyFile, key, 0600)).To(Succeed())
+		viper.Set("signing-key-file", keyFile)
+
+		signer, err := GetSigner(ctx, fakeKube, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(signer).NotTo(BeNil())
+	})
+
+	It("fails when the key file doesn't exist", func() {
+		viper.Set("signing-key-file", filepath.Join(dir, "missing"))
+
+		_, err := GetSigner(ctx, fakeKube, "wego-system")
+		Expect(err).To(MatchError(ContainSubstring("failed reading signing key")))
+	})
+
+	It("reads the key secret from the namespace", func() {
+		fakeKube.GetSecretReturns(&corev1.Secret{Data: map[string][]byte{services.SigningKeySecretKey: key}}, nil)
+		viper.Set("signing-key-secret", "wego-signing-key")
+
+		signer, err := GetSigner(ctx, fakeKube, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(signer).NotTo(BeNil())
+
+		_, name := fakeKube.GetSecretArgsForCall(0)
+		Expect(name).To(Equal(types.NamespacedName{Name: "wego-signing-key", Namespace: "wego-system"}))
+	})
+})
@@ -66,6 +66,18 @@ metadata:
 kind: ClusterRoleBinding
 metadata:
   name: wego-helm-watcher-rolebinding`))
+
+			By("containing the application watcher Cluster Role manifest")
+			Expect(manifests).To(ContainSubstring(`
+kind: ClusterRole
+metadata:
+  name: wego-app-watcher-role`))
+
+			By("containing the application watcher Cluster Role Binding manifest")
+			Expect(manifests).To(ContainSubstring(`
+kind: ClusterRoleBinding
+metadata:
+  name: wego-app-watcher-rolebinding`))
 		})
 	})
 })
@@ -0,0 +1,56 @@
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: wego-app-watcher-role
+rules:
+  - apiGroups:
+      - wego.weave.works
+    resources:
+      - apps
+    verbs:
+      - get
+      - list
+      - watch
+  - apiGroups:
+      - wego.weave.works
+    resources:
+      - apps/status
+    verbs:
+      - get
+      - patch
+      - update
+  - apiGroups:
+      - source.toolkit.fluxcd.io
+    resources:
+      - gitrepositories
+      - helmrepositories
+      - buckets
+    verbs:
+      - get
+      - list
+      - watch
+  - apiGroups:
+      - kustomize.toolkit.fluxcd.io
+    resources:
+      - kustomizations
+    verbs:
+      - get
+      - list
+      - watch
+  - apiGroups:
+      - helm.toolkit.fluxcd.io
+    resources:
+      - helmreleases
+    verbs:
+      - get
+      - list
+      - watch
+  # the objects reconciled by applications, of any kind, are watched with this service account.
+  # The server checks that the user of a watch may list and watch them before streaming them.
+  - apiGroups:
+      - "*"
+    resources:
+      - "*"
+    verbs:
+      - list
+      - watch
@@ -22,3 +22,16 @@ rules:
   - apiGroups: ["source.toolkit.fluxcd.io"]
     resources: [ "gitrepositories" ]
     verbs: [ "*" ]
+  - apiGroups: ["source.toolkit.fluxcd.io"]
+    resources: [ "buckets" ]
+    verbs: [ "*" ]
+  # the leader election of the application watcher
+  - apiGroups: [""]
+    resources: [ "configmaps" ]
+    verbs: [ "get", "create", "update" ]
+  - apiGroups: ["coordination.k8s.io"]
+    resources: [ "leases" ]
+    verbs: [ "get", "create", "update" ]
+  - apiGroups: [""]
+    resources: [ "events" ]
+    verbs: [ "create", "patch" ]
@@ -234,6 +234,10 @@ func (c *HTTPClient) CreatePullRequestFromTemplate(params capi.CreatePullRequest
 		CommitMessage   string               `json:"commitMessage"`
 		Credentials     capi.Credentials     `json:"credentials"`
 		ProfileValues   []capi.ProfileValues `json:"profile_values"`
+		Reviewers       []string             `json:"reviewers,omitempty"`
+		Labels          []string             `json:"labels,omitempty"`
+		Assignees       []string             `json:"assignees,omitempty"`
+		Draft           bool                 `json:"draft,omitempty"`
 	}
 
 	// POST response payload
@@ -259,6 +263,10 @@ func (c *HTTPClient) CreatePullRequestFromTemplate(params capi.CreatePullRequest
 			CommitMessage:   params.CommitMessage,
 			Credentials:     params.Credentials,
 			ProfileValues:   params.ProfileValues,
+			Reviewers:       params.Reviewers,
+			Labels:          params.Labels,
+			Assignees:       params.Assignees,
+			Draft:           params.Draft,
 		}).
 		SetResult(&result).
 		SetError(&serviceErr).
@@ -2,6 +2,7 @@ package adapters_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
@@ -323,6 +324,36 @@ func TestCreatePullRequestFromTemplate(t *testing.T) {
 	}
 }
 
+func TestCreatePullRequestFromTemplateWithOptions(t *testing.T) {
+	client := resty.New()
+	httpmock.ActivateNonDefault(client.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	var body map[string]interface{}
+
+	httpmock.RegisterResponder("POST", BaseURI+"/v1/clusters", func(req *http.Request) (*http.Response, error) {
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+
+		return httpmock.NewJsonResponse(200, map[string]string{"webUrl": "https://github.com/org/repo/pull/1"})
+	})
+
+	c, err := adapters.NewHttpClient(BaseURI, client, os.Stdout)
+	assert.NoError(t, err)
+
+	_, err = c.CreatePullRequestFromTemplate(capi.CreatePullRequestFromTemplateParams{
+		Reviewers: []string{"alice"},
+		Labels:    []string{"clusters"},
+		Draft:     true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"alice"}, body["reviewers"])
+	assert.Equal(t, []interface{}{"clusters"}, body["labels"])
+	assert.NotContains(t, body, "assignees")
+	assert.Equal(t, true, body["draft"])
+}
+
 func TestRetrieveCredentials(t *testing.T) {
 	tests := []struct {
 		name       string
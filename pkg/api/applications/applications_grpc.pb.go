@@ -40,6 +40,28 @@ type ApplicationsClient interface {
 	// Not all Kubernets objects have children. For example, a Deployment has a child ReplicaSet, but a Service has no child objects.
 	GetChildObjects(ctx context.Context, in *GetChildObjectsReq, opts ...grpc.CallOption) (*GetChildObjectsRes, error)
 	//
+	// GetApplicationTree returns the objects reconciled by an Application, each with the objects it owns.
+	// The reconciled objects are found from the inventory of the Kustomization or the manifest of the Helm release of the Application.
+	GetApplicationTree(ctx context.Context, in *GetApplicationTreeRequest, opts ...grpc.CallOption) (*GetApplicationTreeResponse, error)
+	//
+	// WatchApplications streams the changes to the Applications of a namespace and to their Flux objects.
+	// The objects that exist when the watch starts are sent first, as added.
+	WatchApplications(ctx context.Context, in *WatchApplicationsRequest, opts ...grpc.CallOption) (Applications_WatchApplicationsClient, error)
+	//
+	// WatchApplication streams the changes to an Application, to its Flux objects and to the objects it reconciled.
+	// The objects that exist when the watch starts are sent first, as added.
+	WatchApplication(ctx context.Context, in *WatchApplicationRequest, opts ...grpc.CallOption) (Applications_WatchApplicationClient, error)
+	//
+	// ListApplicationEvents returns the Kubernetes Events involving the Flux objects of an Application
+	// and the objects it reconciled, with the objects they own, such as the pods of a deployment.
+	ListApplicationEvents(ctx context.Context, in *ListApplicationEventsRequest, opts ...grpc.CallOption) (*ListApplicationEventsResponse, error)
+	//
+	// GetObjectLogs streams the logs of the containers of the pods run by the objects an Application reconciled.
+	GetObjectLogs(ctx context.Context, in *GetObjectLogsRequest, opts ...grpc.CallOption) (Applications_GetObjectLogsClient, error)
+	//
+	// GetSystemHealth reports the health of the Flux controllers, of the Weave GitOps components and of the CRDs they use.
+	GetSystemHealth(ctx context.Context, in *GetSystemHealthRequest, opts ...grpc.CallOption) (*GetSystemHealthResponse, error)
+	//
 	// GetGithubDeviceCode retrieves a temporary device code for Github authentication.
 	// This code is used to start the Github device-flow.
 	GetGithubDeviceCode(ctx context.Context, in *GetGithubDeviceCodeRequest, opts ...grpc.CallOption) (*GetGithubDeviceCodeResponse, error)
@@ -65,6 +87,9 @@ type ApplicationsClient interface {
 	// AddApplication adds an Application to a cluster via GitOps.
 	AddApplication(ctx context.Context, in *AddApplicationRequest, opts ...grpc.CallOption) (*AddApplicationResponse, error)
 	//
+	// UpdateApplication changes the spec of an Application via GitOps.
+	UpdateApplication(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*UpdateApplicationResponse, error)
+	//
 	// RemoveApplication removes an Application from a cluster via GitOps.
 	RemoveApplication(ctx context.Context, in *RemoveApplicationRequest, opts ...grpc.CallOption) (*RemoveApplicationResponse, error)
 	//
@@ -143,6 +168,129 @@ func (c *applicationsClient) GetChildObjects(ctx context.Context, in *GetChildOb
 	return out, nil
 }
 
+func (c *applicationsClient) GetApplicationTree(ctx context.Context, in *GetApplicationTreeRequest, opts ...grpc.CallOption) (*GetApplicationTreeResponse, error) {
+	out := new(GetApplicationTreeResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/GetApplicationTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) WatchApplications(ctx context.Context, in *WatchApplicationsRequest, opts ...grpc.CallOption) (Applications_WatchApplicationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Applications_ServiceDesc.Streams[0], "/wego_server.v1.Applications/WatchApplications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationsWatchApplicationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Applications_WatchApplicationsClient interface {
+	Recv() (*ApplicationEvent, error)
+	grpc.ClientStream
+}
+
+type applicationsWatchApplicationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationsWatchApplicationsClient) Recv() (*ApplicationEvent, error) {
+	m := new(ApplicationEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *applicationsClient) WatchApplication(ctx context.Context, in *WatchApplicationRequest, opts ...grpc.CallOption) (Applications_WatchApplicationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Applications_ServiceDesc.Streams[1], "/wego_server.v1.Applications/WatchApplication", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationsWatchApplicationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Applications_WatchApplicationClient interface {
+	Recv() (*ApplicationEvent, error)
+	grpc.ClientStream
+}
+
+type applicationsWatchApplicationClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationsWatchApplicationClient) Recv() (*ApplicationEvent, error) {
+	m := new(ApplicationEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *applicationsClient) ListApplicationEvents(ctx context.Context, in *ListApplicationEventsRequest, opts ...grpc.CallOption) (*ListApplicationEventsResponse, error) {
+	out := new(ListApplicationEventsResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/ListApplicationEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) GetObjectLogs(ctx context.Context, in *GetObjectLogsRequest, opts ...grpc.CallOption) (Applications_GetObjectLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Applications_ServiceDesc.Streams[2], "/wego_server.v1.Applications/GetObjectLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationsGetObjectLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Applications_GetObjectLogsClient interface {
+	Recv() (*LogLine, error)
+	grpc.ClientStream
+}
+
+type applicationsGetObjectLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationsGetObjectLogsClient) Recv() (*LogLine, error) {
+	m := new(LogLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *applicationsClient) GetSystemHealth(ctx context.Context, in *GetSystemHealthRequest, opts ...grpc.CallOption) (*GetSystemHealthResponse, error) {
+	out := new(GetSystemHealthResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/GetSystemHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationsClient) GetGithubDeviceCode(ctx context.Context, in *GetGithubDeviceCodeRequest, opts ...grpc.CallOption) (*GetGithubDeviceCodeResponse, error) {
 	out := new(GetGithubDeviceCodeResponse)
 	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/GetGithubDeviceCode", in, out, opts...)
@@ -188,6 +336,15 @@ func (c *applicationsClient) AddApplication(ctx context.Context, in *AddApplicat
 	return out, nil
 }
 
+func (c *applicationsClient) UpdateApplication(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*UpdateApplicationResponse, error) {
+	out := new(UpdateApplicationResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/UpdateApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationsClient) RemoveApplication(ctx context.Context, in *RemoveApplicationRequest, opts ...grpc.CallOption) (*RemoveApplicationResponse, error) {
 	out := new(RemoveApplicationResponse)
 	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/RemoveApplication", in, out, opts...)
@@ -259,6 +416,28 @@ type ApplicationsServer interface {
 	// Not all Kubernets objects have children. For example, a Deployment has a child ReplicaSet, but a Service has no child objects.
 	GetChildObjects(context.Context, *GetChildObjectsReq) (*GetChildObjectsRes, error)
 	//
+	// GetApplicationTree returns the objects reconciled by an Application, each with the objects it owns.
+	// The reconciled objects are found from the inventory of the Kustomization or the manifest of the Helm release of the Application.
+	GetApplicationTree(context.Context, *GetApplicationTreeRequest) (*GetApplicationTreeResponse, error)
+	//
+	// WatchApplications streams the changes to the Applications of a namespace and to their Flux objects.
+	// The objects that exist when the watch starts are sent first, as added.
+	WatchApplications(*WatchApplicationsRequest, Applications_WatchApplicationsServer) error
+	//
+	// WatchApplication streams the changes to an Application, to its Flux objects and to the objects it reconciled.
+	// The objects that exist when the watch starts are sent first, as added.
+	WatchApplication(*WatchApplicationRequest, Applications_WatchApplicationServer) error
+	//
+	// ListApplicationEvents returns the Kubernetes Events involving the Flux objects of an Application
+	// and the objects it reconciled, with the objects they own, such as the pods of a deployment.
+	ListApplicationEvents(context.Context, *ListApplicationEventsRequest) (*ListApplicationEventsResponse, error)
+	//
+	// GetObjectLogs streams the logs of the containers of the pods run by the objects an Application reconciled.
+	GetObjectLogs(*GetObjectLogsRequest, Applications_GetObjectLogsServer) error
+	//
+	// GetSystemHealth reports the health of the Flux controllers, of the Weave GitOps components and of the CRDs they use.
+	GetSystemHealth(context.Context, *GetSystemHealthRequest) (*GetSystemHealthResponse, error)
+	//
 	// GetGithubDeviceCode retrieves a temporary device code for Github authentication.
 	// This code is used to start the Github device-flow.
 	GetGithubDeviceCode(context.Context, *GetGithubDeviceCodeRequest) (*GetGithubDeviceCodeResponse, error)
@@ -284,6 +463,9 @@ type ApplicationsServer interface {
 	// AddApplication adds an Application to a cluster via GitOps.
 	AddApplication(context.Context, *AddApplicationRequest) (*AddApplicationResponse, error)
 	//
+	// UpdateApplication changes the spec of an Application via GitOps.
+	UpdateApplication(context.Context, *UpdateApplicationRequest) (*UpdateApplicationResponse, error)
+	//
 	// RemoveApplication removes an Application from a cluster via GitOps.
 	RemoveApplication(context.Context, *RemoveApplicationRequest) (*RemoveApplicationResponse, error)
 	//
@@ -323,6 +505,24 @@ func (UnimplementedApplicationsServer) GetReconciledObjects(context.Context, *Ge
 func (UnimplementedApplicationsServer) GetChildObjects(context.Context, *GetChildObjectsReq) (*GetChildObjectsRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChildObjects not implemented")
 }
+func (UnimplementedApplicationsServer) GetApplicationTree(context.Context, *GetApplicationTreeRequest) (*GetApplicationTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationTree not implemented")
+}
+func (UnimplementedApplicationsServer) WatchApplications(*WatchApplicationsRequest, Applications_WatchApplicationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchApplications not implemented")
+}
+func (UnimplementedApplicationsServer) WatchApplication(*WatchApplicationRequest, Applications_WatchApplicationServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchApplication not implemented")
+}
+func (UnimplementedApplicationsServer) ListApplicationEvents(context.Context, *ListApplicationEventsRequest) (*ListApplicationEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApplicationEvents not implemented")
+}
+func (UnimplementedApplicationsServer) GetObjectLogs(*GetObjectLogsRequest, Applications_GetObjectLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetObjectLogs not implemented")
+}
+func (UnimplementedApplicationsServer) GetSystemHealth(context.Context, *GetSystemHealthRequest) (*GetSystemHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemHealth not implemented")
+}
 func (UnimplementedApplicationsServer) GetGithubDeviceCode(context.Context, *GetGithubDeviceCodeRequest) (*GetGithubDeviceCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGithubDeviceCode not implemented")
 }
@@ -338,6 +538,9 @@ func (UnimplementedApplicationsServer) AuthorizeGitlab(context.Context, *Authori
 func (UnimplementedApplicationsServer) AddApplication(context.Context, *AddApplicationRequest) (*AddApplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddApplication not implemented")
 }
+func (UnimplementedApplicationsServer) UpdateApplication(context.Context, *UpdateApplicationRequest) (*UpdateApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplication not implemented")
+}
 func (UnimplementedApplicationsServer) RemoveApplication(context.Context, *RemoveApplicationRequest) (*RemoveApplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveApplication not implemented")
 }
@@ -474,6 +677,123 @@ func _Applications_GetChildObjects_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Applications_GetApplicationTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).GetApplicationTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/GetApplicationTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).GetApplicationTree(ctx, req.(*GetApplicationTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_WatchApplications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchApplicationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationsServer).WatchApplications(m, &applicationsWatchApplicationsServer{stream})
+}
+
+type Applications_WatchApplicationsServer interface {
+	Send(*ApplicationEvent) error
+	grpc.ServerStream
+}
+
+type applicationsWatchApplicationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationsWatchApplicationsServer) Send(m *ApplicationEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Applications_WatchApplication_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchApplicationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationsServer).WatchApplication(m, &applicationsWatchApplicationServer{stream})
+}
+
+type Applications_WatchApplicationServer interface {
+	Send(*ApplicationEvent) error
+	grpc.ServerStream
+}
+
+type applicationsWatchApplicationServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationsWatchApplicationServer) Send(m *ApplicationEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Applications_ListApplicationEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).ListApplicationEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/ListApplicationEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).ListApplicationEvents(ctx, req.(*ListApplicationEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_GetObjectLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetObjectLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationsServer).GetObjectLogs(m, &applicationsGetObjectLogsServer{stream})
+}
+
+type Applications_GetObjectLogsServer interface {
+	Send(*LogLine) error
+	grpc.ServerStream
+}
+
+type applicationsGetObjectLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationsGetObjectLogsServer) Send(m *LogLine) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Applications_GetSystemHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).GetSystemHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/GetSystemHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).GetSystemHealth(ctx, req.(*GetSystemHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Applications_GetGithubDeviceCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetGithubDeviceCodeRequest)
 	if err := dec(in); err != nil {
@@ -564,6 +884,24 @@ func _Applications_AddApplication_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Applications_UpdateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).UpdateApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/UpdateApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).UpdateApplication(ctx, req.(*UpdateApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Applications_RemoveApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveApplicationRequest)
 	if err := dec(in); err != nil {
@@ -685,6 +1023,18 @@ var Applications_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChildObjects",
 			Handler:    _Applications_GetChildObjects_Handler,
 		},
+		{
+			MethodName: "GetApplicationTree",
+			Handler:    _Applications_GetApplicationTree_Handler,
+		},
+		{
+			MethodName: "ListApplicationEvents",
+			Handler:    _Applications_ListApplicationEvents_Handler,
+		},
+		{
+			MethodName: "GetSystemHealth",
+			Handler:    _Applications_GetSystemHealth_Handler,
+		},
 		{
 			MethodName: "GetGithubDeviceCode",
 			Handler:    _Applications_GetGithubDeviceCode_Handler,
@@ -705,6 +1055,10 @@ var Applications_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddApplication",
 			Handler:    _Applications_AddApplication_Handler,
 		},
+		{
+			MethodName: "UpdateApplication",
+			Handler:    _Applications_UpdateApplication_Handler,
+		},
 		{
 			MethodName: "RemoveApplication",
 			Handler:    _Applications_RemoveApplication_Handler,
@@ -726,6 +1080,22 @@ var Applications_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Applications_GetFeatureFlags_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchApplications",
+			Handler:       _Applications_WatchApplications_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchApplication",
+			Handler:       _Applications_WatchApplication_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetObjectLogs",
+			Handler:       _Applications_GetObjectLogs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/applications/applications.proto",
 }
@@ -0,0 +1,249 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/metrics"
+)
+
+// ApplicationReconciler populates the status of Applications from the flux objects generated for them.
+type ApplicationReconciler struct {
+	client.Client
+}
+
+// +kubebuilder:rbac:groups=wego.weave.works,resources=apps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=wego.weave.works,resources=apps/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=source.toolkit.fluxcd.io,resources=gitrepositories;helmrepositories;buckets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kustomize.toolkit.fluxcd.io,resources=kustomizations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=helm.toolkit.fluxcd.io,resources=helmreleases,verbs=get;list;watch
+
+// Reconcile is called when an Application changes or when one of the flux objects generated for it changes.
+// The source and automation objects share the name and namespace of the Application they were generated for.
+func (r *ApplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logr.FromContextOrDiscard(ctx).WithValues("application", req.NamespacedName)
+
+	var app wego.Application
+	if err := r.Get(ctx, req.NamespacedName, &app); err != nil {
+		if apierrors.IsNotFound(err) {
+			metrics.DeleteApplicationStatus(req.Namespace, req.Name)
+		}
+
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !app.ObjectMeta.GetDeletionTimestamp().IsZero() {
+		metrics.DeleteApplicationStatus(app.Namespace, app.Name)
+		return ctrl.Result{}, nil
+	}
+
+	src, automation, err := fluxObjects(app)
+	if err != nil {
+		log.Error(err, "unable to determine flux objects for application")
+		return ctrl.Result{}, nil
+	}
+
+	patch := client.MergeFrom(app.DeepCopy())
+
+	if err := r.updateSourceStatus(ctx, &app, src); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.updateAutomationStatus(ctx, &app, automation); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	app.Status.ObservedGeneration = app.Generation
+
+	if err := r.Status().Patch(ctx, &app, patch); err != nil {
+		log.Error(err, "unable to update application status")
+		return ctrl.Result{}, err
+	}
+
+	metrics.SetApplicationStatus(app.Namespace, app.Name, apimeta.IsStatusConditionTrue(app.Status.Conditions, wego.ReadyCondition), app.Status.Suspended)
+
+	return ctrl.Result{}, nil
+}
+
+func (r *ApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	enqueueApplication := handler.EnqueueRequestsFromMapFunc(applicationForFluxObject)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&wego.Application{}).
+		Watches(&source.Kind{Type: &sourcev1.GitRepository{}}, enqueueApplication).
+		Watches(&source.Kind{Type: &sourcev1.HelmRepository{}}, enqueueApplication).
+		Watches(&source.Kind{Type: &sourcev1.Bucket{}}, enqueueApplication).
+		Watches(&source.Kind{Type: &kustomizev2.Kustomization{}}, enqueueApplication).
+		Watches(&source.Kind{Type: &helmv2.HelmRelease{}}, enqueueApplication).
+		Complete(r)
+}
+
+func (r *ApplicationReconciler) updateSourceStatus(ctx context.Context, app *wego.Application, src client.Object) error {
+	found, err := r.getFluxObject(ctx, app, src)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		setCondition(app, wego.SourceReadyCondition, metav1.ConditionFalse, wego.SourceNotFoundReason,
+			fmt.Sprintf("%s %s/%s not found", src.GetObjectKind().GroupVersionKind().Kind, app.Namespace, app.Name))
+
+		return nil
+	}
+
+	var (
+		conditions []metav1.Condition
+		artifact   *sourcev1.Artifact
+	)
+
+	switch st := src.(type) {
+	case *sourcev1.GitRepository:
+		conditions = st.Status.Conditions
+		artifact = st.Status.Artifact
+	case *sourcev1.HelmRepository:
+		conditions = st.Status.Conditions
+		artifact = st.Status.Artifact
+	case *sourcev1.Bucket:
+		conditions = st.Status.Conditions
+		artifact = st.Status.Artifact
+	}
+
+	if artifact != nil {
+		app.Status.SourceRevision = artifact.Revision
+	}
+
+	mirrorReadyCondition(app, wego.SourceReadyCondition, conditions)
+
+	return nil
+}
+
+func (r *ApplicationReconciler) updateAutomationStatus(ctx context.Context, app *wego.Application, automation client.Object) error {
+	found, err := r.getFluxObject(ctx, app, automation)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		setCondition(app, wego.ReadyCondition, metav1.ConditionFalse, wego.AutomationNotFoundReason,
+			fmt.Sprintf("%s %s/%s not found", automation.GetObjectKind().GroupVersionKind().Kind, app.Namespace, app.Name))
+
+		return nil
+	}
+
+	var conditions []metav1.Condition
+
+	switch at := automation.(type) {
+	case *kustomizev2.Kustomization:
+		conditions = at.Status.Conditions
+		app.Status.Suspended = at.Spec.Suspend
+		app.Status.LastAppliedRevision = at.Status.LastAppliedRevision
+	case *helmv2.HelmRelease:
+		conditions = at.Status.Conditions
+		app.Status.Suspended = at.Spec.Suspend
+		app.Status.LastAppliedRevision = at.Status.LastAppliedRevision
+	}
+
+	if ready := apimeta.FindStatusCondition(conditions, wego.ReadyCondition); ready != nil {
+		lastReconcile := ready.LastTransitionTime
+		app.Status.LastReconcileTime = &lastReconcile
+	}
+
+	mirrorReadyCondition(app, wego.ReadyCondition, conditions)
+
+	return nil
+}
+
+// getFluxObject fetches the flux object sharing the application's name and namespace into obj.
+// It returns false if the object does not exist.
+func (r *ApplicationReconciler) getFluxObject(ctx context.Context, app *wego.Application, obj client.Object) (bool, error) {
+	name := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+
+	if err := r.Get(ctx, name, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("could not get %T for application %s: %w", obj, app.Name, err)
+	}
+
+	return true, nil
+}
+
+// mirrorReadyCondition copies the Ready condition of a flux object into the application's conditionType condition.
+func mirrorReadyCondition(app *wego.Application, conditionType string, conditions []metav1.Condition) {
+	ready := apimeta.FindStatusCondition(conditions, wego.ReadyCondition)
+	if ready == nil {
+		setCondition(app, conditionType, metav1.ConditionUnknown, wego.ProgressingReason, "reconciliation in progress")
+		return
+	}
+
+	reason := ready.Reason
+	if reason == "" {
+		reason = wego.ProgressingReason
+	}
+
+	setCondition(app, conditionType, ready.Status, reason, ready.Message)
+}
+
+func setCondition(app *wego.Application, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	apimeta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: app.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// fluxObjects returns empty objects of the source and automation kinds generated for an application.
+func fluxObjects(app wego.Application) (client.Object, client.Object, error) {
+	var (
+		src        client.Object
+		automation client.Object
+	)
+
+	switch app.Spec.SourceType {
+	// Apps created before the SourceType field existed default to git, same as the CLI.
+	case wego.SourceTypeGit, "":
+		src = &sourcev1.GitRepository{TypeMeta: metav1.TypeMeta{Kind: sourcev1.GitRepositoryKind}}
+	case wego.SourceTypeHelm, wego.SourceTypeOCI:
+		src = &sourcev1.HelmRepository{TypeMeta: metav1.TypeMeta{Kind: sourcev1.HelmRepositoryKind}}
+	case wego.SourceTypeBucket:
+		src = &sourcev1.Bucket{TypeMeta: metav1.TypeMeta{Kind: sourcev1.BucketKind}}
+	default:
+		return nil, nil, fmt.Errorf("invalid source type %q", app.Spec.SourceType)
+	}
+
+	switch app.Spec.DeploymentType {
+	case wego.DeploymentTypeKustomize, "":
+		automation = &kustomizev2.Kustomization{TypeMeta: metav1.TypeMeta{Kind: kustomizev2.KustomizationKind}}
+	case wego.DeploymentTypeHelm:
+		automation = &helmv2.HelmRelease{TypeMeta: metav1.TypeMeta{Kind: helmv2.HelmReleaseKind}}
+	default:
+		return nil, nil, fmt.Errorf("invalid deployment type %q", app.Spec.DeploymentType)
+	}
+
+	return src, automation, nil
+}
+
+// applicationForFluxObject maps a flux object to the Application it was generated for.
+func applicationForFluxObject(obj client.Object) []reconcile.Request {
+	return []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}},
+	}
+}
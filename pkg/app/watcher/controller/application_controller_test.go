@@ -0,0 +1,216 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+)
+
+var (
+	appName = types.NamespacedName{Name: "my-app", Namespace: "wego-system"}
+
+	reconcileTime = metav1.NewTime(time.Date(2021, time.November, 10, 23, 0, 0, 0, time.UTC))
+)
+
+func TestReconcileKustomizeApplication(t *testing.T) {
+	app := application(wego.SourceTypeGit, wego.DeploymentTypeKustomize)
+	repo := &sourcev1.GitRepository{
+		ObjectMeta: objectMeta(),
+		Status: sourcev1.GitRepositoryStatus{
+			Artifact:   &sourcev1.Artifact{Revision: "main/abc123"},
+			Conditions: []metav1.Condition{readyCondition(metav1.ConditionTrue, "GitOperationSucceed", "Fetched revision: main/abc123")},
+		},
+	}
+	kustomization := &kustomizev2.Kustomization{
+		ObjectMeta: objectMeta(),
+		Spec:       kustomizev2.KustomizationSpec{Suspend: true},
+		Status: kustomizev2.KustomizationStatus{
+			LastAppliedRevision: "main/abc123",
+			Conditions:          []metav1.Condition{readyCondition(metav1.ConditionTrue, "ReconciliationSucceeded", "Applied revision: main/abc123")},
+		},
+	}
+
+	reconciler := setupReconciler(app, repo, kustomization)
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: appName})
+	assert.NoError(t, err)
+
+	result := getApplication(t, reconciler)
+	assert.Equal(t, "main/abc123", result.Status.SourceRevision)
+	assert.Equal(t, "main/abc123", result.Status.LastAppliedRevision)
+	assert.True(t, result.Status.Suspended)
+	assert.True(t, reconcileTime.Equal(result.Status.LastReconcileTime))
+
+	ready := apimeta.FindStatusCondition(result.Status.Conditions, wego.ReadyCondition)
+	assert.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionTrue, ready.Status)
+	assert.Equal(t, "ReconciliationSucceeded", ready.Reason)
+	assert.Equal(t, "Applied revision: main/abc123", ready.Message)
+
+	sourceReady := apimeta.FindStatusCondition(result.Status.Conditions, wego.SourceReadyCondition)
+	assert.NotNil(t, sourceReady)
+	assert.Equal(t, metav1.ConditionTrue, sourceReady.Status)
+	assert.Equal(t, "GitOperationSucceed", sourceReady.Reason)
+}
+
+func TestReconcileHelmApplication(t *testing.T) {
+	app := application(wego.SourceTypeHelm, wego.DeploymentTypeHelm)
+	repo := &sourcev1.HelmRepository{
+		ObjectMeta: objectMeta(),
+		Status: sourcev1.HelmRepositoryStatus{
+			Artifact: &sourcev1.Artifact{Revision: "9c5a4f2"},
+		},
+	}
+	release := &helmv2.HelmRelease{
+		ObjectMeta: objectMeta(),
+		Status: helmv2.HelmReleaseStatus{
+			LastAppliedRevision: "6.0.0",
+			Conditions:          []metav1.Condition{readyCondition(metav1.ConditionFalse, "InstallFailed", "install retries exhausted")},
+		},
+	}
+
+	reconciler := setupReconciler(app, repo, release)
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: appName})
+	assert.NoError(t, err)
+
+	result := getApplication(t, reconciler)
+	assert.Equal(t, "9c5a4f2", result.Status.SourceRevision)
+	assert.Equal(t, "6.0.0", result.Status.LastAppliedRevision)
+	assert.False(t, result.Status.Suspended)
+
+	ready := apimeta.FindStatusCondition(result.Status.Conditions, wego.ReadyCondition)
+	assert.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, "InstallFailed", ready.Reason)
+
+	sourceReady := apimeta.FindStatusCondition(result.Status.Conditions, wego.SourceReadyCondition)
+	assert.NotNil(t, sourceReady)
+	assert.Equal(t, metav1.ConditionUnknown, sourceReady.Status)
+	assert.Equal(t, wego.ProgressingReason, sourceReady.Reason)
+}
+
+func TestReconcileMissingFluxObjects(t *testing.T) {
+	reconciler := setupReconciler(application(wego.SourceTypeGit, wego.DeploymentTypeKustomize))
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: appName})
+	assert.NoError(t, err)
+
+	result := getApplication(t, reconciler)
+	assert.Empty(t, result.Status.SourceRevision)
+	assert.Nil(t, result.Status.LastReconcileTime)
+
+	ready := apimeta.FindStatusCondition(result.Status.Conditions, wego.ReadyCondition)
+	assert.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, wego.AutomationNotFoundReason, ready.Reason)
+
+	sourceReady := apimeta.FindStatusCondition(result.Status.Conditions, wego.SourceReadyCondition)
+	assert.NotNil(t, sourceReady)
+	assert.Equal(t, metav1.ConditionFalse, sourceReady.Status)
+	assert.Equal(t, wego.SourceNotFoundReason, sourceReady.Reason)
+}
+
+func TestReconcileApplicationNotFound(t *testing.T) {
+	reconciler := setupReconciler()
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: appName})
+	assert.NoError(t, err)
+}
+
+func TestReconcileReportsApplicationMetrics(t *testing.T) {
+	kustomization := &kustomizev2.Kustomization{
+		ObjectMeta: objectMeta(),
+		Spec:       kustomizev2.KustomizationSpec{Suspend: true},
+		Status: kustomizev2.KustomizationStatus{
+			Conditions: []metav1.Condition{readyCondition(metav1.ConditionTrue, "ReconciliationSucceeded", "Applied revision: main/abc123")},
+		},
+	}
+
+	reconciler := setupReconciler(application(wego.SourceTypeGit, wego.DeploymentTypeKustomize), kustomization)
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: appName})
+	assert.NoError(t, err)
+
+	expected := `
+# HELP gitops_application_ready Whether the automation of an Application is ready (1) or not (0).
+# TYPE gitops_application_ready gauge
+gitops_application_ready{name="my-app",namespace="wego-system"} 1
+# HELP gitops_application_suspended Whether the automation of an Application is suspended (1) or not (0).
+# TYPE gitops_application_suspended gauge
+gitops_application_suspended{name="my-app",namespace="wego-system"} 1
+`
+	assert.NoError(t, testutil.GatherAndCompare(ctrlmetrics.Registry, strings.NewReader(expected), "gitops_application_ready", "gitops_application_suspended"))
+
+	_, err = setupReconciler().Reconcile(context.Background(), ctrl.Request{NamespacedName: appName})
+	assert.NoError(t, err)
+
+	assert.NoError(t, testutil.GatherAndCompare(ctrlmetrics.Registry, strings.NewReader(""), "gitops_application_ready", "gitops_application_suspended"))
+}
+
+func TestApplicationForFluxObject(t *testing.T) {
+	requests := applicationForFluxObject(&kustomizev2.Kustomization{ObjectMeta: objectMeta()})
+	assert.Len(t, requests, 1)
+	assert.Equal(t, appName, requests[0].NamespacedName)
+}
+
+func setupReconciler(objects ...client.Object) *ApplicationReconciler {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(wego.AddToScheme(scheme))
+	utilruntime.Must(sourcev1.AddToScheme(scheme))
+	utilruntime.Must(kustomizev2.AddToScheme(scheme))
+	utilruntime.Must(helmv2.AddToScheme(scheme))
+
+	return &ApplicationReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+	}
+}
+
+func getApplication(t *testing.T, reconciler *ApplicationReconciler) wego.Application {
+	var app wego.Application
+	assert.NoError(t, reconciler.Get(context.Background(), appName, &app))
+
+	return app
+}
+
+func application(sourceType wego.SourceType, deploymentType wego.DeploymentType) *wego.Application {
+	return &wego.Application{
+		ObjectMeta: objectMeta(),
+		Spec: wego.ApplicationSpec{
+			SourceType:     sourceType,
+			DeploymentType: deploymentType,
+		},
+	}
+}
+
+func objectMeta() metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: appName.Name, Namespace: appName.Namespace}
+}
+
+func readyCondition(status metav1.ConditionStatus, reason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               wego.ReadyCondition,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: reconcileTime,
+	}
+}
@@ -0,0 +1,98 @@
+package watcher
+
+import (
+	"context"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/app/watcher/controller"
+)
+
+var (
+	scheme   = runtime.NewScheme()
+	setupLog = ctrl.Log.WithName("app-watcher-setup")
+)
+
+type Options struct {
+	// MetricsBindAddress defaults to "0", which disables the metrics endpoint so
+	// the watcher can run next to the helm watcher in the same process.
+	MetricsBindAddress string
+	// LeaderElection makes the replicas of the watcher elect a leader, so that only one of
+	// them reconciles the applications. The lock is kept in LeaderElectionNamespace.
+	LeaderElection          bool
+	LeaderElectionNamespace string
+}
+
+type Watcher struct {
+	metricsBindAddress      string
+	leaderElection          bool
+	leaderElectionNamespace string
+}
+
+const leaderElectionID = "app-watcher.wego.weave.works"
+
+func NewWatcher(opts Options) (*Watcher, error) {
+	for _, add := range []func(*runtime.Scheme) error{
+		clientgoscheme.AddToScheme,
+		wego.AddToScheme,
+		sourcev1.AddToScheme,
+		kustomizev2.AddToScheme,
+		helmv2.AddToScheme,
+	} {
+		if err := add(scheme); err != nil {
+			return nil, err
+		}
+	}
+
+	metricsBindAddress := opts.MetricsBindAddress
+	if metricsBindAddress == "" {
+		metricsBindAddress = "0"
+	}
+
+	return &Watcher{
+		metricsBindAddress:      metricsBindAddress,
+		leaderElection:          opts.LeaderElection,
+		leaderElectionNamespace: opts.LeaderElectionNamespace,
+	}, nil
+}
+
+// StartWatcher runs the application controller until ctx is done. It does not install
+// a signal handler, as controller-runtime only allows one per process and the helm
+// watcher already owns it.
+func (w *Watcher) StartWatcher(ctx context.Context) error {
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+		Scheme:                  scheme,
+		MetricsBindAddress:      w.metricsBindAddress,
+		Logger:                  ctrl.Log,
+		LeaderElection:          w.leaderElection,
+		LeaderElectionID:        leaderElectionID,
+		LeaderElectionNamespace: w.leaderElectionNamespace,
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to create manager")
+		return err
+	}
+
+	if err = (&controller.ApplicationReconciler{
+		Client: mgr.GetClient(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ApplicationReconciler")
+		return err
+	}
+
+	setupLog.Info("starting manager")
+
+	if err := mgr.Start(ctx); err != nil {
+		setupLog.Error(err, "problem running manager")
+		return err
+	}
+
+	return nil
+}
@@ -3,7 +3,10 @@ package capi
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
+
+	"github.com/weaveworks/weave-gitops/pkg/printer"
 )
 
 // TemplatesRetriever defines the interface that adapters
@@ -39,17 +42,17 @@ type CredentialsRetriever interface {
 }
 
 type Template struct {
-	Name        string
-	Description string
-	Provider    string
-	Error       string
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Provider    string `json:"provider"`
+	Error       string `json:"error"`
 }
 
 type TemplateParameter struct {
-	Name        string
-	Description string
-	Required    bool
-	Options     []string
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Required    bool     `json:"required"`
+	Options     []string `json:"options"`
 }
 
 type Credentials struct {
@@ -78,120 +81,100 @@ type CreatePullRequestFromTemplateParams struct {
 	CommitMessage    string
 	Credentials      Credentials
 	ProfileValues    []ProfileValues
+	Reviewers        []string
+	Labels           []string
+	Assignees        []string
+	Draft            bool
 }
 
 type Profile struct {
-	Name              string
-	Home              string
-	Sources           []string
-	Description       string
-	Keywords          []string
-	Maintainers       []Maintainer
-	Icon              string
-	Annotations       map[string]string
-	KubeVersion       string
-	HelmRepository    HelmRepository
-	AvailableVersions []string
+	Name              string            `json:"name"`
+	Home              string            `json:"home"`
+	Sources           []string          `json:"sources"`
+	Description       string            `json:"description"`
+	Keywords          []string          `json:"keywords"`
+	Maintainers       []Maintainer      `json:"maintainers"`
+	Icon              string            `json:"icon"`
+	Annotations       map[string]string `json:"annotations"`
+	KubeVersion       string            `json:"kubeVersion"`
+	HelmRepository    HelmRepository    `json:"helmRepository"`
+	AvailableVersions []string          `json:"availableVersions"`
 }
 
 type HelmRepository struct {
-	Name      string
-	Namespace string
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
 }
 
 type Maintainer struct {
-	Name  string
-	Email string
-	Url   string
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Url   string `json:"url"`
 }
 
 // GetTemplates uses a TemplatesRetriever adapter to show
 // a list of templates to the console.
-func GetTemplates(r TemplatesRetriever, w io.Writer) error {
+func GetTemplates(r TemplatesRetriever, w io.Writer, p printer.Printer) error {
 	ts, err := r.RetrieveTemplates()
 	if err != nil {
 		return fmt.Errorf("unable to retrieve templates from %q: %w", r.Source(), err)
 	}
 
-	if len(ts) > 0 {
-		fmt.Fprintf(w, "NAME\tPROVIDER\tDESCRIPTION\tERROR\n")
-
-		for _, t := range ts {
-			fmt.Fprintf(w, "%s", t.Name)
-			fmt.Fprintf(w, "\t%s", t.Provider)
-			fmt.Fprintf(w, "\t%s", t.Description)
-			fmt.Fprintf(w, "\t%s", t.Error)
-			fmt.Fprintln(w, "")
-		}
-
-		return nil
-	}
-
-	fmt.Fprintf(w, "No templates were found.\n")
-
-	return nil
+	return p.Print(w, ts, templatesTable(ts, "No templates were found.\n"))
 }
 
 // GetTemplatesByProvider uses a TemplatesRetriever adapter to show
 // a list of templates for a given provider to the console.
-func GetTemplatesByProvider(provider string, r TemplatesRetriever, w io.Writer) error {
+func GetTemplatesByProvider(provider string, r TemplatesRetriever, w io.Writer, p printer.Printer) error {
 	ts, err := r.RetrieveTemplatesByProvider(provider)
 	if err != nil {
 		return fmt.Errorf("unable to retrieve templates from %q: %w", r.Source(), err)
 	}
 
-	if len(ts) > 0 {
-		fmt.Fprintf(w, "NAME\tPROVIDER\tDESCRIPTION\tERROR\n")
-
-		for _, t := range ts {
-			fmt.Fprintf(w, "%s", t.Name)
-			fmt.Fprintf(w, "\t%s", t.Provider)
-			fmt.Fprintf(w, "\t%s", t.Description)
-			fmt.Fprintf(w, "\t%s", t.Error)
-			fmt.Fprintln(w, "")
-		}
+	return p.Print(w, ts, templatesTable(ts, fmt.Sprintf("No templates were found for provider %q.\n", provider)))
+}
 
-		return nil
+func templatesTable(ts []Template, empty string) printer.Table {
+	table := printer.Table{
+		Columns: []printer.Column{{Header: "NAME"}, {Header: "PROVIDER"}, {Header: "DESCRIPTION"}, {Header: "ERROR"}},
+		Empty:   empty,
 	}
 
-	fmt.Fprintf(w, "No templates were found for provider %q.\n", provider)
+	for _, t := range ts {
+		table.Rows = append(table.Rows, []string{t.Name, t.Provider, t.Description, t.Error})
+	}
 
-	return nil
+	return table
 }
 
 // GetTemplateParameters uses a TemplatesRetriever adapter
 // to show a list of parameters for a given template.
-func GetTemplateParameters(name string, r TemplatesRetriever, w io.Writer) error {
+func GetTemplateParameters(name string, r TemplatesRetriever, w io.Writer, p printer.Printer) error {
 	ps, err := r.RetrieveTemplateParameters(name)
 	if err != nil {
 		return fmt.Errorf("unable to retrieve parameters for template %q from %q: %w", name, r.Source(), err)
 	}
 
-	if len(ps) > 0 {
-		fmt.Fprintf(w, "NAME\tREQUIRED\tDESCRIPTION\tOPTIONS\n")
-
-		for _, t := range ps {
-			fmt.Fprintf(w, "%s", t.Name)
-			fmt.Fprintf(w, "\t%t", t.Required)
+	table := printer.Table{
+		Columns: []printer.Column{{Header: "NAME"}, {Header: "REQUIRED"}, {Header: "DESCRIPTION"}, {Header: "OPTIONS"}},
+		Empty:   "No template parameters were found.\n",
+	}
 
-			if t.Description != "" {
-				fmt.Fprintf(w, "\t%s", t.Description)
-			}
+	for _, t := range ps {
+		row := []string{t.Name, strconv.FormatBool(t.Required)}
 
-			if t.Options != nil {
-				optionsStr := strings.Join(t.Options, ", ")
-				fmt.Fprintf(w, "\t%s", optionsStr)
-			}
+		if t.Description != "" {
+			row = append(row, t.Description)
+		}
 
-			fmt.Fprintln(w, "")
+		if t.Options != nil {
+			row = append(row, strings.Join(t.Options, ", "))
 		}
 
-		return nil
+		table.Rows = append(table.Rows, row)
 	}
 
-	fmt.Fprintf(w, "No template parameters were found.\n")
-
-	return nil
+	return p.Print(w, ps, table)
 }
 
 // RenderTemplate uses a TemplateRenderer adapter to show
@@ -227,58 +210,47 @@ func CreatePullRequestFromTemplate(params CreatePullRequestFromTemplateParams, r
 
 // GetCredentials uses a CredentialsRetriever adapter to show
 // a list of CAPI credentials.
-func GetCredentials(r CredentialsRetriever, w io.Writer) error {
+func GetCredentials(r CredentialsRetriever, w io.Writer, p printer.Printer) error {
 	cs, err := r.RetrieveCredentials()
 	if err != nil {
 		return fmt.Errorf("unable to retrieve credentials from %q: %w", r.Source(), err)
 	}
 
-	if len(cs) > 0 {
-		fmt.Fprintf(w, "NAME\tINFRASTRUCTURE PROVIDER\n")
-
-		for _, c := range cs {
-			fmt.Fprintf(w, "%s", c.Name)
-			// Extract the infra provider name from ClusterKind
-			provider := c.Kind[:strings.Index(c.Kind, "Cluster")]
-			fmt.Fprintf(w, "\t%s", provider)
-			fmt.Fprintln(w, "")
-		}
-
-		return nil
+	table := printer.Table{
+		Columns: []printer.Column{{Header: "NAME"}, {Header: "INFRASTRUCTURE PROVIDER"}, {Header: "NAMESPACE", Wide: true}},
+		Empty:   "No credentials were found.\n",
 	}
 
-	fmt.Fprintf(w, "No credentials were found.\n")
+	for _, c := range cs {
+		// Extract the infra provider name from ClusterKind
+		provider := c.Kind[:strings.Index(c.Kind, "Cluster")]
+		table.Rows = append(table.Rows, []string{c.Name, provider, c.Namespace})
+	}
 
-	return nil
+	return p.Print(w, cs, table)
 }
 
 // GetTemplateProfiles uses a TemplatesRetriever adapter
 // to show a list of profiles for a given template.
-func GetTemplateProfiles(name string, r TemplatesRetriever, w io.Writer) error {
+func GetTemplateProfiles(name string, r TemplatesRetriever, w io.Writer, p printer.Printer) error {
 	ps, err := r.RetrieveTemplateProfiles(name)
 	if err != nil {
 		return fmt.Errorf("unable to retrieve profiles for template %q from %q: %w", name, r.Source(), err)
 	}
 
-	if len(ps) > 0 {
-		fmt.Fprintf(w, "NAME\tLATEST_VERSIONS\n")
-
-		for _, p := range ps {
-			if len(p.AvailableVersions) > 5 {
-				p.AvailableVersions = p.AvailableVersions[len(p.AvailableVersions)-5:]
-			}
-
-			latestVersions := strings.Join(p.AvailableVersions, ", ")
+	table := printer.Table{
+		Columns: []printer.Column{{Header: "NAME"}, {Header: "LATEST_VERSIONS"}},
+		Empty:   "No template profiles were found.\n",
+	}
 
-			fmt.Fprintf(w, "%s", p.Name)
-			fmt.Fprintf(w, "\t%s", latestVersions)
-			fmt.Fprintln(w, "")
+	for _, profile := range ps {
+		versions := profile.AvailableVersions
+		if len(versions) > 5 {
+			versions = versions[len(versions)-5:]
 		}
 
-		return nil
+		table.Rows = append(table.Rows, []string{profile.Name, strings.Join(versions, ", ")})
 	}
 
-	fmt.Fprintf(w, "No template profiles were found.\n")
-
-	return nil
+	return p.Print(w, ps, table)
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/weaveworks/weave-gitops/pkg/capi"
+	"github.com/weaveworks/weave-gitops/pkg/printer"
 )
 
 func TestGetTemplates(t *testing.T) {
@@ -63,7 +64,7 @@ func TestGetTemplates(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			c := newFakeClient(tt.ts, nil, nil, nil, "", tt.err)
 			w := new(bytes.Buffer)
-			err := capi.GetTemplates(c, w)
+			err := capi.GetTemplates(c, w, printer.Default)
 			assert.Equal(t, tt.expected, w.String())
 			if err != nil {
 				assert.EqualError(t, err, tt.expectedErrorStr)
@@ -131,7 +132,7 @@ func TestGetTemplatesByProvider(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			c := newFakeClient(tt.ts, nil, nil, nil, "", tt.err)
 			w := new(bytes.Buffer)
-			err := capi.GetTemplatesByProvider(tt.provider, c, w)
+			err := capi.GetTemplatesByProvider(tt.provider, c, w, printer.Default)
 			assert.Equal(t, tt.expected, w.String())
 			if err != nil {
 				assert.EqualError(t, err, tt.expectedErrorStr)
@@ -192,7 +193,7 @@ func TestGetTemplateParameters(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			c := newFakeClient(nil, tt.tps, nil, nil, "", tt.err)
 			w := new(bytes.Buffer)
-			err := capi.GetTemplateParameters("foo", c, w)
+			err := capi.GetTemplateParameters("foo", c, w, printer.Default)
 			assert.Equal(t, tt.expected, w.String())
 			if err != nil {
 				assert.EqualError(t, err, tt.expectedErrorStr)
@@ -340,7 +341,7 @@ func TestGetCredentials(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			c := newFakeClient(nil, nil, tt.creds, nil, "", tt.err)
 			w := new(bytes.Buffer)
-			err := capi.GetCredentials(c, w)
+			err := capi.GetCredentials(c, w, printer.Default)
 			assert.Equal(t, tt.expected, w.String())
 			if err != nil {
 				assert.EqualError(t, err, tt.expectedErrorStr)
@@ -399,7 +400,7 @@ func TestGetTemplateProfiles(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			c := newFakeClient(nil, nil, nil, tt.fs, "", tt.err)
 			w := new(bytes.Buffer)
-			err := capi.GetTemplateProfiles("profile-b", c, w)
+			err := capi.GetTemplateProfiles("profile-b", c, w, printer.Default)
 			assert.Equal(t, tt.expected, w.String())
 			if err != nil {
 				assert.EqualError(t, err, tt.expectedErrorStr)
@@ -408,6 +409,69 @@ func TestGetTemplateProfiles(t *testing.T) {
 	}
 }
 
+func TestGetTemplatesOutputFormats(t *testing.T) {
+	ts := []capi.Template{
+		{
+			Name:        "template-a",
+			Description: "a desc",
+			Provider:    "aws",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		output   string
+		ts       []capi.Template
+		expected string
+	}{
+		{
+			name:     "json",
+			output:   "json",
+			ts:       ts,
+			expected: "[\n  {\n    \"description\": \"a desc\",\n    \"error\": \"\",\n    \"name\": \"template-a\",\n    \"provider\": \"aws\"\n  }\n]\n",
+		},
+		{
+			name:     "yaml",
+			output:   "yaml",
+			ts:       ts,
+			expected: "- description: a desc\n  error: \"\"\n  name: template-a\n  provider: aws\n",
+		},
+		{
+			name:     "no templates in json",
+			output:   "json",
+			expected: "[]\n",
+		},
+		{
+			name:     "jsonpath",
+			output:   "jsonpath={[*].name}",
+			ts:       ts,
+			expected: "template-a\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := printer.New(tt.output)
+			assert.NoError(t, err)
+
+			c := newFakeClient(tt.ts, nil, nil, nil, "", nil)
+			w := new(bytes.Buffer)
+			assert.NoError(t, capi.GetTemplates(c, w, p))
+			assert.Equal(t, tt.expected, w.String())
+		})
+	}
+}
+
+func TestGetCredentialsWide(t *testing.T) {
+	p, err := printer.New("wide")
+	assert.NoError(t, err)
+
+	c := newFakeClient(nil, nil, []capi.Credentials{{Name: "creds-a", Kind: "AWSCluster", Namespace: "default"}}, nil, "", nil)
+	w := new(bytes.Buffer)
+	assert.NoError(t, capi.GetCredentials(c, w, p))
+	assert.Equal(t, "NAME\tINFRASTRUCTURE PROVIDER\tNAMESPACE\ncreds-a\tAWS\tdefault\n", w.String())
+}
+
 type fakeClient struct {
 	ts  []capi.Template
 	ps  []capi.TemplateParameter
@@ -3,6 +3,8 @@ package clusters
 import (
 	"fmt"
 	"io"
+
+	"github.com/weaveworks/weave-gitops/pkg/printer"
 )
 
 // ClustersRetriever defines the interface that adapters
@@ -27,50 +29,32 @@ type PullRequest struct {
 
 // GetClusters uses a ClustersRetriever adapter to show
 // a list of clusters to the console.
-func GetClusters(r ClustersRetriever, w io.Writer) error {
+func GetClusters(r ClustersRetriever, w io.Writer, p printer.Printer) error {
 	cs, err := r.RetrieveClusters()
 	if err != nil {
 		return fmt.Errorf("unable to retrieve clusters from %q: %w", r.Source(), err)
 	}
 
-	if len(cs) > 0 {
-		fmt.Fprintf(w, "NAME\tSTATUS\tSTATUS_MESSAGE\n")
-
-		for _, c := range cs {
-			printCluster(c, w)
-		}
-
-		return nil
-	}
-
-	fmt.Fprintf(w, "No clusters found.\n")
-
-	return nil
+	return p.Print(w, cs, clustersTable(cs, len(cs) == 0))
 }
 
 // GetClusterByName uses a ClustersRetriever adapter to show
 // a cluster to the console given its name.
-func GetClusterByName(name string, r ClustersRetriever, w io.Writer) error {
+func GetClusterByName(name string, r ClustersRetriever, w io.Writer, p printer.Printer) error {
 	cs, err := r.RetrieveClusters()
 	if err != nil {
 		return fmt.Errorf("unable to retrieve clusters from %q: %w", r.Source(), err)
 	}
 
-	if len(cs) > 0 {
-		fmt.Fprintf(w, "NAME\tSTATUS\tSTATUS_MESSAGE\n")
+	matching := []Cluster{}
 
-		for _, c := range cs {
-			if c.Name == name {
-				printCluster(c, w)
-			}
+	for _, c := range cs {
+		if c.Name == name {
+			matching = append(matching, c)
 		}
-
-		return nil
 	}
 
-	fmt.Fprintf(w, "No clusters found.\n")
-
-	return nil
+	return p.Print(w, matching, clustersTable(matching, len(cs) == 0))
 }
 
 func GetClusterKubeconfig(name string, r ClustersRetriever, w io.Writer) error {
@@ -106,7 +90,24 @@ type DeleteClustersParams struct {
 	CommitMessage    string
 }
 
-func printCluster(c Cluster, w io.Writer) {
+// clustersTable only has an empty message when no cluster at all was retrieved, not when none matched a name
+func clustersTable(cs []Cluster, none bool) printer.Table {
+	table := printer.Table{
+		Columns: []printer.Column{{Header: "NAME"}, {Header: "STATUS"}, {Header: "STATUS_MESSAGE"}},
+	}
+
+	if none {
+		table.Empty = "No clusters found.\n"
+	}
+
+	for _, c := range cs {
+		table.Rows = append(table.Rows, clusterRow(c))
+	}
+
+	return table
+}
+
+func clusterRow(c Cluster) []string {
 	if c.Status == "pullRequestCreated" && c.PullRequest.Type == "create" {
 		c.Status = "Creation PR"
 	} else if c.PullRequest.Type == "delete" {
@@ -114,10 +115,8 @@ func printCluster(c Cluster, w io.Writer) {
 	}
 
 	if c.Status == "Creation PR" || c.Status == "Deletion PR" {
-		fmt.Fprintf(w, "%s\t%s\t%s", c.Name, c.Status, c.PullRequest.Url)
-		fmt.Fprintln(w, "")
-	} else {
-		fmt.Fprintf(w, "%s\t%s", c.Name, c.Status)
-		fmt.Fprintln(w, "")
+		return []string{c.Name, c.Status, c.PullRequest.Url}
 	}
+
+	return []string{c.Name, c.Status}
 }
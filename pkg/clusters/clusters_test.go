@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/weaveworks/weave-gitops/pkg/clusters"
+	"github.com/weaveworks/weave-gitops/pkg/printer"
 )
 
 func TestGetClusters(t *testing.T) {
@@ -89,7 +90,7 @@ func TestGetClusters(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewFakeClient(tt.cs, "", tt.err)
 			w := new(bytes.Buffer)
-			err := clusters.GetClusters(c, w)
+			err := clusters.GetClusters(c, w, printer.Default)
 			assert.Equal(t, tt.expected, w.String())
 			if err != nil {
 				assert.EqualError(t, err, tt.expectedErrorStr)
@@ -143,7 +144,7 @@ func TestGetClusterByName(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewFakeClient(tt.cs, "", tt.err)
 			w := new(bytes.Buffer)
-			err := clusters.GetClusterByName(tt.clusterName, c, w)
+			err := clusters.GetClusterByName(tt.clusterName, c, w, printer.Default)
 			assert.Equal(t, tt.expected, w.String())
 			if err != nil {
 				assert.EqualError(t, err, tt.expectedErrorStr)
@@ -152,6 +153,35 @@ func TestGetClusterByName(t *testing.T) {
 	}
 }
 
+func TestGetClustersJSON(t *testing.T) {
+	p, err := printer.New("json")
+	assert.NoError(t, err)
+
+	c := NewFakeClient([]clusters.Cluster{
+		{
+			Name:   "cluster-a",
+			Status: "pullRequestCreated",
+			PullRequest: clusters.PullRequest{
+				Type: "create",
+				Url:  "https://github.com/org/repo/pull/1",
+			},
+		},
+	}, "", nil)
+	w := new(bytes.Buffer)
+	assert.NoError(t, clusters.GetClusters(c, w, p))
+	assert.Equal(t, `[
+  {
+    "name": "cluster-a",
+    "pullRequest": {
+      "type": "create",
+      "url": "https://github.com/org/repo/pull/1"
+    },
+    "status": "pullRequestCreated"
+  }
+]
+`, w.String())
+}
+
 func TestDeleteClusters(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -27,10 +27,9 @@ type Flux interface {
 	CreateHelmReleaseGitRepository(name, source, path, namespace, targetNamespace string) ([]byte, error)
 	CreateHelmReleaseHelmRepository(name, chart, namespace, targetNamespace string) ([]byte, error)
 	CreateSecretGit(name string, repoUrl gitproviders.RepoURL, namespace string) ([]byte, error)
+	CreateSecretGitWithKey(name string, repoUrl gitproviders.RepoURL, namespace string, privateKeyFile string) ([]byte, error)
 	GetVersion() (string, error)
-	GetAllResourcesStatus(name string, namespace string) ([]byte, error)
 	SuspendOrResumeApp(pause wego.SuspendActionType, name, namespace, deploymentType string) ([]byte, error)
-	GetLatestStatusAllNamespaces() ([]string, error)
 	PreCheck() (string, error)
 }
 
@@ -98,29 +97,6 @@ func (f *FluxClient) Uninstall(namespace string, dryRun bool) error {
 	return nil
 }
 
-func (f *FluxClient) CreateSourceGit(name string, repoUrl gitproviders.RepoURL, branch string, secretRef string, namespace string) ([]byte, error) {
-	args := []string{
-		"create", "source", "git", name,
-		"--branch", branch,
-		"--namespace", namespace,
-		"--interval", "30s",
-		"--export",
-	}
-
-	if secretRef != "" {
-		args = append(args, "--secret-ref", secretRef, "--url", repoUrl.String())
-	} else {
-		args = append(args, "--url", makePublicUrl(repoUrl))
-	}
-
-	out, err := f.runFluxCmd(args...)
-	if err != nil {
-		return out, fmt.Errorf("failed to create source git: %w", err)
-	}
-
-	return out, nil
-}
-
 func makePublicUrl(repoUrl gitproviders.RepoURL) string {
 	trimmed := ""
 
@@ -147,116 +123,6 @@ func makePublicUrl(repoUrl gitproviders.RepoURL) string {
 	return url
 }
 
-func (f *FluxClient) CreateSourceHelm(name string, url string, namespace string) ([]byte, error) {
-	args := []string{
-		"create", "source", "helm", name,
-		"--url", url,
-		"--namespace", namespace,
-		"--interval", "30s",
-		"--export",
-	}
-
-	out, err := f.runFluxCmd(args...)
-	if err != nil {
-		return out, fmt.Errorf("failed to create source helm: %w", err)
-	}
-
-	return out, nil
-}
-
-func (f *FluxClient) CreateKustomization(name string, source string, path string, namespace string) ([]byte, error) {
-	args := []string{
-		"create", "kustomization", name,
-		"--path", path,
-		"--source", source,
-		"--namespace", namespace,
-		"--prune", "true",
-		"--interval", "1m",
-		"--export",
-	}
-
-	out, err := f.runFluxCmd(args...)
-	if err != nil {
-		return out, fmt.Errorf("failed to create kustomization: %w", err)
-	}
-
-	return out, nil
-}
-
-func (f *FluxClient) CreateHelmReleaseGitRepository(name, source, chartPath, namespace, targetNamespace string) ([]byte, error) {
-	args := []string{
-		"create", "helmrelease", name,
-		"--source", "GitRepository/" + source,
-		"--chart", chartPath,
-		"--namespace", namespace,
-		"--interval", "5m",
-		"--export",
-	}
-
-	if targetNamespace != "" {
-		args = append(args, "--target-namespace", targetNamespace)
-	}
-
-	out, err := f.runFluxCmd(args...)
-	if err != nil {
-		return out, fmt.Errorf("failed to create helm release git repo: %w", err)
-	}
-
-	return out, nil
-}
-
-func (f *FluxClient) CreateHelmReleaseHelmRepository(name, chart, namespace, targetNamespace string) ([]byte, error) {
-	args := []string{
-		"create", "helmrelease", name,
-		"--source", "HelmRepository/" + name,
-		"--chart", chart,
-		"--namespace", namespace,
-		"--interval", "5m",
-		"--export",
-	}
-
-	if targetNamespace != "" {
-		args = append(args, "--target-namespace", targetNamespace)
-	}
-
-	out, err := f.runFluxCmd(args...)
-	if err != nil {
-		return out, fmt.Errorf("failed to create helm release helm repo: %w", err)
-	}
-
-	return out, nil
-}
-
-// CreatSecretGit Creates a Git secret returns the deploy key
-func (f *FluxClient) CreateSecretGit(name string, repoUrl gitproviders.RepoURL, namespace string) ([]byte, error) {
-	args := []string{
-		"create", "secret", "git", name,
-		"--url", repoUrl.String(),
-		"--namespace", namespace,
-		"--export",
-	}
-
-	out, err := f.runFluxCmd(args...)
-	if err != nil {
-		return out, fmt.Errorf("failed to create secret git: %w", err)
-	}
-
-	return out, nil
-}
-
-func (f *FluxClient) GetAllResourcesStatus(name string, namespace string) ([]byte, error) {
-	args := []string{
-		"get", "all", "--namespace", namespace, name,
-	}
-
-	out, err := f.runFluxCmd(args...)
-	if err != nil {
-		return out, fmt.Errorf("failed to get flux resources status: %w", err)
-	}
-
-	return out, nil
-}
-
 func (f *FluxClient) GetVersion() (string, error) {
 	out, err := f.runFluxCmd("-v")
 	if err != nil {
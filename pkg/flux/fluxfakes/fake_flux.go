@@ -74,6 +74,22 @@ type FakeFlux struct {
 		result1 []byte
 		result2 error
 	}
+	CreateSecretGitWithKeyStub        func(string, gitproviders.RepoURL, string, string) ([]byte, error)
+	createSecretGitWithKeyMutex       sync.RWMutex
+	createSecretGitWithKeyArgsForCall []struct {
+		arg1 string
+		arg2 gitproviders.RepoURL
+		arg3 string
+		arg4 string
+	}
+	createSecretGitWithKeyReturns struct {
+		result1 []byte
+		result2 error
+	}
+	createSecretGitWithKeyReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
 	CreateSourceGitStub        func(string, gitproviders.RepoURL, string, string, string) ([]byte, error)
 	createSourceGitMutex       sync.RWMutex
 	createSourceGitArgsForCall []struct {
@@ -106,20 +122,6 @@ type FakeFlux struct {
 		result1 []byte
 		result2 error
 	}
-	GetAllResourcesStatusStub        func(string, string) ([]byte, error)
-	getAllResourcesStatusMutex       sync.RWMutex
-	getAllResourcesStatusArgsForCall []struct {
-		arg1 string
-		arg2 string
-	}
-	getAllResourcesStatusReturns struct {
-		result1 []byte
-		result2 error
-	}
-	getAllResourcesStatusReturnsOnCall map[int]struct {
-		result1 []byte
-		result2 error
-	}
 	GetBinPathStub        func() (string, error)
 	getBinPathMutex       sync.RWMutex
 	getBinPathArgsForCall []struct {
@@ -144,18 +146,6 @@ type FakeFlux struct {
 		result1 string
 		result2 error
 	}
-	GetLatestStatusAllNamespacesStub        func() ([]string, error)
-	getLatestStatusAllNamespacesMutex       sync.RWMutex
-	getLatestStatusAllNamespacesArgsForCall []struct {
-	}
-	getLatestStatusAllNamespacesReturns struct {
-		result1 []string
-		result2 error
-	}
-	getLatestStatusAllNamespacesReturnsOnCall map[int]struct {
-		result1 []string
-		result2 error
-	}
 	GetVersionStub        func() (string, error)
 	getVersionMutex       sync.RWMutex
 	getVersionArgsForCall []struct {
@@ -498,6 +488,73 @@ func (fake *FakeFlux) CreateSecretGitReturnsOnCall(i int, result1 []byte, result
 	}{result1, result2}
 }
 
+func (fake *FakeFlux) CreateSecretGitWithKey(arg1 string, arg2 gitproviders.RepoURL, arg3 string, arg4 string) ([]byte, error) {
+	fake.createSecretGitWithKeyMutex.Lock()
+	ret, specificReturn := fake.createSecretGitWithKeyReturnsOnCall[len(fake.createSecretGitWithKeyArgsForCall)]
+	fake.createSecretGitWithKeyArgsForCall = append(fake.createSecretGitWithKeyArgsForCall, struct {
+		arg1 string
+		arg2 gitproviders.RepoURL
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateSecretGitWithKeyStub
+	fakeReturns := fake.createSecretGitWithKeyReturns
+	fake.recordInvocation("CreateSecretGitWithKey", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createSecretGitWithKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFlux) CreateSecretGitWithKeyCallCount() int {
+	fake.createSecretGitWithKeyMutex.RLock()
+	defer fake.createSecretGitWithKeyMutex.RUnlock()
+	return len(fake.createSecretGitWithKeyArgsForCall)
+}
+
+func (fake *FakeFlux) CreateSecretGitWithKeyCalls(stub func(string, gitproviders.RepoURL, string, string) ([]byte, error)) {
+	fake.createSecretGitWithKeyMutex.Lock()
+	defer fake.createSecretGitWithKeyMutex.Unlock()
+	fake.CreateSecretGitWithKeyStub = stub
+}
+
+func (fake *FakeFlux) CreateSecretGitWithKeyArgsForCall(i int) (string, gitproviders.RepoURL, string, string) {
+	fake.createSecretGitWithKeyMutex.RLock()
+	defer fake.createSecretGitWithKeyMutex.RUnlock()
+	argsForCall := fake.createSecretGitWithKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeFlux) CreateSecretGitWithKeyReturns(result1 []byte, result2 error) {
+	fake.createSecretGitWithKeyMutex.Lock()
+	defer fake.createSecretGitWithKeyMutex.Unlock()
+	fake.CreateSecretGitWithKeyStub = nil
+	fake.createSecretGitWithKeyReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFlux) CreateSecretGitWithKeyReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.createSecretGitWithKeyMutex.Lock()
+	defer fake.createSecretGitWithKeyMutex.Unlock()
+	fake.CreateSecretGitWithKeyStub = nil
+	if fake.createSecretGitWithKeyReturnsOnCall == nil {
+		fake.createSecretGitWithKeyReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.createSecretGitWithKeyReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeFlux) CreateSourceGit(arg1 string, arg2 gitproviders.RepoURL, arg3 string, arg4 string, arg5 string) ([]byte, error) {
 	fake.createSourceGitMutex.Lock()
 	ret, specificReturn := fake.createSourceGitReturnsOnCall[len(fake.createSourceGitArgsForCall)]
@@ -632,71 +689,6 @@ func (fake *FakeFlux) CreateSourceHelmReturnsOnCall(i int, result1 []byte, resul
 	}{result1, result2}
 }
 
-func (fake *FakeFlux) GetAllResourcesStatus(arg1 string, arg2 string) ([]byte, error) {
-	fake.getAllResourcesStatusMutex.Lock()
-	ret, specificReturn := fake.getAllResourcesStatusReturnsOnCall[len(fake.getAllResourcesStatusArgsForCall)]
-	fake.getAllResourcesStatusArgsForCall = append(fake.getAllResourcesStatusArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.GetAllResourcesStatusStub
-	fakeReturns := fake.getAllResourcesStatusReturns
-	fake.recordInvocation("GetAllResourcesStatus", []interface{}{arg1, arg2})
-	fake.getAllResourcesStatusMutex.Unlock()
-	if stub != nil {
-		return stub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2
-	}
-	return fakeReturns.result1, fakeReturns.result2
-}
-
-func (fake *FakeFlux) GetAllResourcesStatusCallCount() int {
-	fake.getAllResourcesStatusMutex.RLock()
-	defer fake.getAllResourcesStatusMutex.RUnlock()
-	return len(fake.getAllResourcesStatusArgsForCall)
-}
-
-func (fake *FakeFlux) GetAllResourcesStatusCalls(stub func(string, string) ([]byte, error)) {
-	fake.getAllResourcesStatusMutex.Lock()
-	defer fake.getAllResourcesStatusMutex.Unlock()
-	fake.GetAllResourcesStatusStub = stub
-}
-
-func (fake *FakeFlux) GetAllResourcesStatusArgsForCall(i int) (string, string) {
-	fake.getAllResourcesStatusMutex.RLock()
-	defer fake.getAllResourcesStatusMutex.RUnlock()
-	argsForCall := fake.getAllResourcesStatusArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
-func (fake *FakeFlux) GetAllResourcesStatusReturns(result1 []byte, result2 error) {
-	fake.getAllResourcesStatusMutex.Lock()
-	defer fake.getAllResourcesStatusMutex.Unlock()
-	fake.GetAllResourcesStatusStub = nil
-	fake.getAllResourcesStatusReturns = struct {
-		result1 []byte
-		result2 error
-	}{result1, result2}
-}
-
-func (fake *FakeFlux) GetAllResourcesStatusReturnsOnCall(i int, result1 []byte, result2 error) {
-	fake.getAllResourcesStatusMutex.Lock()
-	defer fake.getAllResourcesStatusMutex.Unlock()
-	fake.GetAllResourcesStatusStub = nil
-	if fake.getAllResourcesStatusReturnsOnCall == nil {
-		fake.getAllResourcesStatusReturnsOnCall = make(map[int]struct {
-			result1 []byte
-			result2 error
-		})
-	}
-	fake.getAllResourcesStatusReturnsOnCall[i] = struct {
-		result1 []byte
-		result2 error
-	}{result1, result2}
-}
-
 func (fake *FakeFlux) GetBinPath() (string, error) {
 	fake.getBinPathMutex.Lock()
 	ret, specificReturn := fake.getBinPathReturnsOnCall[len(fake.getBinPathArgsForCall)]
@@ -809,62 +801,6 @@ func (fake *FakeFlux) GetExePathReturnsOnCall(i int, result1 string, result2 err
 	}{result1, result2}
 }
 
-func (fake *FakeFlux) GetLatestStatusAllNamespaces() ([]string, error) {
-	fake.getLatestStatusAllNamespacesMutex.Lock()
-	ret, specificReturn := fake.getLatestStatusAllNamespacesReturnsOnCall[len(fake.getLatestStatusAllNamespacesArgsForCall)]
-	fake.getLatestStatusAllNamespacesArgsForCall = append(fake.getLatestStatusAllNamespacesArgsForCall, struct {
-	}{})
-	stub := fake.GetLatestStatusAllNamespacesStub
-	fakeReturns := fake.getLatestStatusAllNamespacesReturns
-	fake.recordInvocation("GetLatestStatusAllNamespaces", []interface{}{})
-	fake.getLatestStatusAllNamespacesMutex.Unlock()
-	if stub != nil {
-		return stub()
-	}
-	if specificReturn {
-		return ret.result1, ret.result2
-	}
-	return fakeReturns.result1, fakeReturns.result2
-}
-
-func (fake *FakeFlux) GetLatestStatusAllNamespacesCallCount() int {
-	fake.getLatestStatusAllNamespacesMutex.RLock()
-	defer fake.getLatestStatusAllNamespacesMutex.RUnlock()
-	return len(fake.getLatestStatusAllNamespacesArgsForCall)
-}
-
-func (fake *FakeFlux) GetLatestStatusAllNamespacesCalls(stub func() ([]string, error)) {
-	fake.getLatestStatusAllNamespacesMutex.Lock()
-	defer fake.getLatestStatusAllNamespacesMutex.Unlock()
-	fake.GetLatestStatusAllNamespacesStub = stub
-}
-
-func (fake *FakeFlux) GetLatestStatusAllNamespacesReturns(result1 []string, result2 error) {
-	fake.getLatestStatusAllNamespacesMutex.Lock()
-	defer fake.getLatestStatusAllNamespacesMutex.Unlock()
-	fake.GetLatestStatusAllNamespacesStub = nil
-	fake.getLatestStatusAllNamespacesReturns = struct {
-		result1 []string
-		result2 error
-	}{result1, result2}
-}
-
-func (fake *FakeFlux) GetLatestStatusAllNamespacesReturnsOnCall(i int, result1 []string, result2 error) {
-	fake.getLatestStatusAllNamespacesMutex.Lock()
-	defer fake.getLatestStatusAllNamespacesMutex.Unlock()
-	fake.GetLatestStatusAllNamespacesStub = nil
-	if fake.getLatestStatusAllNamespacesReturnsOnCall == nil {
-		fake.getLatestStatusAllNamespacesReturnsOnCall = make(map[int]struct {
-			result1 []string
-			result2 error
-		})
-	}
-	fake.getLatestStatusAllNamespacesReturnsOnCall[i] = struct {
-		result1 []string
-		result2 error
-	}{result1, result2}
-}
-
 func (fake *FakeFlux) GetVersion() (string, error) {
 	fake.getVersionMutex.Lock()
 	ret, specificReturn := fake.getVersionReturnsOnCall[len(fake.getVersionArgsForCall)]
@@ -1206,18 +1142,16 @@ func (fake *FakeFlux) Invocations() map[string][][]interface{} {
 	defer fake.createKustomizationMutex.RUnlock()
 	fake.createSecretGitMutex.RLock()
 	defer fake.createSecretGitMutex.RUnlock()
+	fake.createSecretGitWithKeyMutex.RLock()
+	defer fake.createSecretGitWithKeyMutex.RUnlock()
 	fake.createSourceGitMutex.RLock()
 	defer fake.createSourceGitMutex.RUnlock()
 	fake.createSourceHelmMutex.RLock()
 	defer fake.createSourceHelmMutex.RUnlock()
-	fake.getAllResourcesStatusMutex.RLock()
-	defer fake.getAllResourcesStatusMutex.RUnlock()
 	fake.getBinPathMutex.RLock()
 	defer fake.getBinPathMutex.RUnlock()
 	fake.getExePathMutex.RLock()
 	defer fake.getExePathMutex.RUnlock()
-	fake.getLatestStatusAllNamespacesMutex.RLock()
-	defer fake.getLatestStatusAllNamespacesMutex.RUnlock()
 	fake.getVersionMutex.RLock()
 	defer fake.getVersionMutex.RUnlock()
 	fake.installMutex.RLock()
@@ -0,0 +1,310 @@
+package flux
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+)
+
+const (
+	sourceInterval        = 30 * time.Second
+	kustomizationInterval = time.Minute
+	helmReleaseInterval   = 5 * time.Minute
+	hostKeyScanTimeout    = 30 * time.Second
+)
+
+// The Create* methods of FluxClient generate the manifests in Go. They match the output of
+// `flux create ... --export`, so only installing, uninstalling, suspending and resuming need
+// the flux binary.
+
+func (f *FluxClient) CreateSourceGit(name string, repoUrl gitproviders.RepoURL, branch string, secretRef string, namespace string) ([]byte, error) {
+	repoURL := makePublicUrl(repoUrl)
+	if secretRef != "" {
+		repoURL = repoUrl.String()
+	}
+
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create source git: %w", err)
+	}
+
+	gitRepository := sourcev1.GitRepository{
+		TypeMeta:   typeMeta(sourcev1.GroupVersion.String(), sourcev1.GitRepositoryKind),
+		ObjectMeta: objectMeta(name, namespace),
+		Spec: sourcev1.GitRepositorySpec{
+			URL:      u.String(),
+			Interval: metav1.Duration{Duration: sourceInterval},
+			Reference: &sourcev1.GitRepositoryRef{
+				Branch: branch,
+			},
+		},
+	}
+
+	if secretRef != "" {
+		gitRepository.Spec.SecretRef = &meta.LocalObjectReference{Name: secretRef}
+	}
+
+	out, err := export(gitRepository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create source git: %w", err)
+	}
+
+	return out, nil
+}
+
+func (f *FluxClient) CreateSourceHelm(name string, helmURL string, namespace string) ([]byte, error) {
+	u, err := url.Parse(helmURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create source helm: %w", err)
+	}
+
+	helmRepository := sourcev1.HelmRepository{
+		TypeMeta:   typeMeta(sourcev1.GroupVersion.String(), sourcev1.HelmRepositoryKind),
+		ObjectMeta: objectMeta(name, namespace),
+		Spec: sourcev1.HelmRepositorySpec{
+			URL:      u.String(),
+			Interval: metav1.Duration{Duration: sourceInterval},
+		},
+	}
+
+	out, err := export(helmRepository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create source helm: %w", err)
+	}
+
+	return out, nil
+}
+
+func (f *FluxClient) CreateKustomization(name string, source string, path string, namespace string) ([]byte, error) {
+	kind, sourceName := parseSourceRef(source)
+
+	kustomization := kustomizev2.Kustomization{
+		TypeMeta:   typeMeta(kustomizev2.GroupVersion.String(), kustomizev2.KustomizationKind),
+		ObjectMeta: objectMeta(name, namespace),
+		Spec: kustomizev2.KustomizationSpec{
+			Interval: metav1.Duration{Duration: kustomizationInterval},
+			Path:     safeRelativePath(path),
+			Prune:    true,
+			SourceRef: kustomizev2.CrossNamespaceSourceReference{
+				Kind: kind,
+				Name: sourceName,
+			},
+		},
+	}
+
+	out, err := export(kustomization)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kustomization: %w", err)
+	}
+
+	return out, nil
+}
+
+func (f *FluxClient) CreateHelmReleaseGitRepository(name, source, chartPath, namespace, targetNamespace string) ([]byte, error) {
+	out, err := export(helmRelease(name, sourcev1.GitRepositoryKind, source, chartPath, namespace, targetNamespace))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create helm release git repo: %w", err)
+	}
+
+	return out, nil
+}
+
+func (f *FluxClient) CreateHelmReleaseHelmRepository(name, chart, namespace, targetNamespace string) ([]byte, error) {
+	out, err := export(helmRelease(name, sourcev1.HelmRepositoryKind, name, chart, namespace, targetNamespace))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create helm release helm repo: %w", err)
+	}
+
+	return out, nil
+}
+
+// CreateSecretGit generates an ECDSA P-384 deploy key and scans the git host for its
+// known_hosts entry, the same as `flux create secret git` does for ssh urls.
+func (f *FluxClient) CreateSecretGit(name string, repoUrl gitproviders.RepoURL, namespace string) ([]byte, error) {
+	privateKey, publicKey, err := generateKeyPair()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secret git: %w", err)
+	}
+
+	return gitSecret(name, repoUrl, namespace, privateKey, publicKey)
+}
+
+// CreateSecretGitWithKey creates a git secret holding the private key of a file, the same as
+// `flux create secret git --private-key-file` does for ssh urls.
+func (f *FluxClient) CreateSecretGitWithKey(name string, repoUrl gitproviders.RepoURL, namespace string, privateKeyFile string) ([]byte, error) {
+	privateKey, err := ioutil.ReadFile(privateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secret git: %w", err)
+	}
+
+	signer, err := ssh.ParsePrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secret git: failed parsing private key %s: %w", privateKeyFile, err)
+	}
+
+	return gitSecret(name, repoUrl, namespace, privateKey, ssh.MarshalAuthorizedKey(signer.PublicKey()))
+}
+
+// gitSecret exports the secret of a key pair, with the known_hosts entry of the git host
+func gitSecret(name string, repoUrl gitproviders.RepoURL, namespace string, privateKey, publicKey []byte) ([]byte, error) {
+	u := repoUrl.URL()
+	if u.Scheme != "ssh" {
+		return nil, fmt.Errorf("failed to create secret git: ssh url expected, got %q", repoUrl.String())
+	}
+
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(host, "22")
+	}
+
+	hostKey, err := scanHostKey(host, hostKeyScanTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secret git: %w", err)
+	}
+
+	secret := corev1.Secret{
+		TypeMeta:   typeMeta("v1", "Secret"),
+		ObjectMeta: objectMeta(name, namespace),
+		StringData: map[string]string{
+			"identity":     string(privateKey),
+			"identity.pub": string(publicKey),
+			"known_hosts":  string(hostKey),
+		},
+	}
+
+	out, err := export(secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secret git: %w", err)
+	}
+
+	// flux prints the secret with a trailing newline
+	return append(out, '\n'), nil
+}
+
+func helmRelease(name, sourceKind, sourceName, chart, namespace, targetNamespace string) helmv2.HelmRelease {
+	return helmv2.HelmRelease{
+		TypeMeta:   typeMeta(helmv2.GroupVersion.String(), helmv2.HelmReleaseKind),
+		ObjectMeta: objectMeta(name, namespace),
+		Spec: helmv2.HelmReleaseSpec{
+			Interval:        metav1.Duration{Duration: helmReleaseInterval},
+			TargetNamespace: targetNamespace,
+			Chart: helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart: chart,
+					SourceRef: helmv2.CrossNamespaceObjectReference{
+						Kind: sourceKind,
+						Name: sourceName,
+					},
+				},
+			},
+		},
+	}
+}
+
+func typeMeta(apiVersion, kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{APIVersion: apiVersion, Kind: kind}
+}
+
+func objectMeta(name, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: name, Namespace: namespace}
+}
+
+// export serialises an object the way `flux create ... --export` prints it
+func export(obj interface{}) ([]byte, error) {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	data = bytes.Replace(data, []byte("  creationTimestamp: null\n"), []byte(""), 1)
+	data = bytes.Replace(data, []byte("status: {}\n"), []byte(""), 1)
+
+	return append([]byte("---\n"), data...), nil
+}
+
+// parseSourceRef splits a flux source reference of the form <kind>/<name>.
+// The kind defaults to GitRepository, as it does for `flux create kustomization --source`.
+func parseSourceRef(source string) (string, string) {
+	if parts := strings.SplitN(source, "/", 2); len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+
+	return sourcev1.GitRepositoryKind, source
+}
+
+// safeRelativePath normalises a path the way the flux CLI does for `--path`.
+// Like flux, it turns a leading dot directory such as .weave-gitops into ./weave-gitops.
+func safeRelativePath(p string) string {
+	clean := strings.TrimPrefix(path.Clean("/"+strings.TrimPrefix(p, ".")), "/")
+
+	return "./" + clean
+}
+
+func generateKeyPair() ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed generating key: %w", err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed encoding private key: %w", err)
+	}
+
+	pub, err := ssh.NewPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed encoding public key: %w", err)
+	}
+
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	return privateKey, ssh.MarshalAuthorizedKey(pub), nil
+}
+
+// scanHostKey connects to an ssh server to collect its host key in known_hosts format.
+// The connection is expected to fail authentication; the key is recorded during the handshake.
+func scanHostKey(host string, timeout time.Duration) ([]byte, error) {
+	var knownHosts []byte
+
+	config := &ssh.ClientConfig{
+		User: "git",
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			knownHosts = append(knownHosts, []byte(knownhosts.Line([]string{knownhosts.Normalize(hostname)}, key)+"\n")...)
+			return nil
+		},
+		Timeout: timeout,
+	}
+
+	client, err := ssh.Dial("tcp", host, config)
+	if err == nil {
+		defer client.Close()
+	}
+
+	if len(knownHosts) > 0 {
+		return knownHosts, nil
+	}
+
+	return nil, fmt.Errorf("failed scanning host key for %s: %w", host, err)
+}
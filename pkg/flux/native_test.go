@@ -0,0 +1,166 @@
+package flux_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+)
+
+var _ = Describe("Create manifests", func() {
+	AfterEach(func() {
+		Expect(runner.RunCallCount()).To(Equal(0), "the flux binary should not be called")
+	})
+
+	Describe("CreateSourceGit", func() {
+		It("creates a git source with a secret", func() {
+			repoUrl, err := gitproviders.NewRepoURL("https://github.com/foo/my-name")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			out, err := fluxClient.CreateSourceGit("my-name", repoUrl, "main", "my-secret", wego.DefaultNamespace)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(out)).To(Equal(`---
+apiVersion: source.toolkit.fluxcd.io/v1beta1
+kind: GitRepository
+metadata:
+  name: my-name
+  namespace: wego-system
+spec:
+  interval: 30s
+  ref:
+    branch: main
+  secretRef:
+    name: my-secret
+  url: ssh://git@github.com/foo/my-name.git
+`))
+		})
+
+		It("creates a git source for a public repo", func() {
+			repoUrl, err := gitproviders.NewRepoURL("ssh://git@gitlab.com/foo/my-name")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			out, err := fluxClient.CreateSourceGit("my-name", repoUrl, "main", "", wego.DefaultNamespace)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(out)).To(Equal(`---
+apiVersion: source.toolkit.fluxcd.io/v1beta1
+kind: GitRepository
+metadata:
+  name: my-name
+  namespace: wego-system
+spec:
+  interval: 30s
+  ref:
+    branch: main
+  url: https://gitlab.com/foo/my-name.git
+`))
+		})
+	})
+
+	It("creates a helm source", func() {
+		out, err := fluxClient.CreateSourceHelm("my-name", "https://charts.example.com/foo", wego.DefaultNamespace)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(out)).To(Equal(`---
+apiVersion: source.toolkit.fluxcd.io/v1beta1
+kind: HelmRepository
+metadata:
+  name: my-name
+  namespace: wego-system
+spec:
+  interval: 30s
+  url: https://charts.example.com/foo
+`))
+	})
+
+	Describe("CreateKustomization", func() {
+		It("creates a kustomization", func() {
+			out, err := fluxClient.CreateKustomization("my-name", "my-source", "./deploy", wego.DefaultNamespace)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(out)).To(Equal(`---
+apiVersion: kustomize.toolkit.fluxcd.io/v1beta2
+kind: Kustomization
+metadata:
+  name: my-name
+  namespace: wego-system
+spec:
+  interval: 1m0s
+  path: ./deploy
+  prune: true
+  sourceRef:
+    kind: GitRepository
+    name: my-source
+`))
+		})
+
+		It("normalises the path like flux does", func() {
+			out, err := fluxClient.CreateKustomization("my-name", "my-source", ".weave-gitops/apps/my-name", wego.DefaultNamespace)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("  path: ./weave-gitops/apps/my-name\n"))
+
+			out, err = fluxClient.CreateKustomization("my-name", "my-source", "./", wego.DefaultNamespace)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("  path: ./\n"))
+		})
+	})
+
+	It("creates a helm release from a helm repository", func() {
+		out, err := fluxClient.CreateHelmReleaseHelmRepository("my-name", "my-chart", wego.DefaultNamespace, "target")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(out)).To(Equal(`---
+apiVersion: helm.toolkit.fluxcd.io/v2beta1
+kind: HelmRelease
+metadata:
+  name: my-name
+  namespace: wego-system
+spec:
+  chart:
+    spec:
+      chart: my-chart
+      sourceRef:
+        kind: HelmRepository
+        name: my-name
+  interval: 5m0s
+  targetNamespace: target
+`))
+	})
+
+	It("creates a helm release from a git repository", func() {
+		out, err := fluxClient.CreateHelmReleaseGitRepository("my-name", "my-source", "./charts/my-chart", wego.DefaultNamespace, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(out)).To(Equal(`---
+apiVersion: helm.toolkit.fluxcd.io/v2beta1
+kind: HelmRelease
+metadata:
+  name: my-name
+  namespace: wego-system
+spec:
+  chart:
+    spec:
+      chart: ./charts/my-chart
+      sourceRef:
+        kind: GitRepository
+        name: my-source
+  interval: 5m0s
+`))
+	})
+
+	Describe("CreateSecretGitWithKey", func() {
+		It("fails on a file without a private key", func() {
+			dir, err := ioutil.TempDir("", "a-key-dir")
+			Expect(err).ShouldNot(HaveOccurred())
+			defer os.RemoveAll(dir)
+
+			keyFile := filepath.Join(dir, "identity")
+			Expect(ioutil.WriteFile(keyFile, []byte("not a key"), 0600)).To(Succeed())
+
+			repoUrl, err := gitproviders.NewRepoURL("ssh://git@github.com/foo/bar.git")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = fluxClient.CreateSecretGitWithKey("my-secret", repoUrl, wego.DefaultNamespace, keyFile)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing private key " + keyFile)))
+		})
+	})
+})
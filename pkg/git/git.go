@@ -36,6 +36,9 @@ type Author struct {
 	Email string
 }
 
+// DefaultAuthor is the author of the commits of gitops when no other author is configured
+var DefaultAuthor = Author{Name: "Weave Gitops", Email: "weave-gitops@weave.works"}
+
 type Commit struct {
 	Author
 	Hash    string
@@ -28,6 +28,8 @@ import (
 	"time"
 
 	"github.com/weaveworks/weave-gitops/pkg/git/wrapper"
+	"github.com/weaveworks/weave-gitops/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
 
 	gogit "github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
@@ -39,17 +41,46 @@ import (
 type GoGit struct {
 	path       string
 	auth       transport.AuthMethod
+	author     Author
+	signer     Signer
 	repository *gogit.Repository
 	git        wrapper.Git
 }
 
-func New(auth transport.AuthMethod, wrapper wrapper.Git) Git {
-	return &GoGit{
-		auth: auth,
-		git:  wrapper,
+// Option configures a GoGit client
+type Option func(*GoGit)
+
+// WithAuthor sets the author of the commits which don't have one, DefaultAuthor otherwise.
+// An empty author keeps the default one.
+func WithAuthor(author Author) Option {
+	return func(g *GoGit) {
+		if author != (Author{}) {
+			g.author = author
+		}
 	}
 }
 
+// WithSigner signs the commits of the client with a GPG or an SSH key
+func WithSigner(signer Signer) Option {
+	return func(g *GoGit) {
+		g.signer = signer
+	}
+}
+
+func New(auth transport.AuthMethod, wrapper wrapper.Git, opts ...Option) Git {
+	g := &GoGit{
+		auth:   auth,
+		author: DefaultAuthor,
+		git:    wrapper,
+	}
+
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	return g
+}
+
 // Open opens a git repository in the provided path, and returns a repository.
 func (g *GoGit) Open(path string) (*gogit.Repository, error) {
 	g.path = path
@@ -133,6 +164,8 @@ func (g *GoGit) Clone(ctx context.Context, path, url, branch string) (bool, erro
 }
 
 func (g *GoGit) clone(ctx context.Context, path, url, branch string, depth int) (*gogit.Repository, error) {
+	ctx, span := tracing.Start(ctx, "git.Clone", attribute.String("git.url", url), attribute.String("git.branch", branch))
+
 	branchRef := plumbing.NewBranchReferenceName(branch)
 	r, err := g.git.PlainCloneContext(ctx, path, false, &gogit.CloneOptions{
 		URL:           url,
@@ -146,6 +179,8 @@ func (g *GoGit) clone(ctx context.Context, path, url, branch string, depth int)
 		Tags:          gogit.NoTags,
 	})
 
+	tracing.End(span, err)
+
 	if err != nil {
 		return nil, err
 	}
@@ -286,10 +321,15 @@ func (g *GoGit) Commit(message Commit, filters ...func(string) bool) (string, er
 		return head.Hash().String(), ErrNoStagedFiles
 	}
 
+	author := message.Author
+	if author == (Author{}) {
+		author = g.author
+	}
+
 	commit, err := wt.Commit(message.Message, &gogit.CommitOptions{
 		Author: &object.Signature{
-			Name:  message.Name,
-			Email: message.Email,
+			Name:  author.Name,
+			Email: author.Email,
 			When:  time.Now(),
 		},
 	})
@@ -297,19 +337,85 @@ func (g *GoGit) Commit(message Commit, filters ...func(string) bool) (string, er
 		return "", fmt.Errorf("failed to commit changes: %w", err)
 	}
 
+	if g.signer != nil {
+		commit, err = SignCommit(g.repository, commit, g.signer)
+		if err != nil {
+			return "", fmt.Errorf("failed to sign commit: %w", err)
+		}
+	}
+
 	return commit.String(), nil
 }
 
+// SignCommit replaces a commit of a repository with a copy signed by a signer, and moves HEAD to it.
+// go-git can only sign with GPG keys, so the signature is added to the commit once it's created.
+func SignCommit(repo *gogit.Repository, hash plumbing.Hash, signer Signer) (plumbing.Hash, error) {
+	commit, err := repo.CommitObject(hash)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	unsigned := &plumbing.MemoryObject{}
+	if err := commit.EncodeWithoutSignature(unsigned); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	message, err := unsigned.Reader()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	defer message.Close()
+
+	signature, err := signer.Sign(message)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	commit.PGPSignature = string(signature)
+
+	signed := repo.Storer.NewEncodedObject()
+	if err := commit.Encode(signed); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	signedHash, err := repo.Storer.SetEncodedObject(signed)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	head, err := repo.Storer.Reference(plumbing.HEAD)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	name := plumbing.HEAD
+	if head.Type() == plumbing.SymbolicReference {
+		name = head.Target()
+	}
+
+	if err := repo.Storer.SetReference(plumbing.NewHashReference(name, signedHash)); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	return signedHash, nil
+}
+
 func (g *GoGit) Push(ctx context.Context) error {
 	if g.repository == nil {
 		return ErrNoGitRepository
 	}
 
-	return g.repository.PushContext(ctx, &gogit.PushOptions{
+	ctx, span := tracing.Start(ctx, "git.Push")
+
+	err := g.repository.PushContext(ctx, &gogit.PushOptions{
 		RemoteName: gogit.DefaultRemoteName,
 		Auth:       g.auth,
 		Progress:   nil,
 	})
+
+	tracing.End(span, err)
+
+	return err
 }
 
 // Status returns true if no files in the repository have been modified.
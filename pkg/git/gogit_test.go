@@ -252,6 +252,32 @@ var _ = Describe("Commit", func() {
 		Expect(out).To(ContainSubstring("test commit"))
 	})
 
+	It("commits with the author of the client when the commit has none", func() {
+		_, err = gitClient.Init(dir, "https://github.com/github/gitignore", "master")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(gitClient.Write("/test.txt", []byte("testing"))).To(Succeed())
+
+		_, err = gitClient.Commit(git.Commit{Message: "test commit"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		out := executeCommand(dir, "sh", "-c", `git log -1 --pretty="%an <%ae>"`)
+		Expect(out).To(ContainSubstring("Weave Gitops <weave-gitops@weave.works>"))
+
+		authorClient := git.New(nil, wrapper.NewGoGit(), git.WithAuthor(git.Author{Name: "bot", Email: "bot@example.com"}))
+
+		_, err = authorClient.Open(dir)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(authorClient.Write("/test.txt", []byte("testing again"))).To(Succeed())
+
+		_, err = authorClient.Commit(git.Commit{Message: "test commit"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		out = executeCommand(dir, "sh", "-c", `git log -1 --pretty="%an <%ae>"`)
+		Expect(out).To(ContainSubstring("bot <bot@example.com>"))
+	})
+
 	It("commits into a given repository skipping filtered files", func() {
 		_, err = gitClient.Init(dir, "https://github.com/github/gitignore", "master")
 		Expect(err).ShouldNot(HaveOccurred())
@@ -0,0 +1,231 @@
+package git_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/git/gitfakes"
+	"github.com/weaveworks/weave-gitops/pkg/git/wrapper"
+)
+
+// newGPGKey returns an armored GPG private key and its armored public key
+func newGPGKey(passphrase string) ([]byte, []byte) {
+	entity, err := openpgp.NewEntity("Weave Gitops", "", "wego@example.com", nil)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	if passphrase != "" {
+		Expect(entity.PrivateKey.Encrypt([]byte(passphrase))).To(Succeed())
+
+		for _, subkey := range entity.Subkeys {
+			Expect(subkey.PrivateKey.Encrypt([]byte(passphrase))).To(Succeed())
+		}
+	}
+
+	var private, public bytes.Buffer
+
+	w, err := armor.Encode(&private, openpgp.PrivateKeyType, nil)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(entity.SerializePrivateWithoutSigning(w, nil)).To(Succeed())
+	Expect(w.Close()).To(Succeed())
+
+	w, err = armor.Encode(&public, openpgp.PublicKeyType, nil)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(entity.Serialize(w)).To(Succeed())
+	Expect(w.Close()).To(Succeed())
+
+	return private.Bytes(), public.Bytes()
+}
+
+// newSSHKey returns an ed25519 private key in the PEM format and its public key in the authorized_keys format
+func newSSHKey() ([]byte, []byte) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	publicKey, err := ssh.NewPublicKey(pub)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), ssh.MarshalAuthorizedKey(publicKey)
+}
+
+var _ = Describe("Signer", func() {
+	message := []byte("tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\n\nAdd podinfo\n")
+
+	It("signs and verifies with a gpg key", func() {
+		private, public := newGPGKey("")
+
+		signer, err := git.NewSigner(private, "")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		signature, err := signer.Sign(bytes.NewReader(message))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(signature)).To(HavePrefix("-----BEGIN PGP SIGNATURE-----"))
+
+		verifier, err := git.NewVerifier(public)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		key, err := verifier.Verify(message, string(signature))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(key).To(HaveLen(16))
+
+		_, err = verifier.Verify([]byte("Add podinfo"), string(signature))
+		Expect(err).To(MatchError(ContainSubstring("invalid gpg signature")))
+	})
+
+	It("decrypts an encrypted gpg key with the passphrase", func() {
+		private, _ := newGPGKey("secret")
+
+		_, err := git.NewSigner(private, "")
+		Expect(err).To(MatchError(ContainSubstring("a passphrase is required")))
+
+		_, err = git.NewSigner(private, "wrong")
+		Expect(err).To(MatchError(ContainSubstring("failed decrypting gpg key")))
+
+		signer, err := git.NewSigner(private, "secret")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = signer.Sign(bytes.NewReader(message))
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("signs and verifies with an ssh key", func() {
+		private, public := newSSHKey()
+
+		signer, err := git.NewSigner(private, "")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		signature, err := signer.Sign(bytes.NewReader(message))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(signature)).To(HavePrefix("-----BEGIN SSH SIGNATURE-----"))
+
+		verifier, err := git.NewVerifier(append([]byte("# the ssh key of the bot\n\n"), public...))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		key, err := verifier.Verify(message, string(signature))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(key).To(HavePrefix("SHA256:"))
+
+		_, err = verifier.Verify([]byte("Add podinfo"), string(signature))
+		Expect(err).To(MatchError(ContainSubstring("invalid ssh signature")))
+	})
+
+	It("doesn't trust the signatures made by other keys", func() {
+		gpgPrivate, gpgPublic := newGPGKey("")
+		sshPrivate, sshPublic := newSSHKey()
+		_, otherGPGPublic := newGPGKey("")
+		_, otherSSHPublic := newSSHKey()
+
+		verifier, err := git.NewVerifier(append(otherGPGPublic, otherSSHPublic...))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		for _, private := range [][]byte{gpgPrivate, sshPrivate} {
+			signer, err := git.NewSigner(private, "")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			signature, err := signer.Sign(bytes.NewReader(message))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = verifier.Verify(message, string(signature))
+			Expect(err).To(MatchError(git.ErrUntrustedSignature))
+		}
+
+		verifier, err = git.NewVerifier(append(gpgPublic, sshPublic...))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = verifier.Verify(message, "not a signature")
+		Expect(err).To(MatchError("unknown signature format"))
+	})
+
+	It("fails without public keys", func() {
+		_, err := git.NewVerifier([]byte("# no keys\n"))
+		Expect(err).To(MatchError("no gpg or ssh public keys found"))
+	})
+
+	It("fails to read an invalid private key", func() {
+		_, err := git.NewSigner([]byte("not a key"), "")
+		Expect(err).To(MatchError(ContainSubstring("failed reading ssh key")))
+	})
+})
+
+var _ = Describe("Commit signing", func() {
+	It("signs the commits and moves the branch to the signed commit", func() {
+		private, public := newSSHKey()
+
+		signer, err := git.NewSigner(private, "")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		signingClient := git.New(nil, wrapper.NewGoGit(), git.WithSigner(signer))
+
+		_, err = signingClient.Init(dir, "https://github.com/github/gitignore", "main")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(signingClient.Write("podinfo.yaml", []byte("kind: Kustomization"))).To(Succeed())
+
+		hash, err := signingClient.Commit(git.Commit{
+			Author:  git.Author{Name: "test", Email: "test@example.com"},
+			Message: "Add podinfo",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		repo, err := gogit.PlainOpen(dir)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		ref, err := repo.Reference(plumbing.NewBranchReferenceName("main"), true)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ref.Hash().String()).To(Equal(hash))
+
+		commit, err := repo.CommitObject(ref.Hash())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(commit.Message).To(Equal("Add podinfo"))
+
+		payload := &plumbing.MemoryObject{}
+		Expect(commit.EncodeWithoutSignature(payload)).To(Succeed())
+
+		reader, err := payload.Reader()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		message, err := ioutil.ReadAll(reader)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		verifier, err := git.NewVerifier(public)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = verifier.Verify(message, commit.PGPSignature)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("fails to commit when the commit can't be signed", func() {
+		signer := &gitfakes.FakeSigner{}
+		signer.SignReturns(nil, errors.New("no key"))
+
+		signingClient := git.New(nil, wrapper.NewGoGit(), git.WithSigner(signer))
+
+		_, err = signingClient.Init(dir, "https://github.com/github/gitignore", "main")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(signingClient.Write("podinfo.yaml", []byte("kind: Kustomization"))).To(Succeed())
+
+		_, err = signingClient.Commit(git.Commit{
+			Author:  git.Author{Name: "test", Email: "test@example.com"},
+			Message: "Add podinfo",
+		})
+		Expect(err).To(MatchError(ContainSubstring("failed to sign commit")))
+		Expect(signer.SignCallCount()).To(Equal(1))
+	})
+})
@@ -0,0 +1,129 @@
+package gitproviders
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// apiClient calls the REST API of the providers go-git-providers doesn't support
+type apiClient struct {
+	baseURL string
+	// authorization is the Authorization header of the requests
+	authorization string
+	http          *http.Client
+}
+
+// apiError is the error of a request the API didn't handle successfully
+type apiError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e apiError) Error() string {
+	return fmt.Sprintf("%d %s", e.StatusCode, e.Message)
+}
+
+// Is lets errors.Is match the responses of resources that don't exist with gitprovider.ErrNotFound
+func (e apiError) Is(target error) bool {
+	return target == gitprovider.ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// do sends a request to the API, its body is encoded in JSON and the response decoded in out unless they are nil
+func (c apiClient) do(ctx context.Context, method, path string, query url.Values, body, out interface{}) error {
+	header := http.Header{"Accept": {"application/json"}}
+
+	var reqBody io.Reader
+
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("could not encode request: %w", err)
+		}
+
+		header.Set("Content-Type", "application/json")
+
+		reqBody = bytes.NewReader(b)
+	}
+
+	resBody, err := c.send(ctx, method, path, query, header, reqBody)
+	if err != nil {
+		return err
+	}
+
+	if out == nil || len(resBody) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(resBody, out); err != nil {
+		return fmt.Errorf("could not decode response of %s %s: %w", method, path, err)
+	}
+
+	return nil
+}
+
+// send sends a request to the API and returns the body of its response
+func (c apiClient) send(ctx context.Context, method, path string, query url.Values, header http.Header, body io.Reader) ([]byte, error) {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	req.Header.Set("Authorization", c.authorization)
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return nil, apiError{StatusCode: res.StatusCode, Message: errorMessage(res.StatusCode, resBody)}
+	}
+
+	return resBody, nil
+}
+
+// errorMessage returns the message of an error response. Gitea answers with {"message": "..."}
+// and Bitbucket Server with {"errors": [{"message": "..."}]}.
+func errorMessage(statusCode int, body []byte) string {
+	res := struct {
+		Message string `json:"message"`
+		Errors  []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}{}
+
+	if json.Unmarshal(body, &res) == nil {
+		if res.Message != "" {
+			return res.Message
+		}
+
+		if len(res.Errors) > 0 && res.Errors[0].Message != "" {
+			return res.Errors[0].Message
+		}
+	}
+
+	return http.StatusText(statusCode)
+}
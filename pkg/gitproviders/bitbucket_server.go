@@ -0,0 +1,98 @@
+package gitproviders
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// The objects of the REST API of Bitbucket Server, https://docs.atlassian.com/bitbucket-server/rest/7.21.0/bitbucket-rest.html
+
+// bitbucketServerPermissionWrite lets an access key push to a repository
+const bitbucketServerPermissionWrite = "REPO_WRITE"
+
+type bitbucketServerRepository struct {
+	Slug   string `json:"slug"`
+	Public bool   `json:"public"`
+}
+
+type bitbucketServerRef struct {
+	ID        string `json:"id"`
+	DisplayID string `json:"displayId,omitempty"`
+}
+
+type bitbucketServerAccessKey struct {
+	Key struct {
+		ID    int    `json:"id,omitempty"`
+		Text  string `json:"text"`
+		Label string `json:"label"`
+	} `json:"key"`
+	Permission string `json:"permission"`
+}
+
+type bitbucketServerAccessKeys struct {
+	Values []bitbucketServerAccessKey `json:"values"`
+}
+
+type bitbucketServerCommit struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	Author  struct {
+		Name string `json:"name"`
+	} `json:"author"`
+	// AuthorTimestamp is in milliseconds
+	AuthorTimestamp int64 `json:"authorTimestamp"`
+}
+
+type bitbucketServerCommits struct {
+	Values []bitbucketServerCommit `json:"values"`
+}
+
+// bitbucketServerFiles are the paths of the files of a directory and its subdirectories, relative to the directory
+type bitbucketServerFiles struct {
+	Values []string `json:"values"`
+}
+
+type bitbucketServerCreateBranch struct {
+	Name       string `json:"name"`
+	StartPoint string `json:"startPoint"`
+}
+
+type bitbucketServerCreatePullRequest struct {
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	FromRef     bitbucketServerRef `json:"fromRef"`
+	ToRef       bitbucketServerRef `json:"toRef"`
+}
+
+type bitbucketServerMergePullRequest struct {
+	Message string `json:"message,omitempty"`
+}
+
+// bitbucketServerPullRequest is a gitprovider.PullRequest created with the API of Bitbucket Server
+type bitbucketServerPullRequest struct {
+	ID      int `json:"id"`
+	Version int `json:"version"`
+	// State is OPEN, DECLINED or MERGED
+	State string `json:"state"`
+	Links struct {
+		Self []struct {
+			Href string `json:"href"`
+		} `json:"self"`
+	} `json:"links"`
+}
+
+func (pr bitbucketServerPullRequest) APIObject() interface{} {
+	return &pr
+}
+
+func (pr bitbucketServerPullRequest) Get() gitprovider.PullRequestInfo {
+	info := gitprovider.PullRequestInfo{
+		Merged: pr.State == "MERGED",
+		Number: pr.ID,
+	}
+
+	if len(pr.Links.Self) > 0 {
+		info.WebURL = pr.Links.Self[0].Href
+	}
+
+	return info
+}
@@ -0,0 +1,109 @@
+package gitproviders
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+)
+
+// cassette is a recording of HTTP interactions in the go-vcr format of the files of the cache directory
+type cassette struct {
+	Interactions []struct {
+		Request struct {
+			Method string `json:"method"`
+			URL    string `json:"url"`
+		} `json:"request"`
+		Response struct {
+			Body    string              `json:"body"`
+			Headers map[string][]string `json:"headers"`
+			Code    int                 `json:"code"`
+		} `json:"response"`
+	} `json:"interactions"`
+}
+
+// replayTransport answers each request with the first interaction of a cassette with the same
+// method and URL that wasn't replayed yet, and keeps the requests sent for the assertions of the tests
+type replayTransport struct {
+	mu       sync.Mutex
+	cassette cassette
+	replayed []bool
+	requests []replayedRequest
+}
+
+type replayedRequest struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   string
+}
+
+func replayCassette(name string) *replayTransport {
+	data, err := ioutil.ReadFile(filepath.Join("cache", name+".yaml"))
+	Expect(err).ToNot(HaveOccurred())
+
+	t := &replayTransport{}
+	Expect(yaml.Unmarshal(data, &t.cassette)).To(Succeed())
+
+	t.replayed = make([]bool, len(t.cassette.Interactions))
+
+	return t
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	body := []byte{}
+
+	if req.Body != nil {
+		var err error
+
+		body, err = ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t.requests = append(t.requests, replayedRequest{Method: req.Method, URL: req.URL.String(), Header: req.Header, Body: string(body)})
+
+	for i, interaction := range t.cassette.Interactions {
+		if t.replayed[i] || interaction.Request.Method != req.Method || interaction.Request.URL != req.URL.String() {
+			continue
+		}
+
+		t.replayed[i] = true
+
+		return &http.Response{
+			StatusCode: interaction.Response.Code,
+			Status:     fmt.Sprintf("%d %s", interaction.Response.Code, http.StatusText(interaction.Response.Code)),
+			Header:     interaction.Response.Headers,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(interaction.Response.Body)),
+			Request:    req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction for %s %s", req.Method, req.URL)
+}
+
+// request returns the last request sent with the method and URL given
+func (t *replayTransport) request(method, url string) replayedRequest {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i := len(t.requests) - 1; i >= 0; i-- {
+		if t.requests[i].Method == method && t.requests[i].URL == url {
+			return t.requests[i]
+		}
+	}
+
+	Fail(fmt.Sprintf("no request sent for %s %s", method, url))
+
+	return replayedRequest{}
+}
@@ -0,0 +1,169 @@
+package gitproviders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-github/v41/github"
+	"github.com/xanzy/go-gitlab"
+)
+
+// ErrPathFilterNotSupported is returned when listing the commits of a path with a provider that can't filter them
+var ErrPathFilterNotSupported = errors.New("listing the commits of a path is not supported by this git provider")
+
+// getCommitsForPath lists the commits of a branch that touched a path, newest first.
+// go-git-providers can't filter the commits by path, so they are listed with the API client of the provider.
+func getCommitsForPath(ctx context.Context, provider gitprovider.Client, repoUrl RepoURL, targetBranch, path string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	var (
+		commits []gitprovider.Commit
+		err     error
+	)
+
+	switch raw := provider.Raw().(type) {
+	case *github.Client:
+		commits, err = listGitHubCommits(ctx, raw, repoUrl, targetBranch, path, pageSize, pageToken)
+	case *gitlab.Client:
+		commits, err = listGitLabCommits(ctx, raw, repoUrl, targetBranch, path, pageSize, pageToken)
+	default:
+		return nil, ErrPathFilterNotSupported
+	}
+
+	if err != nil {
+		if isEmptyRepoError(err) {
+			return []gitprovider.Commit{}, nil
+		}
+
+		return nil, fmt.Errorf("error getting commits of %s: %w", path, err)
+	}
+
+	return commits, nil
+}
+
+func listGitHubCommits(ctx context.Context, client *github.Client, repoUrl RepoURL, targetBranch, path string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	apiObjs, _, err := client.Repositories.ListCommits(ctx, repoUrl.Owner(), repoUrl.RepositoryName(), &github.CommitsListOptions{
+		SHA:         targetBranch,
+		Path:        path,
+		ListOptions: github.ListOptions{PerPage: pageSize, Page: pageToken},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	commits := make([]gitprovider.Commit, 0, len(apiObjs))
+
+	for _, apiObj := range apiObjs {
+		commits = append(commits, commit{
+			apiObj: apiObj,
+			info: gitprovider.CommitInfo{
+				Sha:       apiObj.GetSHA(),
+				TreeSha:   apiObj.GetCommit().GetTree().GetSHA(),
+				Author:    apiObj.GetCommit().GetAuthor().GetName(),
+				Message:   apiObj.GetCommit().GetMessage(),
+				CreatedAt: apiObj.GetCommit().GetAuthor().GetDate(),
+				URL:       apiObj.GetHTMLURL(),
+			},
+		})
+	}
+
+	return commits, nil
+}
+
+func listGitLabCommits(ctx context.Context, client *gitlab.Client, repoUrl RepoURL, targetBranch, path string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	project := fmt.Sprintf("%s/%s", repoUrl.Owner(), repoUrl.RepositoryName())
+
+	apiObjs, _, err := client.Commits.ListCommits(project, &gitlab.ListCommitsOptions{
+		RefName:     &targetBranch,
+		Path:        &path,
+		ListOptions: gitlab.ListOptions{PerPage: pageSize, Page: pageToken},
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	commits := make([]gitprovider.Commit, 0, len(apiObjs))
+
+	for _, apiObj := range apiObjs {
+		info := gitprovider.CommitInfo{
+			Sha:     apiObj.ID,
+			Author:  apiObj.AuthorName,
+			Message: apiObj.Message,
+			URL:     apiObj.WebURL,
+		}
+
+		if apiObj.CreatedAt != nil {
+			info.CreatedAt = *apiObj.CreatedAt
+		}
+
+		commits = append(commits, commit{apiObj: apiObj, info: info})
+	}
+
+	return commits, nil
+}
+
+// commit is a gitprovider.Commit listed with the API client of a provider
+type commit struct {
+	apiObj interface{}
+	info   gitprovider.CommitInfo
+}
+
+func (c commit) APIObject() interface{} {
+	return c.apiObj
+}
+
+func (c commit) Get() gitprovider.CommitInfo {
+	return c.info
+}
+
+// CommitSignature returns the payload a commit is signed over and its armored signature, which is empty for
+// unsigned commits. ok is false when the provider the commit was listed with doesn't return the signatures.
+func CommitSignature(c gitprovider.Commit) (payload []byte, signature string, ok bool) {
+	switch apiObj := c.APIObject().(type) {
+	case *github.Commit:
+		return gitHubCommitSignature(apiObj.GetVerification())
+	case *github.RepositoryCommit:
+		return gitHubCommitSignature(apiObj.GetCommit().GetVerification())
+	case *giteaCommit:
+		if apiObj.Commit.Verification == nil {
+			return nil, "", false
+		}
+
+		return []byte(apiObj.Commit.Verification.Payload), apiObj.Commit.Verification.Signature, true
+	case *object.Commit:
+		if apiObj.PGPSignature == "" {
+			return nil, "", true
+		}
+
+		unsigned := &plumbing.MemoryObject{}
+		if err := apiObj.EncodeWithoutSignature(unsigned); err != nil {
+			return nil, "", false
+		}
+
+		reader, err := unsigned.Reader()
+		if err != nil {
+			return nil, "", false
+		}
+		defer reader.Close()
+
+		payload, err := ioutil.ReadAll(reader)
+		if err != nil {
+			return nil, "", false
+		}
+
+		return payload, apiObj.PGPSignature, true
+	default:
+		return nil, "", false
+	}
+}
+
+func gitHubCommitSignature(verification *github.SignatureVerification) ([]byte, string, bool) {
+	if verification == nil {
+		return nil, "", false
+	}
+
+	return []byte(verification.GetPayload()), verification.GetSignature(), true
+}
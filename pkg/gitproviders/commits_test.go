@@ -0,0 +1,34 @@
+package gitproviders
+
+import (
+	"github.com/google/go-github/v41/github"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = Describe("CommitSignature", func() {
+	It("returns the signature verified by GitHub", func() {
+		payload, signature, ok := CommitSignature(commit{apiObj: &github.RepositoryCommit{Commit: &github.Commit{
+			Verification: &github.SignatureVerification{
+				Payload:   github.String("tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\n"),
+				Signature: github.String("-----BEGIN PGP SIGNATURE-----\n"),
+			},
+		}}})
+		Expect(ok).To(BeTrue())
+		Expect(string(payload)).To(Equal("tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\n"))
+		Expect(signature).To(Equal("-----BEGIN PGP SIGNATURE-----\n"))
+
+		_, signature, ok = CommitSignature(commit{apiObj: &github.Commit{Verification: &github.SignatureVerification{}}})
+		Expect(ok).To(BeTrue())
+		Expect(signature).To(BeEmpty())
+	})
+
+	It("doesn't know the signatures of the other providers", func() {
+		_, _, ok := CommitSignature(commit{apiObj: &github.Commit{}})
+		Expect(ok).To(BeFalse())
+
+		_, _, ok = CommitSignature(commit{apiObj: &gitlab.Commit{}})
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -54,6 +54,10 @@ func (p *dryrunProvider) GetCommits(_ context.Context, repoUrl RepoURL, targetBr
 	return []gitprovider.Commit{}, nil
 }
 
+func (p *dryrunProvider) GetCommitsForPath(_ context.Context, repoUrl RepoURL, targetBranch, path string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	return []gitprovider.Commit{}, nil
+}
+
 func (p *dryrunProvider) GetProviderDomain() string {
 	return p.provider.GetProviderDomain()
 }
@@ -82,13 +82,21 @@ var _ = Describe("DryRun", func() {
 	})
 
 	Describe("GetCommits", func() {
-		It("returns emtpy", func() {
+		It("returns empty", func() {
 			res, err := dryRunProvider.GetCommits(ctx, repoUrl, "", 1, 1)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(res).To(Equal([]gitprovider.Commit{}))
 		})
 	})
 
+	Describe("GetCommitsForPath", func() {
+		It("returns empty", func() {
+			res, err := dryRunProvider.GetCommitsForPath(ctx, repoUrl, "", ".weave-gitops/apps/my-app", 1, 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]gitprovider.Commit{}))
+		})
+	})
+
 	Describe("GetProviderDomain", func() {
 		It("returns github provider", func() {
 			Expect(dryRunProvider.GetProviderDomain()).To(Equal("github.com"))
@@ -2,6 +2,8 @@ package gitproviders
 
 import (
 	"fmt"
+	"net"
+	"net/http"
 
 	"github.com/fluxcd/go-git-providers/github"
 	"github.com/fluxcd/go-git-providers/gitlab"
@@ -12,9 +14,12 @@ import (
 type GitProviderName string
 
 const (
-	GitProviderGitHub GitProviderName = "github"
-	GitProviderGitLab GitProviderName = "gitlab"
-	tokenTypeOauth    string          = "oauth2"
+	GitProviderGitHub          GitProviderName = "github"
+	GitProviderGitLab          GitProviderName = "gitlab"
+	GitProviderBitbucketServer GitProviderName = "bitbucket-server"
+	GitProviderGitea           GitProviderName = "gitea"
+	GitProviderGit             GitProviderName = "git"
+	tokenTypeOauth             string          = "oauth2"
 )
 
 // Config defines the configuration for connecting to a GitProvider.
@@ -29,6 +34,55 @@ type Config struct {
 	// Token contains the token used to authenticate with the
 	// Provider.
 	Token string
+
+	// PrivateKeyFile is the SSH private key the repositories of a plain
+	// git Provider are cloned and pushed with.
+	PrivateKeyFile string
+
+	// transport replaces the HTTP transport of the API client,
+	// the tests replay recorded responses with it.
+	transport http.RoundTripper
+}
+
+// httpClient returns the HTTP client the API of a self-hosted provider is called with
+func (c Config) httpClient() *http.Client {
+	return &http.Client{Transport: c.transport, Timeout: defaultTimeout}
+}
+
+// newSelfHostedProvider builds the GitProvider of Bitbucket Server or Gitea. Their API is expected on the
+// HTTPS port of the host, as the repositories are often cloned with SSH on another port.
+func newSelfHostedProvider(config Config) (GitProvider, error) {
+	if config.Token == "" {
+		return nil, fmt.Errorf("no git provider token present")
+	}
+
+	hostname := config.Hostname
+	if host, _, err := net.SplitHostPort(hostname); err == nil {
+		hostname = host
+	}
+
+	switch config.Provider {
+	case GitProviderBitbucketServer:
+		return bitbucketServerProvider{
+			domain: hostname,
+			client: apiClient{
+				baseURL:       "https://" + hostname,
+				authorization: "Bearer " + config.Token,
+				http:          config.httpClient(),
+			},
+		}, nil
+	case GitProviderGitea:
+		return giteaProvider{
+			domain: hostname,
+			client: apiClient{
+				baseURL:       "https://" + hostname + "/api/v1",
+				authorization: "token " + config.Token,
+				http:          config.httpClient(),
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported Git provider '%s'", config.Provider)
+	}
 }
 
 func buildGitProvider(config Config) (gitprovider.Client, string, error) {
@@ -0,0 +1,99 @@
+package gitproviders
+
+import (
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// The objects of the v1 REST API of Gitea, https://try.gitea.io/api/swagger
+
+type giteaRepository struct {
+	Name          string `json:"name"`
+	Private       bool   `json:"private"`
+	Internal      bool   `json:"internal"`
+	DefaultBranch string `json:"default_branch"`
+}
+
+type giteaDeployKey struct {
+	ID       int64  `json:"id,omitempty"`
+	Title    string `json:"title"`
+	Key      string `json:"key"`
+	ReadOnly bool   `json:"read_only"`
+}
+
+type giteaCommit struct {
+	SHA     string `json:"sha"`
+	HTMLURL string `json:"html_url"`
+	Commit  struct {
+		Message string `json:"message"`
+		Author  struct {
+			Name string    `json:"name"`
+			Date time.Time `json:"date"`
+		} `json:"author"`
+		Tree struct {
+			SHA string `json:"sha"`
+		} `json:"tree"`
+		Verification *struct {
+			Signature string `json:"signature"`
+			Payload   string `json:"payload"`
+		} `json:"verification"`
+	} `json:"commit"`
+}
+
+type giteaContent struct {
+	Name    string  `json:"name"`
+	Path    string  `json:"path"`
+	Type    string  `json:"type"`
+	SHA     string  `json:"sha"`
+	Content *string `json:"content"`
+}
+
+// giteaChangeFiles creates a commit changing several files at once
+type giteaChangeFiles struct {
+	Branch    string            `json:"branch"`
+	NewBranch string            `json:"new_branch,omitempty"`
+	Message   string            `json:"message"`
+	Files     []giteaChangeFile `json:"files"`
+}
+
+type giteaChangeFile struct {
+	// Operation is one of create, update or delete
+	Operation string `json:"operation"`
+	Path      string `json:"path"`
+	// Content is encoded in base64
+	Content string `json:"content,omitempty"`
+	// SHA is the blob of the file updated or deleted
+	SHA string `json:"sha,omitempty"`
+}
+
+type giteaCreatePullRequest struct {
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type giteaMergePullRequest struct {
+	Do                string `json:"Do"`
+	MergeMessageField string `json:"MergeMessageField,omitempty"`
+}
+
+// giteaPullRequest is a gitprovider.PullRequest created with the API of Gitea
+type giteaPullRequest struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+	Merged  bool   `json:"merged"`
+}
+
+func (pr giteaPullRequest) APIObject() interface{} {
+	return &pr
+}
+
+func (pr giteaPullRequest) Get() gitprovider.PullRequestInfo {
+	return gitprovider.PullRequestInfo{
+		Merged: pr.Merged,
+		Number: pr.Number,
+		WebURL: pr.HTMLURL,
+	}
+}
@@ -56,6 +56,24 @@ type FakeGitProvider struct {
 		result1 []gitprovider.Commit
 		result2 error
 	}
+	GetCommitsForPathStub        func(context.Context, gitproviders.RepoURL, string, string, int, int) ([]gitprovider.Commit, error)
+	getCommitsForPathMutex       sync.RWMutex
+	getCommitsForPathArgsForCall []struct {
+		arg1 context.Context
+		arg2 gitproviders.RepoURL
+		arg3 string
+		arg4 string
+		arg5 int
+		arg6 int
+	}
+	getCommitsForPathReturns struct {
+		result1 []gitprovider.Commit
+		result2 error
+	}
+	getCommitsForPathReturnsOnCall map[int]struct {
+		result1 []gitprovider.Commit
+		result2 error
+	}
 	GetDefaultBranchStub        func(context.Context, gitproviders.RepoURL) (string, error)
 	getDefaultBranchMutex       sync.RWMutex
 	getDefaultBranchArgsForCall []struct {
@@ -354,6 +372,75 @@ func (fake *FakeGitProvider) GetCommitsReturnsOnCall(i int, result1 []gitprovide
 	}{result1, result2}
 }
 
+func (fake *FakeGitProvider) GetCommitsForPath(arg1 context.Context, arg2 gitproviders.RepoURL, arg3 string, arg4 string, arg5 int, arg6 int) ([]gitprovider.Commit, error) {
+	fake.getCommitsForPathMutex.Lock()
+	ret, specificReturn := fake.getCommitsForPathReturnsOnCall[len(fake.getCommitsForPathArgsForCall)]
+	fake.getCommitsForPathArgsForCall = append(fake.getCommitsForPathArgsForCall, struct {
+		arg1 context.Context
+		arg2 gitproviders.RepoURL
+		arg3 string
+		arg4 string
+		arg5 int
+		arg6 int
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.GetCommitsForPathStub
+	fakeReturns := fake.getCommitsForPathReturns
+	fake.recordInvocation("GetCommitsForPath", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.getCommitsForPathMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeGitProvider) GetCommitsForPathCallCount() int {
+	fake.getCommitsForPathMutex.RLock()
+	defer fake.getCommitsForPathMutex.RUnlock()
+	return len(fake.getCommitsForPathArgsForCall)
+}
+
+func (fake *FakeGitProvider) GetCommitsForPathCalls(stub func(context.Context, gitproviders.RepoURL, string, string, int, int) ([]gitprovider.Commit, error)) {
+	fake.getCommitsForPathMutex.Lock()
+	defer fake.getCommitsForPathMutex.Unlock()
+	fake.GetCommitsForPathStub = stub
+}
+
+func (fake *FakeGitProvider) GetCommitsForPathArgsForCall(i int) (context.Context, gitproviders.RepoURL, string, string, int, int) {
+	fake.getCommitsForPathMutex.RLock()
+	defer fake.getCommitsForPathMutex.RUnlock()
+	argsForCall := fake.getCommitsForPathArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeGitProvider) GetCommitsForPathReturns(result1 []gitprovider.Commit, result2 error) {
+	fake.getCommitsForPathMutex.Lock()
+	defer fake.getCommitsForPathMutex.Unlock()
+	fake.GetCommitsForPathStub = nil
+	fake.getCommitsForPathReturns = struct {
+		result1 []gitprovider.Commit
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeGitProvider) GetCommitsForPathReturnsOnCall(i int, result1 []gitprovider.Commit, result2 error) {
+	fake.getCommitsForPathMutex.Lock()
+	defer fake.getCommitsForPathMutex.Unlock()
+	fake.GetCommitsForPathStub = nil
+	if fake.getCommitsForPathReturnsOnCall == nil {
+		fake.getCommitsForPathReturnsOnCall = make(map[int]struct {
+			result1 []gitprovider.Commit
+			result2 error
+		})
+	}
+	fake.getCommitsForPathReturnsOnCall[i] = struct {
+		result1 []gitprovider.Commit
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeGitProvider) GetDefaultBranch(arg1 context.Context, arg2 gitproviders.RepoURL) (string, error) {
 	fake.getDefaultBranchMutex.Lock()
 	ret, specificReturn := fake.getDefaultBranchReturnsOnCall[len(fake.getDefaultBranchArgsForCall)]
@@ -810,6 +897,8 @@ func (fake *FakeGitProvider) Invocations() map[string][][]interface{} {
 	defer fake.deployKeyExistsMutex.RUnlock()
 	fake.getCommitsMutex.RLock()
 	defer fake.getCommitsMutex.RUnlock()
+	fake.getCommitsForPathMutex.RLock()
+	defer fake.getCommitsForPathMutex.RUnlock()
 	fake.getDefaultBranchMutex.RLock()
 	defer fake.getDefaultBranchMutex.RUnlock()
 	fake.getProviderDomainMutex.RLock()
@@ -0,0 +1,116 @@
+package gitproviders
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/weaveworks/weave-gitops/pkg/metrics"
+	"github.com/weaveworks/weave-gitops/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentedProvider records the calls a GitProvider makes to the API of the git provider
+// in the metrics, and traces them
+type instrumentedProvider struct {
+	GitProvider
+	name string
+}
+
+func instrument(provider GitProvider, name GitProviderName) GitProvider {
+	return instrumentedProvider{GitProvider: provider, name: string(name)}
+}
+
+// start starts the span of a call to the API of the git provider
+func (p instrumentedProvider) start(ctx context.Context, operation string, repoUrl RepoURL) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "gitprovider."+operation,
+		attribute.String("gitprovider.name", p.name),
+		attribute.String("gitprovider.repository", repoUrl.String()),
+	)
+}
+
+// end records a call to the API of the git provider and ends its span
+func (p instrumentedProvider) end(span trace.Span, operation string, err error) {
+	metrics.GitProviderCalled(p.name, operation, err)
+	tracing.End(span, err)
+}
+
+func (p instrumentedProvider) RepositoryExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	ctx, span := p.start(ctx, "RepositoryExists", repoUrl)
+	exists, err := p.GitProvider.RepositoryExists(ctx, repoUrl)
+	p.end(span, "RepositoryExists", err)
+
+	return exists, err
+}
+
+func (p instrumentedProvider) DeployKeyExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	ctx, span := p.start(ctx, "DeployKeyExists", repoUrl)
+	exists, err := p.GitProvider.DeployKeyExists(ctx, repoUrl)
+	p.end(span, "DeployKeyExists", err)
+
+	return exists, err
+}
+
+func (p instrumentedProvider) GetDefaultBranch(ctx context.Context, repoUrl RepoURL) (string, error) {
+	ctx, span := p.start(ctx, "GetDefaultBranch", repoUrl)
+	branch, err := p.GitProvider.GetDefaultBranch(ctx, repoUrl)
+	p.end(span, "GetDefaultBranch", err)
+
+	return branch, err
+}
+
+func (p instrumentedProvider) GetRepoVisibility(ctx context.Context, repoUrl RepoURL) (*gitprovider.RepositoryVisibility, error) {
+	ctx, span := p.start(ctx, "GetRepoVisibility", repoUrl)
+	visibility, err := p.GitProvider.GetRepoVisibility(ctx, repoUrl)
+	p.end(span, "GetRepoVisibility", err)
+
+	return visibility, err
+}
+
+func (p instrumentedProvider) UploadDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error {
+	ctx, span := p.start(ctx, "UploadDeployKey", repoUrl)
+	err := p.GitProvider.UploadDeployKey(ctx, repoUrl, deployKey)
+	p.end(span, "UploadDeployKey", err)
+
+	return err
+}
+
+func (p instrumentedProvider) CreatePullRequest(ctx context.Context, repoUrl RepoURL, prInfo PullRequestInfo) (gitprovider.PullRequest, error) {
+	ctx, span := p.start(ctx, "CreatePullRequest", repoUrl)
+	pr, err := p.GitProvider.CreatePullRequest(ctx, repoUrl, prInfo)
+	p.end(span, "CreatePullRequest", err)
+
+	return pr, err
+}
+
+func (p instrumentedProvider) GetCommits(ctx context.Context, repoUrl RepoURL, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	ctx, span := p.start(ctx, "GetCommits", repoUrl)
+	commits, err := p.GitProvider.GetCommits(ctx, repoUrl, targetBranch, pageSize, pageToken)
+	p.end(span, "GetCommits", err)
+
+	return commits, err
+}
+
+func (p instrumentedProvider) GetCommitsForPath(ctx context.Context, repoUrl RepoURL, targetBranch, path string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	ctx, span := p.start(ctx, "GetCommitsForPath", repoUrl)
+	commits, err := p.GitProvider.GetCommitsForPath(ctx, repoUrl, targetBranch, path, pageSize, pageToken)
+	p.end(span, "GetCommitsForPath", err)
+
+	return commits, err
+}
+
+func (p instrumentedProvider) GetRepoDirFiles(ctx context.Context, repoUrl RepoURL, dirPath, targetBranch string) ([]*gitprovider.CommitFile, error) {
+	ctx, span := p.start(ctx, "GetRepoDirFiles", repoUrl)
+	files, err := p.GitProvider.GetRepoDirFiles(ctx, repoUrl, dirPath, targetBranch)
+	p.end(span, "GetRepoDirFiles", err)
+
+	return files, err
+}
+
+func (p instrumentedProvider) MergePullRequest(ctx context.Context, repoUrl RepoURL, pullRequestNumber int, commitMesage string) error {
+	ctx, span := p.start(ctx, "MergePullRequest", repoUrl)
+	err := p.GitProvider.MergePullRequest(ctx, repoUrl, pullRequestNumber, commitMesage)
+	p.end(span, "MergePullRequest", err)
+
+	return err
+}
@@ -37,6 +37,7 @@ type GitProvider interface {
 	UploadDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error
 	CreatePullRequest(ctx context.Context, repoUrl RepoURL, prInfo PullRequestInfo) (gitprovider.PullRequest, error)
 	GetCommits(ctx context.Context, repoUrl RepoURL, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error)
+	GetCommitsForPath(ctx context.Context, repoUrl RepoURL, targetBranch, path string, pageSize int, pageToken int) ([]gitprovider.Commit, error)
 	GetProviderDomain() string
 	GetRepoDirFiles(ctx context.Context, repoUrl RepoURL, dirPath, targetBranch string) ([]*gitprovider.CommitFile, error)
 	MergePullRequest(ctx context.Context, repoUrl RepoURL, pullRequestNumber int, commitMesage string) error
@@ -50,11 +51,42 @@ type PullRequestInfo struct {
 	NewBranch                 string
 	SkipAddingFilesOnCreation bool
 	Files                     []gitprovider.CommitFile
+	PullRequestOptions
+}
+
+// PullRequestOptions are the reviewers, labels, assignees and draft status of a pull request, which only
+// GitHub and GitLab can set. A reviewer of GitHub can be a team, given as org/team.
+type PullRequestOptions struct {
+	Reviewers []string
+	Labels    []string
+	Assignees []string
+	Draft     bool
 }
 
 type AccountTypeGetter func(provider gitprovider.Client, domain string, owner string) (ProviderAccountType, error)
 
 func New(config Config, owner string, getAccountType AccountTypeGetter) (GitProvider, error) {
+	// Bitbucket Server and Gitea aren't supported by go-git-providers, they have providers of their
+	// own which work the same way for the repositories of users and organizations
+	switch config.Provider {
+	case GitProviderBitbucketServer, GitProviderGitea:
+		provider, err := newSelfHostedProvider(config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build git provider: %w", err)
+		}
+
+		return instrument(provider, config.Provider), nil
+	case GitProviderGit:
+		// A plain git server has no API to instrument, and the auth service
+		// needs to find out the provider is a UserKeyProvider
+		provider, err := newPlainGitProvider(config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build git provider: %w", err)
+		}
+
+		return provider, nil
+	}
+
 	provider, domain, err := buildGitProvider(config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build git provider: %w", err)
@@ -66,16 +98,16 @@ func New(config Config, owner string, getAccountType AccountTypeGetter) (GitProv
 	}
 
 	if accountType == AccountTypeOrg {
-		return orgGitProvider{
+		return instrument(orgGitProvider{
 			domain:   domain,
 			provider: provider,
-		}, nil
+		}, config.Provider), nil
 	}
 
-	return userGitProvider{
+	return instrument(userGitProvider{
 		domain:   domain,
 		provider: provider,
-	}, nil
+	}, config.Provider), nil
 }
 
 func deployKeyExists(ctx context.Context, repo gitprovider.UserRepository) (bool, error) {
@@ -111,7 +143,13 @@ func uploadDeployKey(ctx context.Context, repo gitprovider.UserRepository, deplo
 	return nil
 }
 
-func createPullRequest(ctx context.Context, repo gitprovider.UserRepository, prInfo PullRequestInfo) (gitprovider.PullRequest, error) {
+// createPullRequest creates a pull request, which is returned with an ErrPullRequestOptionsNotSet error
+// when some of its labels, assignees or reviewers couldn't be set
+func createPullRequest(ctx context.Context, provider gitprovider.Client, repo gitprovider.UserRepository, repoUrl RepoURL, prInfo PullRequestInfo) (gitprovider.PullRequest, error) {
+	if prInfo.hasOptions() && !supportsPullRequestOptions(provider) {
+		return nil, ErrPullRequestOptionsNotSupported
+	}
+
 	repoInfo := repo.Get()
 
 	if prInfo.TargetBranch == "" {
@@ -119,12 +157,12 @@ func createPullRequest(ctx context.Context, repo gitprovider.UserRepository, prI
 	}
 
 	if prInfo.SkipAddingFilesOnCreation {
-		pr, err := repo.PullRequests().Create(ctx, prInfo.Title, prInfo.NewBranch, prInfo.TargetBranch, prInfo.Description)
-		if err != nil {
+		pr, err := openPullRequest(ctx, provider, repo, repoUrl, prInfo)
+		if err != nil && !errors.Is(err, ErrPullRequestOptionsNotSet) {
 			return nil, fmt.Errorf("error creating pull request %s: %w", prInfo.Title, err)
 		}
 
-		return pr, nil
+		return pr, err
 	}
 
 	commits, err := repo.Commits().ListPage(ctx, prInfo.TargetBranch, 1, 0)
@@ -146,12 +184,12 @@ func createPullRequest(ctx context.Context, repo gitprovider.UserRepository, prI
 		return nil, fmt.Errorf("error creating commit %s: %w", prInfo.NewBranch, err)
 	}
 
-	pr, err := repo.PullRequests().Create(ctx, prInfo.Title, prInfo.NewBranch, prInfo.TargetBranch, prInfo.Description)
-	if err != nil {
+	pr, err := openPullRequest(ctx, provider, repo, repoUrl, prInfo)
+	if err != nil && !errors.Is(err, ErrPullRequestOptionsNotSet) {
 		return nil, fmt.Errorf("error creating pull request %s: %w", prInfo.Title, err)
 	}
 
-	return pr, nil
+	return pr, err
 }
 
 func getCommits(ctx context.Context, repo gitprovider.UserRepository, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
@@ -0,0 +1,355 @@
+package gitproviders
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// The REST APIs of Bitbucket Server the provider uses
+const (
+	bitbucketServerAPI       = "/rest/api/1.0"
+	bitbucketServerKeysAPI   = "/rest/keys/1.0"
+	bitbucketServerBranchAPI = "/rest/branch-utils/1.0"
+)
+
+// bitbucketServerProvider is a GitProvider for Bitbucket Server, which go-git-providers doesn't support.
+// The owner of a repository is the key of its project, or ~<user> for the repositories of a user.
+type bitbucketServerProvider struct {
+	domain string
+	client apiClient
+}
+
+var _ GitProvider = bitbucketServerProvider{}
+
+// bitbucketServerRepoPath returns the path of a repository in one of the APIs, followed by the elements given
+func bitbucketServerRepoPath(api string, repoUrl RepoURL, elements ...string) string {
+	return strings.Join(append([]string{api, "projects", url.PathEscape(repoUrl.Owner()), "repos", url.PathEscape(repoUrl.RepositoryName())}, elements...), "/")
+}
+
+func (p bitbucketServerProvider) RepositoryExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	if _, err := p.getRepo(ctx, repoUrl); err != nil {
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("could not verify repository exists: %w", err)
+	}
+
+	return true, nil
+}
+
+func (p bitbucketServerProvider) DeployKeyExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	keys := bitbucketServerAccessKeys{}
+	if err := p.client.do(ctx, http.MethodGet, bitbucketServerRepoPath(bitbucketServerKeysAPI, repoUrl, "ssh"), url.Values{"limit": {"100"}}, nil, &keys); err != nil {
+		return false, fmt.Errorf("error getting deploy key %s: %w", DeployKeyName, err)
+	}
+
+	for _, key := range keys.Values {
+		if key.Key.Label == DeployKeyName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (p bitbucketServerProvider) UploadDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error {
+	key := bitbucketServerAccessKey{Permission: bitbucketServerPermissionWrite}
+	key.Key.Text = string(deployKey)
+	key.Key.Label = DeployKeyName
+
+	if err := p.client.do(ctx, http.MethodPost, bitbucketServerRepoPath(bitbucketServerKeysAPI, repoUrl, "ssh"), nil, key, nil); err != nil {
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return ErrRepositoryNoPermissionsOrDoesNotExist
+		}
+
+		return fmt.Errorf("error uploading deploy key %s", err)
+	}
+
+	return nil
+}
+
+func (p bitbucketServerProvider) GetDefaultBranch(ctx context.Context, repoUrl RepoURL) (string, error) {
+	ref := bitbucketServerRef{}
+	if err := p.client.do(ctx, http.MethodGet, bitbucketServerRepoPath(bitbucketServerAPI, repoUrl, "default-branch"), nil, nil, &ref); err != nil {
+		return "main", fmt.Errorf("error getting default branch %w", err)
+	}
+
+	return ref.DisplayID, nil
+}
+
+func (p bitbucketServerProvider) GetRepoVisibility(ctx context.Context, repoUrl RepoURL) (*gitprovider.RepositoryVisibility, error) {
+	repo, err := p.getRepo(ctx, repoUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility := gitprovider.RepositoryVisibilityPrivate
+	if repo.Public {
+		visibility = gitprovider.RepositoryVisibilityPublic
+	}
+
+	return &visibility, nil
+}
+
+func (p bitbucketServerProvider) getRepo(ctx context.Context, repoUrl RepoURL) (*bitbucketServerRepository, error) {
+	repo := &bitbucketServerRepository{}
+	if err := p.client.do(ctx, http.MethodGet, bitbucketServerRepoPath(bitbucketServerAPI, repoUrl), nil, nil, repo); err != nil {
+		return nil, fmt.Errorf("error getting repository %w", err)
+	}
+
+	return repo, nil
+}
+
+// CreatePullRequest creates a pull request, after committing the files of the request to its new branch
+func (p bitbucketServerProvider) CreatePullRequest(ctx context.Context, repoUrl RepoURL, prInfo PullRequestInfo) (gitprovider.PullRequest, error) {
+	if prInfo.hasOptions() {
+		return nil, ErrPullRequestOptionsNotSupported
+	}
+
+	if prInfo.TargetBranch == "" {
+		branch, err := p.GetDefaultBranch(ctx, repoUrl)
+		if err != nil {
+			return nil, fmt.Errorf("error getting repo for owner %s, repo %s, %w", repoUrl.Owner(), repoUrl.RepositoryName(), err)
+		}
+
+		prInfo.TargetBranch = branch
+	}
+
+	if !prInfo.SkipAddingFilesOnCreation {
+		if err := p.commitFiles(ctx, repoUrl, prInfo); err != nil {
+			return nil, err
+		}
+	}
+
+	pr := bitbucketServerPullRequest{}
+
+	if err := p.client.do(ctx, http.MethodPost, bitbucketServerRepoPath(bitbucketServerAPI, repoUrl, "pull-requests"), nil, bitbucketServerCreatePullRequest{
+		Title:       prInfo.Title,
+		Description: prInfo.Description,
+		FromRef:     bitbucketServerRef{ID: "refs/heads/" + prInfo.NewBranch},
+		ToRef:       bitbucketServerRef{ID: "refs/heads/" + prInfo.TargetBranch},
+	}, &pr); err != nil {
+		return nil, fmt.Errorf("error creating pull request %s: %w", prInfo.Title, err)
+	}
+
+	return pr, nil
+}
+
+// commitFiles creates the new branch of a pull request from the target branch, and commits its files to it.
+// The API of Bitbucket Server commits the files one by one, and can't delete them: the request is rejected with
+// ErrFileDeletionNotSupported before the branch is created when it has files without content.
+func (p bitbucketServerProvider) commitFiles(ctx context.Context, repoUrl RepoURL, prInfo PullRequestInfo) error {
+	for _, file := range prInfo.Files {
+		if file.Content == nil {
+			return fmt.Errorf("error creating commit %s: deleting %s: %w", prInfo.NewBranch, *file.Path, ErrFileDeletionNotSupported)
+		}
+	}
+
+	commits, err := p.listCommits(ctx, repoUrl, prInfo.TargetBranch, "", 1, 0)
+	if err != nil {
+		return fmt.Errorf("error getting commits: %w", err)
+	}
+
+	if len(commits) == 0 {
+		return fmt.Errorf("no commits on the target branch: %s", prInfo.TargetBranch)
+	}
+
+	head := commits[0].Get().Sha
+
+	if err := p.client.do(ctx, http.MethodPost, bitbucketServerRepoPath(bitbucketServerBranchAPI, repoUrl, "branches"), nil, bitbucketServerCreateBranch{
+		Name:       prInfo.NewBranch,
+		StartPoint: head,
+	}, nil); err != nil {
+		return fmt.Errorf("error creating branch %s: %w", prInfo.NewBranch, err)
+	}
+
+	for _, file := range prInfo.Files {
+		head, err = p.commitFile(ctx, repoUrl, prInfo.NewBranch, head, prInfo.CommitMessage, *file.Path, *file.Content)
+		if err != nil {
+			return fmt.Errorf("error creating commit %s: %w", prInfo.NewBranch, err)
+		}
+	}
+
+	return nil
+}
+
+// commitFile creates or updates a file on a branch whose head is the commit given, and returns the new head of the branch
+func (p bitbucketServerProvider) commitFile(ctx context.Context, repoUrl RepoURL, branch, head, message, path, content string) (string, error) {
+	exists := true
+
+	if err := p.client.do(ctx, http.MethodGet, bitbucketServerRepoPath(bitbucketServerAPI, repoUrl, "browse", path),
+		url.Values{"at": {"refs/heads/" + branch}, "type": {"true"}}, nil, nil); err != nil {
+		if !errors.Is(err, gitprovider.ErrNotFound) {
+			return "", fmt.Errorf("error getting file %s: %w", path, err)
+		}
+
+		exists = false
+	}
+
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+
+	fields := [][2]string{
+		{"content", content},
+		{"message", message},
+		{"branch", branch},
+	}
+
+	// The commit the file is changed from must only be given for the files that exist
+	if exists {
+		fields = append(fields, [2]string{"sourceCommitId", head})
+	}
+
+	for _, field := range fields {
+		if err := form.WriteField(field[0], field[1]); err != nil {
+			return "", err
+		}
+	}
+
+	if err := form.Close(); err != nil {
+		return "", err
+	}
+
+	header := http.Header{
+		"Accept":       {"application/json"},
+		"Content-Type": {form.FormDataContentType()},
+		// Multipart requests are rejected without it to protect the forms of the UI
+		"X-Atlassian-Token": {"no-check"},
+	}
+
+	res, err := p.client.send(ctx, http.MethodPut, bitbucketServerRepoPath(bitbucketServerAPI, repoUrl, "browse", path), nil, header, body)
+	if err != nil {
+		return "", fmt.Errorf("error committing file %s: %w", path, err)
+	}
+
+	created := bitbucketServerCommit{}
+	if err := json.Unmarshal(res, &created); err != nil {
+		return "", fmt.Errorf("could not decode commit of file %s: %w", path, err)
+	}
+
+	return created.ID, nil
+}
+
+func (p bitbucketServerProvider) GetCommits(ctx context.Context, repoUrl RepoURL, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	commits, err := p.listCommits(ctx, repoUrl, targetBranch, "", pageSize, pageToken)
+	if err != nil {
+		return nil, fmt.Errorf("error getting commits: %w", err)
+	}
+
+	return commits, nil
+}
+
+// GetCommitsForPath returns the commits of a branch that touched a file or a directory
+func (p bitbucketServerProvider) GetCommitsForPath(ctx context.Context, repoUrl RepoURL, targetBranch, path string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	commits, err := p.listCommits(ctx, repoUrl, targetBranch, path, pageSize, pageToken)
+	if err != nil {
+		return nil, fmt.Errorf("error getting commits of %s: %w", path, err)
+	}
+
+	return commits, nil
+}
+
+func (p bitbucketServerProvider) listCommits(ctx context.Context, repoUrl RepoURL, targetBranch, path string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	query := url.Values{
+		"until": {targetBranch},
+		"limit": {strconv.Itoa(pageSize)},
+	}
+
+	if path != "" {
+		query.Set("path", path)
+	}
+
+	// The pages start at the index of their first commit
+	if pageToken > 1 {
+		query.Set("start", strconv.Itoa((pageToken-1)*pageSize))
+	}
+
+	list := bitbucketServerCommits{}
+	if err := p.client.do(ctx, http.MethodGet, bitbucketServerRepoPath(bitbucketServerAPI, repoUrl, "commits"), query, nil, &list); err != nil {
+		return nil, err
+	}
+
+	commits := make([]gitprovider.Commit, 0, len(list.Values))
+
+	for i := range list.Values {
+		apiObj := &list.Values[i]
+
+		commits = append(commits, commit{
+			apiObj: apiObj,
+			info: gitprovider.CommitInfo{
+				Sha:       apiObj.ID,
+				Author:    apiObj.Author.Name,
+				Message:   apiObj.Message,
+				CreatedAt: time.UnixMilli(apiObj.AuthorTimestamp),
+				URL:       fmt.Sprintf("%s/projects/%s/repos/%s/commits/%s", p.client.baseURL, repoUrl.Owner(), repoUrl.RepositoryName(), apiObj.ID),
+			},
+		})
+	}
+
+	return commits, nil
+}
+
+func (p bitbucketServerProvider) GetProviderDomain() string {
+	return p.domain
+}
+
+// GetRepoDirFiles returns the files found in a directory, without the ones of its subdirectories. The dirPath must point to a directory, not a file.
+func (p bitbucketServerProvider) GetRepoDirFiles(ctx context.Context, repoUrl RepoURL, dirPath, targetBranch string) ([]*gitprovider.CommitFile, error) {
+	ref := "refs/heads/" + targetBranch
+
+	list := bitbucketServerFiles{}
+	if err := p.client.do(ctx, http.MethodGet, bitbucketServerRepoPath(bitbucketServerAPI, repoUrl, "files", dirPath),
+		url.Values{"at": {ref}, "limit": {"1000"}}, nil, &list); err != nil {
+		return nil, err
+	}
+
+	files := []*gitprovider.CommitFile{}
+
+	for _, name := range list.Values {
+		if strings.Contains(name, "/") {
+			continue
+		}
+
+		path := strings.TrimSuffix(dirPath, "/") + "/" + name
+
+		content, err := p.client.send(ctx, http.MethodGet, bitbucketServerRepoPath(bitbucketServerAPI, repoUrl, "raw", path), url.Values{"at": {ref}}, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting file %s: %w", path, err)
+		}
+
+		contentStr := string(content)
+
+		files = append(files, &gitprovider.CommitFile{
+			Path:    &path,
+			Content: &contentStr,
+		})
+	}
+
+	return files, nil
+}
+
+// MergePullRequest merges a pull request given the repository's URL and the PR's number with a commit message.
+func (p bitbucketServerProvider) MergePullRequest(ctx context.Context, repoUrl RepoURL, pullRequestNumber int, commitMesage string) error {
+	prPath := bitbucketServerRepoPath(bitbucketServerAPI, repoUrl, "pull-requests", strconv.Itoa(pullRequestNumber))
+
+	// Merging requires the version of the pull request, to make sure it wasn't changed since it was reviewed
+	pr := bitbucketServerPullRequest{}
+	if err := p.client.do(ctx, http.MethodGet, prPath, nil, nil, &pr); err != nil {
+		return fmt.Errorf("error getting pull request %d: %w", pullRequestNumber, err)
+	}
+
+	return p.client.do(ctx, http.MethodPost, prPath+"/merge", url.Values{"version": {strconv.Itoa(pr.Version)}},
+		bitbucketServerMergePullRequest{Message: commitMesage}, nil)
+}
@@ -0,0 +1,177 @@
+package gitproviders
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+var _ = Describe("Bitbucket Server Provider", func() {
+	var (
+		ctx       context.Context
+		transport *replayTransport
+		provider  GitProvider
+		repoUrl   RepoURL
+	)
+
+	const api = "https://bitbucket.example.com/rest/api/1.0/projects/proj/repos/podinfo"
+
+	newProvider := func(cassette string) {
+		transport = replayCassette(cassette)
+
+		var err error
+		provider, err = New(Config{
+			Provider:  GitProviderBitbucketServer,
+			Hostname:  "bitbucket.example.com:7999",
+			Token:     "token",
+			transport: transport,
+		}, "proj", GetAccountType)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		viper.Set("git-host-types", "bitbucket.example.com=bitbucket-server")
+
+		var err error
+		repoUrl, err = NewRepoURL("ssh://git@bitbucket.example.com:7999/proj/podinfo.git")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("repositories", func() {
+		BeforeEach(func() {
+			newProvider("bitbucket_server_repo")
+		})
+
+		It("checks whether a repository exists", func() {
+			exists, err := provider.RepositoryExists(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+
+			missing, err := NewRepoURL("https://bitbucket.example.com/scm/proj/missing.git")
+			Expect(err).ToNot(HaveOccurred())
+
+			exists, err = provider.RepositoryExists(ctx, missing)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+
+			Expect(transport.request(http.MethodGet, api).Header.Get("Authorization")).To(Equal("Bearer token"))
+		})
+
+		It("gets the default branch and the visibility", func() {
+			branch, err := provider.GetDefaultBranch(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(branch).To(Equal("main"))
+
+			visibility, err := provider.GetRepoVisibility(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*visibility).To(Equal(gitprovider.RepositoryVisibilityPrivate))
+		})
+
+		It("finds and uploads the deploy key", func() {
+			exists, err := provider.DeployKeyExists(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+
+			key := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl wego-deploy-key"
+			Expect(provider.UploadDeployKey(ctx, repoUrl, []byte(key))).To(Succeed())
+
+			req := transport.request(http.MethodPost, "https://bitbucket.example.com/rest/keys/1.0/projects/proj/repos/podinfo/ssh")
+			Expect(req.Body).To(MatchJSON(`{"key": {"text": "` + key + `", "label": "wego-deploy-key"}, "permission": "REPO_WRITE"}`))
+		})
+
+		It("gets the commits of a branch and of a path", func() {
+			commits, err := provider.GetCommits(ctx, repoUrl, "main", 2, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commits).To(HaveLen(2))
+
+			info := commits[0].Get()
+			Expect(info.Sha).To(Equal("5f2b3ae7c7df4e3b0cbd4bd0e0c7ff8ad0a0c3f4"))
+			Expect(info.Message).To(Equal("Add podinfo automation"))
+			Expect(info.Author).To(Equal("bot"))
+			Expect(info.CreatedAt).To(BeTemporally("==", time.Date(2022, 1, 3, 14, 0, 0, 0, time.UTC)))
+			Expect(info.URL).To(Equal("https://bitbucket.example.com/projects/proj/repos/podinfo/commits/5f2b3ae7c7df4e3b0cbd4bd0e0c7ff8ad0a0c3f4"))
+
+			commits, err = provider.GetCommitsForPath(ctx, repoUrl, "main", ".weave-gitops/apps/podinfo", 10, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commits).To(HaveLen(1))
+		})
+
+		It("gets the files of a directory without its subdirectories", func() {
+			files, err := provider.GetRepoDirFiles(ctx, repoUrl, ".weave-gitops/clusters/my-cluster/system", "main")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(2))
+			Expect(*files[0].Path).To(Equal(".weave-gitops/clusters/my-cluster/system/kustomization.yaml"))
+			Expect(*files[0].Content).To(ContainSubstring("kind: Kustomization"))
+			Expect(*files[1].Path).To(Equal(".weave-gitops/clusters/my-cluster/system/wego-app.yaml"))
+		})
+	})
+
+	Describe("pull requests", func() {
+		BeforeEach(func() {
+			newProvider("bitbucket_server_pull_request")
+		})
+
+		It("commits the files to a new branch and merges its pull request", func() {
+			kustomizationPath := "apps/podinfo/kustomization.yaml"
+			kustomization := "resources: []"
+			readmePath := "README.md"
+			readme := "# podinfo"
+
+			pr, err := provider.CreatePullRequest(ctx, repoUrl, PullRequestInfo{
+				Title:         "Add podinfo",
+				Description:   "Adds the automation of podinfo",
+				CommitMessage: "Add podinfo",
+				NewBranch:     "wego-add-podinfo",
+				Files: []gitprovider.CommitFile{
+					{Path: &kustomizationPath, Content: &kustomization},
+					{Path: &readmePath, Content: &readme},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pr.Get().Number).To(Equal(3))
+			Expect(pr.Get().WebURL).To(Equal("https://bitbucket.example.com/projects/PROJ/repos/podinfo/pull-requests/3"))
+
+			branch := transport.request(http.MethodPost, "https://bitbucket.example.com/rest/branch-utils/1.0/projects/proj/repos/podinfo/branches")
+			Expect(branch.Body).To(MatchJSON(`{"name": "wego-add-podinfo", "startPoint": "5f2b3ae7c7df4e3b0cbd4bd0e0c7ff8ad0a0c3f4"}`))
+
+			created := transport.request(http.MethodPut, api+"/browse/apps/podinfo/kustomization.yaml")
+			Expect(created.Header.Get("X-Atlassian-Token")).To(Equal("no-check"))
+			Expect(created.Body).To(ContainSubstring("resources: []"))
+			Expect(created.Body).ToNot(ContainSubstring("sourceCommitId"))
+
+			// The existing file is updated from the commit that created the first one
+			updated := transport.request(http.MethodPut, api+"/browse/README.md")
+			Expect(updated.Body).To(ContainSubstring("sourceCommitId"))
+			Expect(updated.Body).To(ContainSubstring("b1946ac92492d2347c6235b4d2611184a9c23c1d"))
+
+			Expect(transport.request(http.MethodPost, api+"/pull-requests").Body).To(MatchJSON(`{
+				"title": "Add podinfo",
+				"description": "Adds the automation of podinfo",
+				"fromRef": {"id": "refs/heads/wego-add-podinfo"},
+				"toRef": {"id": "refs/heads/main"}
+			}`))
+
+			Expect(provider.MergePullRequest(ctx, repoUrl, 3, "Merge podinfo")).To(Succeed())
+			Expect(transport.request(http.MethodPost, api+"/pull-requests/3/merge?version=1").Body).To(MatchJSON(`{"message": "Merge podinfo"}`))
+		})
+
+		It("can't delete files", func() {
+			path := "apps/podinfo/kustomization.yaml"
+
+			_, err := provider.CreatePullRequest(ctx, repoUrl, PullRequestInfo{
+				TargetBranch: "main",
+				NewBranch:    "wego-add-podinfo",
+				Files:        []gitprovider.CommitFile{{Path: &path}},
+			})
+			Expect(err).To(MatchError(ErrFileDeletionNotSupported))
+			Expect(err).To(MatchError(ContainSubstring("deleting apps/podinfo/kustomization.yaml")))
+		})
+	})
+})
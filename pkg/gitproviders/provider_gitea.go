@@ -0,0 +1,300 @@
+package gitproviders
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// giteaProvider is a GitProvider for Gitea, which go-git-providers doesn't support
+type giteaProvider struct {
+	domain string
+	client apiClient
+}
+
+var _ GitProvider = giteaProvider{}
+
+// giteaRepoPath returns the path of the API of a repository, followed by the elements given
+func giteaRepoPath(repoUrl RepoURL, elements ...string) string {
+	return strings.Join(append([]string{"/repos", url.PathEscape(repoUrl.Owner()), url.PathEscape(repoUrl.RepositoryName())}, elements...), "/")
+}
+
+func (p giteaProvider) RepositoryExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	if _, err := p.getRepo(ctx, repoUrl); err != nil {
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("could not verify repository exists: %w", err)
+	}
+
+	return true, nil
+}
+
+func (p giteaProvider) DeployKeyExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	keys := []giteaDeployKey{}
+	if err := p.client.do(ctx, http.MethodGet, giteaRepoPath(repoUrl, "keys"), nil, nil, &keys); err != nil {
+		return false, fmt.Errorf("error getting deploy key %s: %w", DeployKeyName, err)
+	}
+
+	for _, key := range keys {
+		if key.Title == DeployKeyName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (p giteaProvider) UploadDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error {
+	key := giteaDeployKey{
+		Title:    DeployKeyName,
+		Key:      string(deployKey),
+		ReadOnly: false,
+	}
+
+	if err := p.client.do(ctx, http.MethodPost, giteaRepoPath(repoUrl, "keys"), nil, key, nil); err != nil {
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return ErrRepositoryNoPermissionsOrDoesNotExist
+		}
+
+		return fmt.Errorf("error uploading deploy key %s", err)
+	}
+
+	return nil
+}
+
+func (p giteaProvider) GetDefaultBranch(ctx context.Context, repoUrl RepoURL) (string, error) {
+	repo, err := p.getRepo(ctx, repoUrl)
+	if err != nil {
+		return "main", err
+	}
+
+	return repo.DefaultBranch, nil
+}
+
+func (p giteaProvider) GetRepoVisibility(ctx context.Context, repoUrl RepoURL) (*gitprovider.RepositoryVisibility, error) {
+	repo, err := p.getRepo(ctx, repoUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility := gitprovider.RepositoryVisibilityPublic
+
+	switch {
+	case repo.Private:
+		visibility = gitprovider.RepositoryVisibilityPrivate
+	case repo.Internal:
+		visibility = gitprovider.RepositoryVisibilityInternal
+	}
+
+	return &visibility, nil
+}
+
+func (p giteaProvider) getRepo(ctx context.Context, repoUrl RepoURL) (*giteaRepository, error) {
+	repo := &giteaRepository{}
+	if err := p.client.do(ctx, http.MethodGet, giteaRepoPath(repoUrl), nil, nil, repo); err != nil {
+		return nil, fmt.Errorf("error getting repository %w", err)
+	}
+
+	return repo, nil
+}
+
+// CreatePullRequest creates a pull request, after committing the files of the request to its new branch in a single commit
+func (p giteaProvider) CreatePullRequest(ctx context.Context, repoUrl RepoURL, prInfo PullRequestInfo) (gitprovider.PullRequest, error) {
+	if prInfo.hasOptions() {
+		return nil, ErrPullRequestOptionsNotSupported
+	}
+
+	if prInfo.TargetBranch == "" {
+		branch, err := p.GetDefaultBranch(ctx, repoUrl)
+		if err != nil {
+			return nil, fmt.Errorf("error getting repo for owner %s, repo %s, %w", repoUrl.Owner(), repoUrl.RepositoryName(), err)
+		}
+
+		prInfo.TargetBranch = branch
+	}
+
+	if !prInfo.SkipAddingFilesOnCreation {
+		if err := p.commitFiles(ctx, repoUrl, prInfo); err != nil {
+			return nil, fmt.Errorf("error creating commit %s: %w", prInfo.NewBranch, err)
+		}
+	}
+
+	pr := giteaPullRequest{}
+
+	if err := p.client.do(ctx, http.MethodPost, giteaRepoPath(repoUrl, "pulls"), nil, giteaCreatePullRequest{
+		Head:  prInfo.NewBranch,
+		Base:  prInfo.TargetBranch,
+		Title: prInfo.Title,
+		Body:  prInfo.Description,
+	}, &pr); err != nil {
+		return nil, fmt.Errorf("error creating pull request %s: %w", prInfo.Title, err)
+	}
+
+	return pr, nil
+}
+
+// commitFiles commits the files of a pull request to its new branch, created from the target branch.
+// Files without content are deleted.
+func (p giteaProvider) commitFiles(ctx context.Context, repoUrl RepoURL, prInfo PullRequestInfo) error {
+	changes := giteaChangeFiles{
+		Branch:    prInfo.TargetBranch,
+		NewBranch: prInfo.NewBranch,
+		Message:   prInfo.CommitMessage,
+		Files:     []giteaChangeFile{},
+	}
+
+	for _, file := range prInfo.Files {
+		path := *file.Path
+
+		existing, err := p.getContent(ctx, repoUrl, path, prInfo.TargetBranch)
+		if err != nil && !errors.Is(err, gitprovider.ErrNotFound) {
+			return fmt.Errorf("error getting file %s: %w", path, err)
+		}
+
+		change := giteaChangeFile{Path: path}
+
+		switch {
+		case file.Content == nil && existing == nil:
+			continue
+		case file.Content == nil:
+			change.Operation = "delete"
+			change.SHA = existing.SHA
+		case existing == nil:
+			change.Operation = "create"
+			change.Content = base64.StdEncoding.EncodeToString([]byte(*file.Content))
+		default:
+			change.Operation = "update"
+			change.SHA = existing.SHA
+			change.Content = base64.StdEncoding.EncodeToString([]byte(*file.Content))
+		}
+
+		changes.Files = append(changes.Files, change)
+	}
+
+	return p.client.do(ctx, http.MethodPost, giteaRepoPath(repoUrl, "contents"), nil, changes, nil)
+}
+
+func (p giteaProvider) getContent(ctx context.Context, repoUrl RepoURL, path, targetBranch string) (*giteaContent, error) {
+	content := &giteaContent{}
+	if err := p.client.do(ctx, http.MethodGet, giteaRepoPath(repoUrl, "contents", path), url.Values{"ref": {targetBranch}}, nil, content); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+func (p giteaProvider) GetCommits(ctx context.Context, repoUrl RepoURL, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	return p.listCommits(ctx, repoUrl, targetBranch, "", pageSize, pageToken)
+}
+
+// GetCommitsForPath returns the commits of a branch that touched a file or a directory
+func (p giteaProvider) GetCommitsForPath(ctx context.Context, repoUrl RepoURL, targetBranch, path string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	return p.listCommits(ctx, repoUrl, targetBranch, path, pageSize, pageToken)
+}
+
+func (p giteaProvider) listCommits(ctx context.Context, repoUrl RepoURL, targetBranch, path string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	query := url.Values{
+		"sha":   {targetBranch},
+		"limit": {strconv.Itoa(pageSize)},
+	}
+
+	if path != "" {
+		query.Set("path", path)
+	}
+
+	if pageToken > 0 {
+		query.Set("page", strconv.Itoa(pageToken))
+	}
+
+	apiObjs := []giteaCommit{}
+	if err := p.client.do(ctx, http.MethodGet, giteaRepoPath(repoUrl, "commits"), query, nil, &apiObjs); err != nil {
+		if isEmptyRepoError(err) {
+			return []gitprovider.Commit{}, nil
+		}
+
+		return nil, fmt.Errorf("error getting commits: %w", err)
+	}
+
+	commits := make([]gitprovider.Commit, 0, len(apiObjs))
+
+	for i := range apiObjs {
+		apiObj := &apiObjs[i]
+
+		commits = append(commits, commit{
+			apiObj: apiObj,
+			info: gitprovider.CommitInfo{
+				Sha:       apiObj.SHA,
+				TreeSha:   apiObj.Commit.Tree.SHA,
+				Author:    apiObj.Commit.Author.Name,
+				Message:   apiObj.Commit.Message,
+				CreatedAt: apiObj.Commit.Author.Date,
+				URL:       apiObj.HTMLURL,
+			},
+		})
+	}
+
+	return commits, nil
+}
+
+func (p giteaProvider) GetProviderDomain() string {
+	return p.domain
+}
+
+// GetRepoDirFiles returns the files found in a directory, without the ones of its subdirectories. The dirPath must point to a directory, not a file.
+func (p giteaProvider) GetRepoDirFiles(ctx context.Context, repoUrl RepoURL, dirPath, targetBranch string) ([]*gitprovider.CommitFile, error) {
+	entries := []giteaContent{}
+	if err := p.client.do(ctx, http.MethodGet, giteaRepoPath(repoUrl, "contents", dirPath), url.Values{"ref": {targetBranch}}, nil, &entries); err != nil {
+		return nil, err
+	}
+
+	files := []*gitprovider.CommitFile{}
+
+	for _, entry := range entries {
+		if entry.Type != "file" {
+			continue
+		}
+
+		// The content of the files is only returned when they are read one by one
+		file, err := p.getContent(ctx, repoUrl, entry.Path, targetBranch)
+		if err != nil {
+			return nil, fmt.Errorf("error getting file %s: %w", entry.Path, err)
+		}
+
+		content := ""
+
+		if file.Content != nil {
+			decoded, err := base64.StdEncoding.DecodeString(*file.Content)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding file %s: %w", entry.Path, err)
+			}
+
+			content = string(decoded)
+		}
+
+		path := entry.Path
+
+		files = append(files, &gitprovider.CommitFile{
+			Path:    &path,
+			Content: &content,
+		})
+	}
+
+	return files, nil
+}
+
+// MergePullRequest merges a pull request given the repository's URL and the PR's number with a commit message.
+func (p giteaProvider) MergePullRequest(ctx context.Context, repoUrl RepoURL, pullRequestNumber int, commitMesage string) error {
+	return p.client.do(ctx, http.MethodPost, giteaRepoPath(repoUrl, "pulls", strconv.Itoa(pullRequestNumber), "merge"), nil, giteaMergePullRequest{
+		Do:                "merge",
+		MergeMessageField: commitMesage,
+	}, nil)
+}
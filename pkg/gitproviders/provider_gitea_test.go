@@ -0,0 +1,177 @@
+package gitproviders
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+var _ = Describe("Gitea Provider", func() {
+	var (
+		ctx       context.Context
+		transport *replayTransport
+		provider  GitProvider
+		repoUrl   RepoURL
+	)
+
+	const api = "https://gitea.example.com/api/v1/repos/bot/podinfo"
+
+	newProvider := func(cassette string) {
+		transport = replayCassette(cassette)
+
+		var err error
+		provider, err = New(Config{
+			Provider:  GitProviderGitea,
+			Hostname:  "gitea.example.com",
+			Token:     "token",
+			transport: transport,
+		}, "bot", GetAccountType)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		viper.Set("git-host-types", "gitea.example.com=gitea")
+
+		var err error
+		repoUrl, err = NewRepoURL("ssh://git@gitea.example.com:2222/bot/podinfo.git")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("repositories", func() {
+		BeforeEach(func() {
+			newProvider("gitea_repo")
+		})
+
+		It("checks whether a repository exists", func() {
+			exists, err := provider.RepositoryExists(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+
+			missing, err := NewRepoURL("https://gitea.example.com/bot/missing.git")
+			Expect(err).ToNot(HaveOccurred())
+
+			exists, err = provider.RepositoryExists(ctx, missing)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+
+			Expect(transport.request(http.MethodGet, api).Header.Get("Authorization")).To(Equal("token token"))
+		})
+
+		It("gets the default branch and the visibility", func() {
+			branch, err := provider.GetDefaultBranch(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(branch).To(Equal("main"))
+
+			visibility, err := provider.GetRepoVisibility(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*visibility).To(Equal(gitprovider.RepositoryVisibilityPrivate))
+		})
+
+		It("finds and uploads the deploy key", func() {
+			exists, err := provider.DeployKeyExists(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+
+			key := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"
+			Expect(provider.UploadDeployKey(ctx, repoUrl, []byte(key))).To(Succeed())
+			Expect(transport.request(http.MethodPost, api+"/keys").Body).To(MatchJSON(`{"title": "wego-deploy-key", "key": "` + key + `", "read_only": false}`))
+		})
+
+		It("gets the commits of a branch and of a path", func() {
+			commits, err := provider.GetCommits(ctx, repoUrl, "main", 2, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commits).To(HaveLen(2))
+
+			info := commits[0].Get()
+			Expect(info.Sha).To(Equal("5f2b3ae7c7df4e3b0cbd4bd0e0c7ff8ad0a0c3f4"))
+			Expect(info.TreeSha).To(Equal("ab4f3c2d9e0a1b2c3d4e5f60718293a4b5c6d7e8"))
+			Expect(info.Author).To(Equal("Bot"))
+			Expect(info.CreatedAt).To(BeTemporally("==", time.Date(2022, 1, 3, 14, 0, 0, 0, time.UTC)))
+			Expect(info.URL).To(Equal("https://gitea.example.com/bot/podinfo/commit/5f2b3ae7c7df4e3b0cbd4bd0e0c7ff8ad0a0c3f4"))
+
+			_, signature, ok := CommitSignature(commits[0])
+			Expect(ok).To(BeTrue())
+			Expect(signature).To(BeEmpty())
+
+			commits, err = provider.GetCommitsForPath(ctx, repoUrl, "main", ".weave-gitops/apps/podinfo", 10, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commits).To(HaveLen(1))
+		})
+
+		It("returns no commits for an empty repository", func() {
+			empty, err := NewRepoURL("https://gitea.example.com/bot/empty.git")
+			Expect(err).ToNot(HaveOccurred())
+
+			commits, err := provider.GetCommits(ctx, empty, "main", 10, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commits).To(BeEmpty())
+		})
+
+		It("gets the files of a directory without its subdirectories", func() {
+			files, err := provider.GetRepoDirFiles(ctx, repoUrl, ".weave-gitops/clusters/my-cluster/system", "main")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+			Expect(*files[0].Path).To(Equal(".weave-gitops/clusters/my-cluster/system/kustomization.yaml"))
+			Expect(*files[0].Content).To(ContainSubstring("kind: Kustomization"))
+		})
+	})
+
+	Describe("pull requests", func() {
+		BeforeEach(func() {
+			newProvider("gitea_pull_request")
+		})
+
+		It("commits the files to a new branch in a single commit and merges its pull request", func() {
+			kustomizationPath := "apps/podinfo/kustomization.yaml"
+			kustomization := "resources: []"
+			readmePath := "README.md"
+			readme := "# podinfo"
+			oldPath := "apps/podinfo/old.yaml"
+
+			pr, err := provider.CreatePullRequest(ctx, repoUrl, PullRequestInfo{
+				Title:         "Add podinfo",
+				Description:   "Adds the automation of podinfo",
+				CommitMessage: "Add podinfo",
+				NewBranch:     "wego-add-podinfo",
+				Files: []gitprovider.CommitFile{
+					{Path: &kustomizationPath, Content: &kustomization},
+					{Path: &readmePath, Content: &readme},
+					{Path: &oldPath},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pr.Get().Number).To(Equal(3))
+			Expect(pr.Get().WebURL).To(Equal("https://gitea.example.com/bot/podinfo/pulls/3"))
+
+			Expect(transport.request(http.MethodPost, api+"/contents").Body).To(MatchJSON(`{
+				"branch": "main",
+				"new_branch": "wego-add-podinfo",
+				"message": "Add podinfo",
+				"files": [
+					{"operation": "create", "path": "apps/podinfo/kustomization.yaml", "content": "cmVzb3VyY2VzOiBbXQ=="},
+					{"operation": "update", "path": "README.md", "content": "IyBwb2RpbmZv", "sha": "8baef1b4abc478178b004d62031cf7fe6db6f903"},
+					{"operation": "delete", "path": "apps/podinfo/old.yaml", "sha": "7c4a8d09ca3762af61e59520943dc26494f8941b"}
+				]
+			}`))
+
+			Expect(provider.MergePullRequest(ctx, repoUrl, 3, "Merge podinfo")).To(Succeed())
+			Expect(transport.request(http.MethodPost, api+"/pulls/3/merge").Body).To(MatchJSON(`{"Do": "merge", "MergeMessageField": "Merge podinfo"}`))
+		})
+
+		It("can't create draft pull requests", func() {
+			_, err := provider.CreatePullRequest(ctx, repoUrl, PullRequestInfo{
+				Title:              "Add podinfo",
+				NewBranch:          "wego-add-podinfo",
+				PullRequestOptions: PullRequestOptions{Draft: true},
+			})
+			Expect(err).To(MatchError(ErrPullRequestOptionsNotSupported))
+		})
+	})
+})
@@ -110,7 +110,7 @@ func (p orgGitProvider) CreatePullRequest(ctx context.Context, repoUrl RepoURL,
 		return nil, fmt.Errorf("error getting org repo for owner %s, repo %s, %w", repoUrl.Owner(), repoUrl.RepositoryName(), err)
 	}
 
-	return createPullRequest(ctx, orgRepo, prInfo)
+	return createPullRequest(ctx, p.provider, orgRepo, repoUrl, prInfo)
 }
 
 func (p orgGitProvider) GetCommits(ctx context.Context, repoUrl RepoURL, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
@@ -122,6 +122,11 @@ func (p orgGitProvider) GetCommits(ctx context.Context, repoUrl RepoURL, targetB
 	return getCommits(ctx, orgRepo, targetBranch, pageSize, pageToken)
 }
 
+// GetCommitsForPath returns the commits of a branch that touched a file or a directory
+func (p orgGitProvider) GetCommitsForPath(ctx context.Context, repoUrl RepoURL, targetBranch, path string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	return getCommitsForPath(ctx, p.provider, repoUrl, targetBranch, path, pageSize, pageToken)
+}
+
 func (p orgGitProvider) GetProviderDomain() string {
 	return getProviderDomain(p.provider.ProviderID())
 }
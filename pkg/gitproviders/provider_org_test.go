@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/weaveworks/weave-gitops/pkg/vendorfakes/fakegitprovider"
+	"github.com/xanzy/go-gitlab"
 )
 
 var _ = Describe("Org Provider", func() {
@@ -310,6 +314,32 @@ var _ = Describe("Org Provider", func() {
 		})
 	})
 
+	Describe("GetCommitsForPath", func() {
+		It("lists the commits of a path with the gitlab client", func() {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v4/projects/owner/repo-name/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Query().Get("ref_name")).To(Equal("main"))
+				Expect(r.URL.Query().Get("path")).To(Equal(".weave-gitops/apps/my-app"))
+
+				fmt.Fprint(w, `[{"id": "commit-sha", "author_name": "someone", "message": "Add my-app",
+					"created_at": "2021-11-30T10:00:00Z", "web_url": "https://gitlab.com/owner/repo-name/-/commit/commit-sha"}]`)
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, err := gitlab.NewClient("token", gitlab.WithBaseURL(server.URL))
+			Expect(err).ToNot(HaveOccurred())
+			gitProviderClient.RawReturns(client)
+
+			commits, err := orgProvider.GetCommitsForPath(context.Background(), repoUrl, "main", ".weave-gitops/apps/my-app", 10, 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commits).To(HaveLen(1))
+			Expect(commits[0].Get().Sha).To(Equal("commit-sha"))
+			Expect(commits[0].Get().CreatedAt).To(Equal(time.Date(2021, 11, 30, 10, 0, 0, 0, time.UTC)))
+		})
+	})
+
 	Describe("GetProviderDomain", func() {
 		It("returns provider domain", func() {
 			gitProviderClient.ProviderIDReturns("github")
@@ -0,0 +1,633 @@
+package gitproviders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/weaveworks/weave-gitops/pkg/git"
+)
+
+const (
+	plainGitRemoteName = "origin"
+	plainGitSSHUser    = "git"
+	// plainGitPullRefs is where the pull requests are recorded on the remote, like GitHub does: the branch of pull
+	// request <n> is at refs/pull/<n>/head, and the name of refs/pull/<n>/base/<branch> is its target branch
+	plainGitPullRefs = "refs/pull/"
+)
+
+// UserKeyProvider is implemented by the git providers which can't register a deploy key on the repositories.
+// Their repositories are accessed with a private key provided by the user instead.
+type UserKeyProvider interface {
+	// PrivateKeyFile is the path of the private key, empty when none was provided
+	PrivateKeyFile() string
+}
+
+// CommitterProvider is implemented by the git providers which make the commits of the pull requests themselves
+// rather than through an API. WithCommitter returns a copy of the provider making them with an author, and
+// signing them with a signer when it isn't nil.
+type CommitterProvider interface {
+	WithCommitter(author git.Author, signer git.Signer) GitProvider
+}
+
+// plainGitProvider is a GitProvider for git servers without a hosting API, e.g. git daemon or gitolite.
+// It only talks git: the default branch is read from the HEAD of the remote, the repositories are
+// considered private, and the branches of the pull requests are pushed without opening pull requests.
+// The repositories are cloned from the URL they were given with, and the commits are made by git.DefaultAuthor
+// unless another committer is set.
+type plainGitProvider struct {
+	domain         string
+	privateKeyFile string
+	keys           *ssh.PublicKeys
+	author         git.Author
+	signer         git.Signer
+}
+
+var (
+	_ GitProvider       = &plainGitProvider{}
+	_ UserKeyProvider   = &plainGitProvider{}
+	_ CommitterProvider = &plainGitProvider{}
+)
+
+func newPlainGitProvider(config Config) (*plainGitProvider, error) {
+	hostname := config.Hostname
+	if host, _, err := net.SplitHostPort(hostname); err == nil {
+		hostname = host
+	}
+
+	provider := &plainGitProvider{
+		domain:         hostname,
+		privateKeyFile: config.PrivateKeyFile,
+		author:         git.DefaultAuthor,
+	}
+
+	// Without a private key the repositories are accessed with the keys of the local ssh-agent
+	if config.PrivateKeyFile != "" {
+		keys, err := ssh.NewPublicKeysFromFile(plainGitSSHUser, config.PrivateKeyFile, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed reading private key %s: %w", config.PrivateKeyFile, err)
+		}
+
+		provider.keys = keys
+	}
+
+	return provider, nil
+}
+
+func (p *plainGitProvider) PrivateKeyFile() string {
+	return p.privateKeyFile
+}
+
+// WithCommitter returns a copy of the provider committing with an author and a signer, an empty author keeps
+// the one of the provider
+func (p *plainGitProvider) WithCommitter(author git.Author, signer git.Signer) GitProvider {
+	committer := *p
+
+	if author != (git.Author{}) {
+		committer.author = author
+	}
+
+	committer.signer = signer
+
+	return &committer
+}
+
+func (p *plainGitProvider) RepositoryExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	if _, err := p.listRefs(ctx, repoUrl); err != nil {
+		if errors.Is(err, transport.ErrRepositoryNotFound) {
+			return false, nil
+		}
+
+		if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+			return true, nil
+		}
+
+		return false, fmt.Errorf("could not verify repository exists: %w", err)
+	}
+
+	return true, nil
+}
+
+// DeployKeyExists is always true, the repositories are accessed with the key of the user
+func (p *plainGitProvider) DeployKeyExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	return true, nil
+}
+
+func (p *plainGitProvider) UploadDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error {
+	return fmt.Errorf("deploy keys can't be uploaded to %s, provide the private key with access to %s with --git-private-key-file", p.domain, repoUrl)
+}
+
+// GetDefaultBranch returns the branch the HEAD of the remote points to, main when the repository is empty
+func (p *plainGitProvider) GetDefaultBranch(ctx context.Context, repoUrl RepoURL) (string, error) {
+	refs, err := p.listRefs(ctx, repoUrl)
+	if err != nil {
+		if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+			return "main", nil
+		}
+
+		return "main", fmt.Errorf("error getting the default branch of %s: %w", repoUrl, err)
+	}
+
+	var head *plumbing.Reference
+
+	for _, ref := range refs {
+		if ref.Name() == plumbing.HEAD {
+			head = ref
+		}
+	}
+
+	if head == nil {
+		return "main", fmt.Errorf("the remote of %s has no HEAD", repoUrl)
+	}
+
+	if head.Type() == plumbing.SymbolicReference {
+		return head.Target().Short(), nil
+	}
+
+	// Remotes which don't advertise the target of HEAD point it to the hash of the default branch
+	for _, ref := range refs {
+		if ref.Name().IsBranch() && ref.Hash() == head.Hash() {
+			return ref.Name().Short(), nil
+		}
+	}
+
+	return "main", fmt.Errorf("the HEAD of %s doesn't point to a branch", repoUrl)
+}
+
+// GetRepoVisibility returns private, the visibility of the repositories isn't known without an API
+func (p *plainGitProvider) GetRepoVisibility(ctx context.Context, repoUrl RepoURL) (*gitprovider.RepositoryVisibility, error) {
+	return gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPrivate), nil
+}
+
+// CreatePullRequest commits the files of the request to its new branch in a single commit and pushes the branch.
+// There are no pull requests without an API: the pushed branch is returned, it is merged with MergePullRequest.
+func (p *plainGitProvider) CreatePullRequest(ctx context.Context, repoUrl RepoURL, prInfo PullRequestInfo) (gitprovider.PullRequest, error) {
+	if prInfo.hasOptions() {
+		return nil, ErrPullRequestOptionsNotSupported
+	}
+
+	if prInfo.TargetBranch == "" {
+		branch, err := p.GetDefaultBranch(ctx, repoUrl)
+		if err != nil {
+			return nil, err
+		}
+
+		prInfo.TargetBranch = branch
+	}
+
+	refs, err := p.listRefs(ctx, repoUrl)
+	if err != nil && !errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		return nil, fmt.Errorf("error listing the refs of %s: %w", repoUrl, err)
+	}
+
+	branch := pushedBranch{
+		number:       nextPullRequestNumber(refs),
+		repository:   repoUrl.CloneURL(),
+		branch:       prInfo.NewBranch,
+		targetBranch: prInfo.TargetBranch,
+	}
+
+	repo, err := p.clone(ctx, repoUrl, prInfo.TargetBranch, memfs.New())
+	if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		repo, err = p.init(repoUrl)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error cloning %s: %w", repoUrl, err)
+	}
+
+	newBranch := plumbing.NewBranchReferenceName(prInfo.NewBranch)
+
+	if err := checkoutNewBranch(repo, newBranch); err != nil {
+		return nil, fmt.Errorf("error creating branch %s: %w", prInfo.NewBranch, err)
+	}
+
+	if !prInfo.SkipAddingFilesOnCreation {
+		if err := p.commitFiles(repo, prInfo); err != nil {
+			return nil, fmt.Errorf("error creating commit %s: %w", prInfo.NewBranch, err)
+		}
+	}
+
+	// The pull request is recorded on the remote with the branch, for MergePullRequest to find it
+	if err := p.push(ctx, repoUrl, repo,
+		refSpec(newBranch, newBranch),
+		refSpec(newBranch, branch.headRef()),
+		refSpec(newBranch, branch.baseRef()),
+	); err != nil {
+		return nil, fmt.Errorf("error pushing branch %s: %w", prInfo.NewBranch, err)
+	}
+
+	return branch, nil
+}
+
+// MergePullRequest fast-forwards the target branch of a pull request to its branch, both read from the refs
+// of the pull request on the remote. The commit message isn't used.
+func (p *plainGitProvider) MergePullRequest(ctx context.Context, repoUrl RepoURL, pullRequestNumber int, commitMesage string) error {
+	refs, err := p.listRefs(ctx, repoUrl)
+	if err != nil {
+		return fmt.Errorf("error listing the refs of %s: %w", repoUrl, err)
+	}
+
+	pr := pushedBranch{number: pullRequestNumber}
+	headRef := pr.headRef()
+	found := false
+
+	for _, ref := range refs {
+		name := ref.Name().String()
+
+		if name == headRef.String() {
+			found = true
+		}
+
+		if strings.HasPrefix(name, pr.basePrefix()) {
+			pr.targetBranch = strings.TrimPrefix(name, pr.basePrefix())
+		}
+	}
+
+	if !found || pr.targetBranch == "" {
+		return fmt.Errorf("no pull request %d was pushed to %s", pullRequestNumber, repoUrl)
+	}
+
+	repo, err := p.init(repoUrl)
+	if err != nil {
+		return err
+	}
+
+	auth, err := p.auth(repoUrl)
+	if err != nil {
+		return err
+	}
+
+	if err := repo.FetchContext(ctx, &gogit.FetchOptions{
+		RemoteName: plainGitRemoteName,
+		Auth:       auth,
+		RefSpecs:   []config.RefSpec{refSpec(headRef, headRef)},
+	}); err != nil {
+		return fmt.Errorf("error fetching pull request %d: %w", pullRequestNumber, err)
+	}
+
+	if err := p.push(ctx, repoUrl, repo, refSpec(headRef, plumbing.NewBranchReferenceName(pr.targetBranch))); err != nil {
+		return fmt.Errorf("error merging pull request %d to %s: %w", pullRequestNumber, pr.targetBranch, err)
+	}
+
+	return nil
+}
+
+func (p *plainGitProvider) GetCommits(ctx context.Context, repoUrl RepoURL, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	return p.listCommits(ctx, repoUrl, targetBranch, "", pageSize, pageToken)
+}
+
+// GetCommitsForPath returns the commits of a branch that touched a file or a directory
+func (p *plainGitProvider) GetCommitsForPath(ctx context.Context, repoUrl RepoURL, targetBranch, path string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	return p.listCommits(ctx, repoUrl, targetBranch, path, pageSize, pageToken)
+}
+
+func (p *plainGitProvider) listCommits(ctx context.Context, repoUrl RepoURL, targetBranch, path string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	repo, err := p.clone(ctx, repoUrl, targetBranch, nil)
+	if err != nil {
+		if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+			return []gitprovider.Commit{}, nil
+		}
+
+		return nil, fmt.Errorf("error getting commits: %w", err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("error getting commits: %w", err)
+	}
+
+	logOptions := &gogit.LogOptions{From: head.Hash()}
+
+	if path != "" {
+		path = strings.Trim(path, "/")
+		logOptions.PathFilter = func(file string) bool {
+			return file == path || strings.HasPrefix(file, path+"/")
+		}
+	}
+
+	iter, err := repo.Log(logOptions)
+	if err != nil {
+		return nil, fmt.Errorf("error getting commits: %w", err)
+	}
+	defer iter.Close()
+
+	// The pages start at 1, like the ones of the APIs of the other providers
+	page := pageToken
+	if page < 1 {
+		page = 1
+	}
+
+	skip := (page - 1) * pageSize
+	commits := []gitprovider.Commit{}
+
+	err = iter.ForEach(func(c *object.Commit) error {
+		if skip > 0 {
+			skip--
+			return nil
+		}
+
+		if len(commits) == pageSize {
+			return storer.ErrStop
+		}
+
+		commits = append(commits, commit{
+			apiObj: c,
+			info: gitprovider.CommitInfo{
+				Sha:       c.Hash.String(),
+				TreeSha:   c.TreeHash.String(),
+				Author:    c.Author.Name,
+				Message:   c.Message,
+				CreatedAt: c.Author.When.UTC(),
+			},
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting commits: %w", err)
+	}
+
+	return commits, nil
+}
+
+func (p *plainGitProvider) GetProviderDomain() string {
+	return p.domain
+}
+
+// GetRepoDirFiles returns the files found in a directory, without the ones of its subdirectories. The dirPath must point to a directory, not a file.
+func (p *plainGitProvider) GetRepoDirFiles(ctx context.Context, repoUrl RepoURL, dirPath, targetBranch string) ([]*gitprovider.CommitFile, error) {
+	repo, err := p.clone(ctx, repoUrl, targetBranch, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error cloning %s: %w", repoUrl, err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, err
+	}
+
+	headCommit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := headCommit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := tree.Tree(strings.Trim(dirPath, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("error getting directory %s: %w", dirPath, err)
+	}
+
+	files := []*gitprovider.CommitFile{}
+
+	for i := range dir.Entries {
+		entry := &dir.Entries[i]
+		if !entry.Mode.IsFile() {
+			continue
+		}
+
+		file, err := dir.TreeEntryFile(entry)
+		if err != nil {
+			return nil, fmt.Errorf("error getting file %s: %w", entry.Name, err)
+		}
+
+		content, err := file.Contents()
+		if err != nil {
+			return nil, fmt.Errorf("error reading file %s: %w", entry.Name, err)
+		}
+
+		path := strings.Trim(dirPath, "/") + "/" + entry.Name
+
+		files = append(files, &gitprovider.CommitFile{
+			Path:    &path,
+			Content: &content,
+		})
+	}
+
+	return files, nil
+}
+
+// auth returns the private key of the user for the SSH URLs, with the user of the URL. The other URLs, and
+// the SSH ones when no private key was given, are accessed without it.
+func (p *plainGitProvider) auth(repoUrl RepoURL) (transport.AuthMethod, error) {
+	endpoint, err := transport.NewEndpoint(repoUrl.CloneURL())
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL %s: %w", repoUrl.CloneURL(), err)
+	}
+
+	if endpoint.Protocol != "ssh" || p.keys == nil {
+		return nil, nil
+	}
+
+	keys := *p.keys
+
+	if endpoint.User != "" {
+		keys.User = endpoint.User
+	}
+
+	return &keys, nil
+}
+
+func (p *plainGitProvider) listRefs(ctx context.Context, repoUrl RepoURL) ([]*plumbing.Reference, error) {
+	auth, err := p.auth(repoUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	remote := gogit.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: plainGitRemoteName,
+		URLs: []string{repoUrl.CloneURL()},
+	})
+
+	return remote.ListContext(ctx, &gogit.ListOptions{Auth: auth})
+}
+
+// clone clones a branch of a repository in memory, without a worktree when fs is nil
+func (p *plainGitProvider) clone(ctx context.Context, repoUrl RepoURL, branch string, fs billy.Filesystem) (*gogit.Repository, error) {
+	auth, err := p.auth(repoUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return gogit.CloneContext(ctx, memory.NewStorage(), fs, &gogit.CloneOptions{
+		URL:           repoUrl.CloneURL(),
+		Auth:          auth,
+		RemoteName:    plainGitRemoteName,
+		ReferenceName: plumbing.NewBranchReferenceName(branch),
+		SingleBranch:  true,
+	})
+}
+
+// init initializes a repository in memory for a remote without any commits
+func (p *plainGitProvider) init(repoUrl RepoURL) (*gogit.Repository, error) {
+	repo, err := gogit.Init(memory.NewStorage(), memfs.New())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := repo.CreateRemote(&config.RemoteConfig{
+		Name: plainGitRemoteName,
+		URLs: []string{repoUrl.CloneURL()},
+	}); err != nil {
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+func (p *plainGitProvider) push(ctx context.Context, repoUrl RepoURL, repo *gogit.Repository, refSpecs ...config.RefSpec) error {
+	auth, err := p.auth(repoUrl)
+	if err != nil {
+		return err
+	}
+
+	return repo.PushContext(ctx, &gogit.PushOptions{
+		RemoteName: plainGitRemoteName,
+		Auth:       auth,
+		RefSpecs:   refSpecs,
+	})
+}
+
+func refSpec(from, to plumbing.ReferenceName) config.RefSpec {
+	return config.RefSpec(from.String() + ":" + to.String())
+}
+
+// nextPullRequestNumber returns the number following the ones of the pull requests recorded on a remote
+func nextPullRequestNumber(refs []*plumbing.Reference) int {
+	last := 0
+
+	for _, ref := range refs {
+		name := ref.Name().String()
+		if !strings.HasPrefix(name, plainGitPullRefs) || !strings.HasSuffix(name, "/head") {
+			continue
+		}
+
+		number, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, plainGitPullRefs), "/head"))
+		if err == nil && number > last {
+			last = number
+		}
+	}
+
+	return last + 1
+}
+
+// checkoutNewBranch creates a branch from the HEAD of a repository. In a repository without commits,
+// HEAD is pointed to the branch, which is created by the first commit.
+func checkoutNewBranch(repo *gogit.Repository, branch plumbing.ReferenceName) error {
+	if _, err := repo.Head(); errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return repo.Storer.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, branch))
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+
+	return wt.Checkout(&gogit.CheckoutOptions{Branch: branch, Create: true})
+}
+
+// commitFiles commits the files of a pull request to the checked out branch with the author and the signer of the
+// provider. Files without content are deleted.
+func (p *plainGitProvider) commitFiles(repo *gogit.Repository, prInfo PullRequestInfo) error {
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+
+	for _, file := range prInfo.Files {
+		path := strings.TrimPrefix(*file.Path, "/")
+
+		if file.Content == nil {
+			if _, err := wt.Filesystem.Stat(path); err != nil {
+				continue
+			}
+
+			if _, err := wt.Remove(path); err != nil {
+				return fmt.Errorf("error removing file %s: %w", path, err)
+			}
+
+			continue
+		}
+
+		if err := util.WriteFile(wt.Filesystem, path, []byte(*file.Content), 0644); err != nil {
+			return fmt.Errorf("error writing file %s: %w", path, err)
+		}
+
+		if _, err := wt.Add(path); err != nil {
+			return fmt.Errorf("error adding file %s: %w", path, err)
+		}
+	}
+
+	commit, err := wt.Commit(prInfo.CommitMessage, &gogit.CommitOptions{
+		Author: &object.Signature{
+			Name:  p.author.Name,
+			Email: p.author.Email,
+			When:  time.Now(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if p.signer != nil {
+		if _, err := git.SignCommit(repo, commit, p.signer); err != nil {
+			return fmt.Errorf("error signing commit: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// pushedBranch is the gitprovider.PullRequest of a plainGitProvider, the branch pushed for the request
+type pushedBranch struct {
+	number       int
+	repository   string
+	branch       string
+	targetBranch string
+}
+
+// headRef is the ref of the branch of the pull request on the remote
+func (b pushedBranch) headRef() plumbing.ReferenceName {
+	return plumbing.ReferenceName(fmt.Sprintf("%s%d/head", plainGitPullRefs, b.number))
+}
+
+// baseRef is the ref naming the target branch of the pull request on the remote
+func (b pushedBranch) baseRef() plumbing.ReferenceName {
+	return plumbing.ReferenceName(b.basePrefix() + b.targetBranch)
+}
+
+func (b pushedBranch) basePrefix() string {
+	return fmt.Sprintf("%s%d/base/", plainGitPullRefs, b.number)
+}
+
+func (b pushedBranch) APIObject() interface{} {
+	return &b
+}
+
+// Get returns the branch pushed as the WebURL, which is reported to the user in place of the URL of a pull request
+func (b pushedBranch) Get() gitprovider.PullRequestInfo {
+	return gitprovider.PullRequestInfo{
+		Number: b.number,
+		WebURL: fmt.Sprintf("branch %s pushed to %s, merge it to %s", b.branch, b.repository, b.targetBranch),
+	}
+}
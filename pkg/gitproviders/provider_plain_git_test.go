@@ -0,0 +1,392 @@
+package gitproviders
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	gitopsgit "github.com/weaveworks/weave-gitops/pkg/git"
+)
+
+var _ = Describe("Plain Git Provider", func() {
+	var (
+		ctx      context.Context
+		dir      string
+		bare     string
+		provider GitProvider
+		repoUrl  RepoURL
+		signKey  *openpgp.Entity
+	)
+
+	// seed commits files to a branch of the bare repository, one commit per file
+	seed := func(branch string, files map[string]string, order ...string) {
+		repo, err := git.Init(memory.NewStorage(), memfs.New())
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = repo.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{bare}})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(repo.Storer.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, plumbing.NewBranchReferenceName(branch)))).To(Succeed())
+
+		wt, err := repo.Worktree()
+		Expect(err).ToNot(HaveOccurred())
+
+		for i, path := range order {
+			Expect(util.WriteFile(wt.Filesystem, path, []byte(files[path]), 0644)).To(Succeed())
+			_, err = wt.Add(path)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = wt.Commit("Add "+path, &git.CommitOptions{
+				Author: &object.Signature{
+					Name: "Bot",
+					When: time.Date(2022, 1, 3, 14, i, 0, 0, time.UTC),
+				},
+				SignKey: signKey,
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Expect(repo.Push(&git.PushOptions{RemoteName: "origin"})).To(Succeed())
+	}
+
+	// local returns the RepoURL of a repository cloned from a local bare repository of the test
+	local := func(uri, name string) RepoURL {
+		repoUrl, err := NewRepoURL(uri)
+		Expect(err).ToNot(HaveOccurred())
+
+		repoUrl.cloneURL = filepath.Join(dir, name)
+
+		return repoUrl
+	}
+
+	// read returns the content of a file of a branch of the bare repository
+	read := func(branch, path string) string {
+		repo, err := git.PlainOpen(bare)
+		Expect(err).ToNot(HaveOccurred())
+
+		ref, err := repo.Reference(plumbing.NewBranchReferenceName(branch), true)
+		Expect(err).ToNot(HaveOccurred())
+
+		c, err := repo.CommitObject(ref.Hash())
+		Expect(err).ToNot(HaveOccurred())
+
+		file, err := c.File(path)
+		if err != nil {
+			return ""
+		}
+
+		content, err := file.Contents()
+		Expect(err).ToNot(HaveOccurred())
+
+		return content
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		signKey = nil
+
+		var err error
+		dir, err = ioutil.TempDir("", "plain-git")
+		Expect(err).ToNot(HaveOccurred())
+
+		bare = filepath.Join(dir, "podinfo.git")
+
+		repo, err := git.PlainInit(bare, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(repo.Storer.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, plumbing.NewBranchReferenceName("trunk")))).To(Succeed())
+
+		viper.Set("git-host-types", "git.example.com=git")
+
+		repoUrl = local("ssh://git@git.example.com/srv/git/podinfo.git", "podinfo.git")
+
+		provider, err = New(Config{
+			Provider: GitProviderGit,
+			Hostname: "git.example.com:2222",
+		}, repoUrl.Owner(), GetAccountType)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("has no deploy key nor visibility", func() {
+		Expect(provider.GetProviderDomain()).To(Equal("git.example.com"))
+		Expect(provider.(UserKeyProvider).PrivateKeyFile()).To(BeEmpty())
+
+		exists, err := provider.DeployKeyExists(ctx, repoUrl)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+
+		err = provider.UploadDeployKey(ctx, repoUrl, []byte("ssh-ed25519 AAAA"))
+		Expect(err).To(MatchError(ContainSubstring("--git-private-key-file")))
+
+		visibility, err := provider.GetRepoVisibility(ctx, repoUrl)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*visibility).To(Equal(gitprovider.RepositoryVisibilityPrivate))
+	})
+
+	It("fails to read a private key which doesn't exist", func() {
+		_, err := New(Config{
+			Provider:       GitProviderGit,
+			Hostname:       "git.example.com",
+			PrivateKeyFile: filepath.Join(dir, "missing"),
+		}, "", GetAccountType)
+		Expect(err).To(MatchError(ContainSubstring("failed reading private key")))
+	})
+
+	It("accesses the SSH URLs with the private key and the user of the URL", func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+
+		keyFile := filepath.Join(dir, "id_rsa")
+		Expect(ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), 0600)).To(Succeed())
+
+		withKey, err := newPlainGitProvider(Config{
+			Provider:       GitProviderGit,
+			Hostname:       "git.example.com",
+			PrivateKeyFile: keyFile,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		userOf := func(uri string) string {
+			repoUrl, err := NewRepoURL(uri)
+			Expect(err).ToNot(HaveOccurred())
+
+			auth, err := withKey.auth(repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+
+			if auth == nil {
+				return ""
+			}
+
+			return auth.(*ssh.PublicKeys).User
+		}
+
+		Expect(userOf("ssh://alice@git.example.com:2222/srv/git/podinfo.git")).To(Equal("alice"))
+		Expect(userOf("git@git.example.com:srv/podinfo.git")).To(Equal("git"))
+		Expect(userOf("https://git.example.com/repos/podinfo")).To(BeEmpty())
+		Expect(userOf("git://git.example.com/podinfo.git")).To(BeEmpty())
+	})
+
+	Describe("an empty repository", func() {
+		It("exists and defaults to main", func() {
+			exists, err := provider.RepositoryExists(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+
+			branch, err := provider.GetDefaultBranch(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(branch).To(Equal("main"))
+
+			commits, err := provider.GetCommits(ctx, repoUrl, "main", 10, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commits).To(BeEmpty())
+		})
+
+		It("pushes the first commit to a branch and merges it", func() {
+			path := ".weave-gitops/clusters/kind/system/wego-system.yaml"
+			content := "kind: Namespace"
+
+			pr, err := provider.CreatePullRequest(ctx, repoUrl, PullRequestInfo{
+				CommitMessage: "Add the cluster",
+				NewBranch:     "wego-kind",
+				Files:         []gitprovider.CommitFile{{Path: &path, Content: &content}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pr.Get().WebURL).To(Equal("branch wego-kind pushed to " + bare + ", merge it to main"))
+			Expect(read("wego-kind", path)).To(Equal(content))
+
+			Expect(provider.MergePullRequest(ctx, repoUrl, pr.Get().Number, "Merge")).To(Succeed())
+			Expect(read("main", path)).To(Equal(content))
+		})
+	})
+
+	It("doesn't find a missing repository", func() {
+		missing := local("ssh://git@git.example.com/srv/git/missing.git", "missing.git")
+
+		exists, err := provider.RepositoryExists(ctx, missing)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	Describe("a repository", func() {
+		BeforeEach(func() {
+			seed("trunk", map[string]string{
+				"README.md":                                      "# podinfo",
+				".weave-gitops/apps/podinfo/app.yaml":            "kind: Application",
+				".weave-gitops/apps/podinfo/kustomization.yaml":  "kind: Kustomization",
+				".weave-gitops/apps/podinfo/overlays/patch.yaml": "kind: Patch",
+			}, "README.md", ".weave-gitops/apps/podinfo/app.yaml", ".weave-gitops/apps/podinfo/kustomization.yaml", ".weave-gitops/apps/podinfo/overlays/patch.yaml")
+		})
+
+		It("gets the default branch from the HEAD of the remote", func() {
+			branch, err := provider.GetDefaultBranch(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(branch).To(Equal("trunk"))
+		})
+
+		It("gets the pages of the commits of a branch and of a path", func() {
+			commits, err := provider.GetCommits(ctx, repoUrl, "trunk", 3, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commits).To(HaveLen(3))
+
+			info := commits[0].Get()
+			Expect(info.Message).To(Equal("Add .weave-gitops/apps/podinfo/overlays/patch.yaml"))
+			Expect(info.Author).To(Equal("Bot"))
+			Expect(info.CreatedAt).To(BeTemporally("==", time.Date(2022, 1, 3, 14, 3, 0, 0, time.UTC)))
+			Expect(info.Sha).To(HaveLen(40))
+
+			commits, err = provider.GetCommits(ctx, repoUrl, "trunk", 3, 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commits).To(HaveLen(1))
+			Expect(commits[0].Get().Message).To(Equal("Add README.md"))
+
+			commits, err = provider.GetCommitsForPath(ctx, repoUrl, "trunk", ".weave-gitops/apps/podinfo/overlays", 10, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commits).To(HaveLen(1))
+		})
+
+		It("returns the signatures of the commits", func() {
+			commits, err := provider.GetCommits(ctx, repoUrl, "trunk", 1, 0)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, signature, ok := CommitSignature(commits[0])
+			Expect(ok).To(BeTrue())
+			Expect(signature).To(BeEmpty())
+
+			signKey, err = openpgp.NewEntity("Bot", "", "bot@example.com", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			seed("signed", map[string]string{"README.md": "# podinfo, signed"}, "README.md")
+
+			commits, err = provider.GetCommits(ctx, repoUrl, "signed", 1, 0)
+			Expect(err).ToNot(HaveOccurred())
+
+			payload, signature, ok := CommitSignature(commits[0])
+			Expect(ok).To(BeTrue())
+			Expect(signature).To(HavePrefix("-----BEGIN PGP SIGNATURE-----"))
+
+			_, err = openpgp.CheckArmoredDetachedSignature(openpgp.EntityList{signKey}, bytes.NewReader(payload), strings.NewReader(signature), nil)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("makes the commits of the pull requests with the author and the signer it is given", func() {
+			entity, err := openpgp.NewEntity("Bot", "", "bot@example.com", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			var private bytes.Buffer
+
+			w, err := armor.Encode(&private, openpgp.PrivateKeyType, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entity.SerializePrivateWithoutSigning(w, nil)).To(Succeed())
+			Expect(w.Close()).To(Succeed())
+
+			signer, err := gitopsgit.NewSigner(private.Bytes(), "")
+			Expect(err).ToNot(HaveOccurred())
+
+			committer := provider.(CommitterProvider).WithCommitter(gitopsgit.Author{Name: "Bot", Email: "bot@example.com"}, signer)
+
+			readmePath := "README.md"
+			readme := "# podinfo, signed"
+
+			_, err = committer.CreatePullRequest(ctx, repoUrl, PullRequestInfo{
+				CommitMessage: "Sign podinfo",
+				NewBranch:     "wego-sign-podinfo",
+				Files:         []gitprovider.CommitFile{{Path: &readmePath, Content: &readme}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			commits, err := provider.GetCommits(ctx, repoUrl, "wego-sign-podinfo", 1, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commits[0].Get().Author).To(Equal("Bot"))
+			Expect(read("wego-sign-podinfo", readmePath)).To(Equal(readme))
+
+			payload, signature, ok := CommitSignature(commits[0])
+			Expect(ok).To(BeTrue())
+
+			_, err = openpgp.CheckArmoredDetachedSignature(openpgp.EntityList{entity}, bytes.NewReader(payload), strings.NewReader(signature), nil)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("gets the files of a directory without its subdirectories", func() {
+			files, err := provider.GetRepoDirFiles(ctx, repoUrl, ".weave-gitops/apps/podinfo", "trunk")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(2))
+			Expect(*files[0].Path).To(Equal(".weave-gitops/apps/podinfo/app.yaml"))
+			Expect(*files[0].Content).To(Equal("kind: Application"))
+			Expect(*files[1].Path).To(Equal(".weave-gitops/apps/podinfo/kustomization.yaml"))
+		})
+
+		It("pushes the files to a new branch in a single commit and merges it", func() {
+			readmePath := "README.md"
+			readme := "# podinfo, deployed"
+			appPath := ".weave-gitops/apps/podinfo/app.yaml"
+			newPath := ".weave-gitops/clusters/kind/user/podinfo.yaml"
+			kustomization := "kind: Kustomization"
+
+			pr, err := provider.CreatePullRequest(ctx, repoUrl, PullRequestInfo{
+				CommitMessage: "Update podinfo",
+				NewBranch:     "wego-update-podinfo",
+				Files: []gitprovider.CommitFile{
+					{Path: &readmePath, Content: &readme},
+					{Path: &appPath},
+					{Path: &newPath, Content: &kustomization},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pr.Get().Number).To(Equal(1))
+			Expect(pr.Get().WebURL).To(Equal("branch wego-update-podinfo pushed to " + bare + ", merge it to trunk"))
+
+			commits, err := provider.GetCommits(ctx, repoUrl, "wego-update-podinfo", 10, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commits).To(HaveLen(5))
+			Expect(commits[0].Get().Message).To(Equal("Update podinfo"))
+			Expect(commits[0].Get().Author).To(Equal("Weave Gitops"))
+
+			Expect(read("wego-update-podinfo", readmePath)).To(Equal(readme))
+			Expect(read("wego-update-podinfo", appPath)).To(BeEmpty())
+			Expect(read("wego-update-podinfo", newPath)).To(Equal(kustomization))
+			Expect(read("trunk", readmePath)).To(Equal("# podinfo"))
+
+			// the pull request is read from the remote, by another provider than the one which pushed it
+			other, err := New(Config{Provider: GitProviderGit, Hostname: "git.example.com"}, repoUrl.Owner(), GetAccountType)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(other.MergePullRequest(ctx, repoUrl, pr.Get().Number, "Merge podinfo")).To(Succeed())
+			Expect(read("trunk", readmePath)).To(Equal(readme))
+
+			Expect(other.MergePullRequest(ctx, repoUrl, 2, "Merge podinfo")).To(MatchError(ContainSubstring("no pull request 2 was pushed")))
+
+			next, err := provider.CreatePullRequest(ctx, repoUrl, PullRequestInfo{
+				CommitMessage: "Update podinfo again",
+				NewBranch:     "wego-update-podinfo-again",
+				Files:         []gitprovider.CommitFile{{Path: &readmePath, Content: &kustomization}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next.Get().Number).To(Equal(2))
+		})
+	})
+})
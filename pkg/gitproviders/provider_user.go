@@ -99,7 +99,7 @@ func (p userGitProvider) CreatePullRequest(ctx context.Context, repoUrl RepoURL,
 		return nil, fmt.Errorf("error getting user repo for owner %s, repo %s, %w", repoUrl.Owner(), repoUrl.RepositoryName(), err)
 	}
 
-	return createPullRequest(ctx, userRepo, prInfo)
+	return createPullRequest(ctx, p.provider, userRepo, repoUrl, prInfo)
 }
 
 func (p userGitProvider) GetCommits(ctx context.Context, repoUrl RepoURL, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
@@ -111,6 +111,11 @@ func (p userGitProvider) GetCommits(ctx context.Context, repoUrl RepoURL, target
 	return getCommits(ctx, userRepo, targetBranch, pageSize, pageToken)
 }
 
+// GetCommitsForPath returns the commits of a branch that touched a file or a directory
+func (p userGitProvider) GetCommitsForPath(ctx context.Context, repoUrl RepoURL, targetBranch, path string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	return getCommitsForPath(ctx, p.provider, repoUrl, targetBranch, path, pageSize, pageToken)
+}
+
 func (p userGitProvider) GetProviderDomain() string {
 	return getProviderDomain(p.provider.ProviderID())
 }
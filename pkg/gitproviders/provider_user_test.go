@@ -4,8 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/google/go-github/v41/github"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/weaveworks/weave-gitops/pkg/vendorfakes/fakegitprovider"
@@ -305,6 +310,42 @@ var _ = Describe("User Provider", func() {
 		})
 	})
 
+	Describe("GetCommitsForPath", func() {
+		It("lists the commits of a path with the github client", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/repos/owner/repo-name/commits"))
+				Expect(r.URL.Query().Get("sha")).To(Equal("main"))
+				Expect(r.URL.Query().Get("path")).To(Equal(".weave-gitops/apps/my-app"))
+				Expect(r.URL.Query().Get("per_page")).To(Equal("10"))
+				Expect(r.URL.Query().Get("page")).To(Equal("2"))
+
+				fmt.Fprint(w, `[{"sha": "commit-sha", "html_url": "https://github.com/owner/repo-name/commit/commit-sha",
+					"commit": {"message": "Add my-app", "author": {"name": "someone", "date": "2021-11-30T10:00:00Z"}}}]`)
+			}))
+			defer server.Close()
+
+			client := github.NewClient(nil)
+			client.BaseURL, _ = url.Parse(server.URL + "/")
+			gitProviderClient.RawReturns(client)
+
+			commits, err := userProvider.GetCommitsForPath(context.Background(), repoUrl, "main", ".weave-gitops/apps/my-app", 10, 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commits).To(HaveLen(1))
+			Expect(commits[0].Get()).To(Equal(gitprovider.CommitInfo{
+				Sha:       "commit-sha",
+				Author:    "someone",
+				Message:   "Add my-app",
+				CreatedAt: time.Date(2021, 11, 30, 10, 0, 0, 0, time.UTC),
+				URL:       "https://github.com/owner/repo-name/commit/commit-sha",
+			}))
+		})
+
+		It("returns an error for providers that can't filter the commits by path", func() {
+			_, err := userProvider.GetCommitsForPath(context.Background(), repoUrl, "main", ".weave-gitops/apps/my-app", 10, 1)
+			Expect(err).To(MatchError(ErrPathFilterNotSupported))
+		})
+	})
+
 	Describe("GetProviderDomain", func() {
 		It("returns provider domain", func() {
 			gitProviderClient.ProviderIDReturns("github")
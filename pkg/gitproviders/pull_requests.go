@@ -0,0 +1,216 @@
+package gitproviders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/google/go-github/v41/github"
+	"github.com/xanzy/go-gitlab"
+)
+
+// ErrPullRequestOptionsNotSupported is returned when creating a pull request with reviewers, labels, assignees
+// or as a draft with a provider that can't set them
+var ErrPullRequestOptionsNotSupported = errors.New("reviewers, labels, assignees and draft pull requests are not supported by this git provider")
+
+// ErrPullRequestOptionsNotSet is returned along with a pull request which was created,
+// but whose labels, assignees or reviewers couldn't all be set
+var ErrPullRequestOptionsNotSet = errors.New("the pull request was created without some of its options")
+
+// ErrFileDeletionNotSupported is returned when creating a pull request deleting files with a provider whose API can
+// only create and update them. The files can still be deleted by committing to the target branch with a git client.
+var ErrFileDeletionNotSupported = errors.New("deleting files in a pull request is not supported by this git provider")
+
+// hasOptions returns whether the pull request has reviewers, labels, assignees or is a draft
+func (p PullRequestOptions) hasOptions() bool {
+	return len(p.Reviewers) > 0 || len(p.Labels) > 0 || len(p.Assignees) > 0 || p.Draft
+}
+
+func supportsPullRequestOptions(provider gitprovider.Client) bool {
+	switch provider.Raw().(type) {
+	case *github.Client, *gitlab.Client:
+		return true
+	default:
+		return false
+	}
+}
+
+// openPullRequest opens the pull request of a branch which is already pushed.
+// go-git-providers can't set the reviewers, labels, assignees and draft status of a pull request,
+// so the pull requests which have them are created with the API client of the provider.
+func openPullRequest(ctx context.Context, provider gitprovider.Client, repo gitprovider.UserRepository, repoUrl RepoURL, prInfo PullRequestInfo) (gitprovider.PullRequest, error) {
+	if !prInfo.hasOptions() {
+		return repo.PullRequests().Create(ctx, prInfo.Title, prInfo.NewBranch, prInfo.TargetBranch, prInfo.Description)
+	}
+
+	switch raw := provider.Raw().(type) {
+	case *github.Client:
+		return createGitHubPullRequest(ctx, raw, repoUrl, prInfo)
+	case *gitlab.Client:
+		return createGitLabMergeRequest(ctx, raw, repoUrl, prInfo)
+	default:
+		return nil, ErrPullRequestOptionsNotSupported
+	}
+}
+
+// createGitHubPullRequest creates a pull request of GitHub and sets its labels, assignees and reviewers.
+// When some of them can't be set, the pull request is returned with an ErrPullRequestOptionsNotSet error.
+func createGitHubPullRequest(ctx context.Context, client *github.Client, repoUrl RepoURL, prInfo PullRequestInfo) (gitprovider.PullRequest, error) {
+	owner, repoName := repoUrl.Owner(), repoUrl.RepositoryName()
+
+	reviewers := github.ReviewersRequest{}
+
+	for _, reviewer := range prInfo.Reviewers {
+		i := strings.Index(reviewer, "/")
+		if i < 0 {
+			reviewers.Reviewers = append(reviewers.Reviewers, reviewer)
+			continue
+		}
+
+		// the teams are requested by their slug, only the teams of the owner of the repository can review it
+		if org := reviewer[:i]; !strings.EqualFold(org, owner) {
+			return nil, fmt.Errorf("team %s can't review a pull request of %s, it isn't a team of %s", reviewer, repoUrl.String(), owner)
+		}
+
+		reviewers.TeamReviewers = append(reviewers.TeamReviewers, reviewer[i+1:])
+	}
+
+	apiObj, _, err := client.PullRequests.Create(ctx, owner, repoName, &github.NewPullRequest{
+		Title: &prInfo.Title,
+		Head:  &prInfo.NewBranch,
+		Base:  &prInfo.TargetBranch,
+		Body:  &prInfo.Description,
+		Draft: &prInfo.Draft,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	number := apiObj.GetNumber()
+	pr := pullRequest{
+		apiObj: apiObj,
+		info: gitprovider.PullRequestInfo{
+			Merged: apiObj.GetMerged(),
+			Number: number,
+			WebURL: apiObj.GetHTMLURL(),
+		},
+	}
+
+	// the pull request exists from now on, the options which fail to be set are reported along with it
+	var failures []string
+
+	if len(prInfo.Labels) > 0 {
+		if _, _, err := client.Issues.AddLabelsToIssue(ctx, owner, repoName, number, prInfo.Labels); err != nil {
+			failures = append(failures, fmt.Sprintf("error adding labels: %s", err))
+		}
+	}
+
+	if len(prInfo.Assignees) > 0 {
+		if _, _, err := client.Issues.AddAssignees(ctx, owner, repoName, number, prInfo.Assignees); err != nil {
+			failures = append(failures, fmt.Sprintf("error adding assignees: %s", err))
+		}
+	}
+
+	if len(prInfo.Reviewers) > 0 {
+		if _, _, err := client.PullRequests.RequestReviewers(ctx, owner, repoName, number, reviewers); err != nil {
+			failures = append(failures, fmt.Sprintf("error requesting reviewers: %s", err))
+		}
+	}
+
+	if len(failures) > 0 {
+		return pr, fmt.Errorf("%w: %s: %s", ErrPullRequestOptionsNotSet, pr.info.WebURL, strings.Join(failures, "; "))
+	}
+
+	return pr, nil
+}
+
+func createGitLabMergeRequest(ctx context.Context, client *gitlab.Client, repoUrl RepoURL, prInfo PullRequestInfo) (gitprovider.PullRequest, error) {
+	project := fmt.Sprintf("%s/%s", repoUrl.Owner(), repoUrl.RepositoryName())
+
+	title := prInfo.Title
+	if prInfo.Draft {
+		title = "Draft: " + title
+	}
+
+	opts := &gitlab.CreateMergeRequestOptions{
+		Title:        &title,
+		Description:  &prInfo.Description,
+		SourceBranch: &prInfo.NewBranch,
+		TargetBranch: &prInfo.TargetBranch,
+	}
+
+	if len(prInfo.Labels) > 0 {
+		labels := gitlab.Labels(prInfo.Labels)
+		opts.Labels = &labels
+	}
+
+	if len(prInfo.Assignees) > 0 {
+		ids, err := getGitLabUserIDs(ctx, client, prInfo.Assignees)
+		if err != nil {
+			return nil, fmt.Errorf("error getting assignees: %w", err)
+		}
+
+		opts.AssigneeIDs = &ids
+	}
+
+	if len(prInfo.Reviewers) > 0 {
+		ids, err := getGitLabUserIDs(ctx, client, prInfo.Reviewers)
+		if err != nil {
+			return nil, fmt.Errorf("error getting reviewers: %w", err)
+		}
+
+		opts.ReviewerIDs = &ids
+	}
+
+	apiObj, _, err := client.MergeRequests.CreateMergeRequest(project, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return pullRequest{
+		apiObj: apiObj,
+		info: gitprovider.PullRequestInfo{
+			Merged: apiObj.State == "merged",
+			Number: apiObj.IID,
+			WebURL: apiObj.WebURL,
+		},
+	}, nil
+}
+
+// getGitLabUserIDs returns the IDs of users of GitLab, which sets the assignees and reviewers by ID
+func getGitLabUserIDs(ctx context.Context, client *gitlab.Client, usernames []string) ([]int, error) {
+	ids := make([]int, 0, len(usernames))
+
+	for _, username := range usernames {
+		username := username
+
+		users, _, err := client.Users.ListUsers(&gitlab.ListUsersOptions{Username: &username}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("error getting user %s: %w", username, err)
+		}
+
+		if len(users) == 0 {
+			return nil, fmt.Errorf("user %s not found", username)
+		}
+
+		ids = append(ids, users[0].ID)
+	}
+
+	return ids, nil
+}
+
+// pullRequest is a gitprovider.PullRequest created with the API client of a provider
+type pullRequest struct {
+	apiObj interface{}
+	info   gitprovider.PullRequestInfo
+}
+
+func (p pullRequest) APIObject() interface{} {
+	return p.apiObj
+}
+
+func (p pullRequest) Get() gitprovider.PullRequestInfo {
+	return p.info
+}
@@ -0,0 +1,144 @@
+package gitproviders
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/go-github/v41/github"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = Describe("pull requests with options", func() {
+	var (
+		ctx     context.Context
+		repoUrl RepoURL
+		prInfo  PullRequestInfo
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		prInfo = PullRequestInfo{
+			Title:        "Add podinfo",
+			Description:  "Adds the automation of podinfo",
+			NewBranch:    "wego-add-podinfo",
+			TargetBranch: "main",
+			PullRequestOptions: PullRequestOptions{
+				Labels:    []string{"gitops", "podinfo"},
+				Assignees: []string{"bot"},
+				Draft:     true,
+			},
+		}
+	})
+
+	It("creates a draft pull request of GitHub and sets its labels, assignees and reviewers", func() {
+		var err error
+		repoUrl, err = NewRepoURL("https://github.com/bot/podinfo")
+		Expect(err).ToNot(HaveOccurred())
+
+		transport := replayCassette("github_pull_request_options")
+		client := github.NewClient(&http.Client{Transport: transport})
+
+		prInfo.Reviewers = []string{"alice", "bot/platform"}
+
+		pr, err := createGitHubPullRequest(ctx, client, repoUrl, prInfo)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pr.Get().Number).To(Equal(4))
+		Expect(pr.Get().WebURL).To(Equal("https://github.com/bot/podinfo/pull/4"))
+
+		const api = "https://api.github.com/repos/bot/podinfo"
+
+		Expect(transport.request(http.MethodPost, api+"/pulls").Body).To(MatchJSON(`{
+			"title": "Add podinfo",
+			"head": "wego-add-podinfo",
+			"base": "main",
+			"body": "Adds the automation of podinfo",
+			"draft": true
+		}`))
+		Expect(transport.request(http.MethodPost, api+"/issues/4/labels").Body).To(MatchJSON(`["gitops", "podinfo"]`))
+		Expect(transport.request(http.MethodPost, api+"/issues/4/assignees").Body).To(MatchJSON(`{"assignees": ["bot"]}`))
+		Expect(transport.request(http.MethodPost, api+"/pulls/4/requested_reviewers").Body).To(MatchJSON(`{
+			"reviewers": ["alice"],
+			"team_reviewers": ["platform"]
+		}`))
+	})
+
+	It("returns the pull request of GitHub with the options it couldn't set", func() {
+		var err error
+		repoUrl, err = NewRepoURL("https://github.com/bot/podinfo")
+		Expect(err).ToNot(HaveOccurred())
+
+		transport := replayCassette("github_pull_request_options_not_set")
+		client := github.NewClient(&http.Client{Transport: transport})
+
+		pr, err := createGitHubPullRequest(ctx, client, repoUrl, prInfo)
+		Expect(err).To(MatchError(ErrPullRequestOptionsNotSet))
+		Expect(err.Error()).To(ContainSubstring("https://github.com/bot/podinfo/pull/4: error adding labels"))
+		Expect(pr.Get().Number).To(Equal(4))
+
+		const api = "https://api.github.com/repos/bot/podinfo"
+
+		Expect(transport.request(http.MethodPost, api+"/issues/4/assignees").Body).To(MatchJSON(`{"assignees": ["bot"]}`))
+	})
+
+	It("doesn't create a pull request of GitHub reviewed by a team of another organization", func() {
+		var err error
+		repoUrl, err = NewRepoURL("https://github.com/bot/podinfo")
+		Expect(err).ToNot(HaveOccurred())
+
+		transport := replayCassette("github_pull_request_options")
+		client := github.NewClient(&http.Client{Transport: transport})
+
+		prInfo.Reviewers = []string{"other-org/platform"}
+
+		_, err = createGitHubPullRequest(ctx, client, repoUrl, prInfo)
+		Expect(err).To(MatchError("team other-org/platform can't review a pull request of " + repoUrl.String() + ", it isn't a team of bot"))
+		Expect(transport.requests).To(BeEmpty())
+	})
+
+	Describe("GitLab", func() {
+		var (
+			transport *replayTransport
+			client    *gitlab.Client
+		)
+
+		BeforeEach(func() {
+			var err error
+			repoUrl, err = NewRepoURL("https://gitlab.com/bot/podinfo")
+			Expect(err).ToNot(HaveOccurred())
+
+			transport = replayCassette("gitlab_merge_request_options")
+
+			client, err = gitlab.NewClient("token", gitlab.WithHTTPClient(&http.Client{Transport: transport}))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates a draft merge request with the IDs of its assignees and reviewers", func() {
+			prInfo.Reviewers = []string{"alice"}
+
+			pr, err := createGitLabMergeRequest(ctx, client, repoUrl, prInfo)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pr.Get().Number).To(Equal(5))
+			Expect(pr.Get().WebURL).To(Equal("https://gitlab.com/bot/podinfo/-/merge_requests/5"))
+
+			Expect(transport.request(http.MethodPost, "https://gitlab.com/api/v4/projects/bot%2Fpodinfo/merge_requests").Body).To(MatchJSON(`{
+				"title": "Draft: Add podinfo",
+				"description": "Adds the automation of podinfo",
+				"source_branch": "wego-add-podinfo",
+				"target_branch": "main",
+				"labels": "gitops,podinfo",
+				"assignee_ids": [12],
+				"reviewer_ids": [11]
+			}`))
+		})
+
+		It("fails when a reviewer isn't a user", func() {
+			prInfo.Reviewers = []string{"nobody"}
+
+			_, err := createGitLabMergeRequest(ctx, client, repoUrl, prInfo)
+			Expect(err).To(MatchError("error getting reviewers: user nobody not found"))
+		})
+	})
+})
@@ -21,16 +21,23 @@ type RepoURL struct {
 	owner      string
 	url        *url.URL
 	normalized string
+	cloneURL   string
 	provider   GitProviderName
 	protocol   RepositoryURLProtocol
 }
 
 func NewRepoURL(uri string) (RepoURL, error) {
+	cloneURL := strings.TrimSuffix(uri, "/")
+
 	providerName, err := detectGitProviderFromUrl(uri, ViperGetStringMapString("git-host-types"))
 	if err != nil {
 		return RepoURL{}, fmt.Errorf("could not get provider name from URL %s: %w", uri, err)
 	}
 
+	if providerName == GitProviderBitbucketServer {
+		uri = trimBitbucketServerScmPath(uri)
+	}
+
 	normalized, err := normalizeRepoURLString(uri)
 	if err != nil {
 		return RepoURL{}, fmt.Errorf("could not normalize repo URL %s: %w", uri, err)
@@ -56,6 +63,7 @@ func NewRepoURL(uri string) (RepoURL, error) {
 		owner:      owner,
 		url:        u,
 		normalized: normalized,
+		cloneURL:   cloneURL,
 		provider:   providerName,
 		protocol:   protocol,
 	}, nil
@@ -65,6 +73,12 @@ func (n RepoURL) String() string {
 	return n.normalized
 }
 
+// CloneURL returns the URL the repository was given with. The repositories of plain git servers are cloned
+// from it, as they may be served over HTTPS or git://, or to another SSH user than git.
+func (n RepoURL) CloneURL() string {
+	return n.cloneURL
+}
+
 func (n RepoURL) URL() *url.URL {
 	return n.url
 }
@@ -89,6 +103,12 @@ func getOwnerFromUrl(url url.URL, providerName GitProviderName) (string, error)
 	url.Path = strings.TrimPrefix(url.Path, "/")
 
 	parts := strings.Split(url.Path, "/")
+
+	// The repositories of a plain git server can be anywhere, their owner is the directory they are found in
+	if providerName == GitProviderGit {
+		return strings.Join(parts[:len(parts)-1], "/"), nil
+	}
+
 	if len(parts) < 2 {
 		return "", fmt.Errorf("could not get owner from url %v", url.String())
 	}
@@ -119,6 +139,11 @@ func detectGitProviderFromUrl(raw string, gitHostTypes map[string]string) (GitPr
 	gitHostTypes[gitlab.DefaultDomain] = string(GitProviderGitLab)
 
 	provider := gitHostTypes[u.Host]
+	if provider == "" {
+		// Self-hosted providers are often cloned with SSH on a port of their own, e.g. 7999 for Bitbucket Server
+		provider = gitHostTypes[u.Hostname()]
+	}
+
 	if provider == "" {
 		return "", fmt.Errorf("no git providers found for %q", raw)
 	}
@@ -126,6 +151,19 @@ func detectGitProviderFromUrl(raw string, gitHostTypes map[string]string) (GitPr
 	return GitProviderName(provider), nil
 }
 
+// trimBitbucketServerScmPath removes the /scm prefix from the path of the HTTPS clone URLs of Bitbucket Server,
+// https://<host>/scm/<project>/<repo>.git, as it isn't part of their SSH clone URLs
+func trimBitbucketServerScmPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || !strings.HasPrefix(u.Scheme, "http") || !strings.HasPrefix(u.Path, "/scm/") {
+		return uri
+	}
+
+	u.Path = strings.TrimPrefix(u.Path, "/scm")
+
+	return u.String()
+}
+
 // Hacks around "scp" formatted urls ($user@$host:$path)
 // the `:` delimiter between host and path throws off the std. url parser
 func parseGitURL(raw string) (*url.URL, error) {
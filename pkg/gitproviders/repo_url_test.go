@@ -135,4 +135,72 @@ var _ = DescribeTable("NewRepoURL", func(input, gitProviderEnv string, expected
 			provider: "gitlab",
 			protocol: RepositoryURLProtocolSSH,
 		}),
+	Entry(
+		"bitbucket server https",
+		"https://bitbucket.acme.org/scm/proj/podinfo-deploy.git",
+		"bitbucket.acme.org=bitbucket-server",
+		expectedRepoURL{
+			s:        "ssh://git@bitbucket.acme.org/proj/podinfo-deploy.git",
+			owner:    "proj",
+			name:     "podinfo-deploy",
+			provider: GitProviderBitbucketServer,
+			protocol: RepositoryURLProtocolSSH,
+		}),
+	Entry(
+		"bitbucket server ssh port",
+		"ssh://git@bitbucket.acme.org:7999/proj/podinfo-deploy.git",
+		"bitbucket.acme.org=bitbucket-server",
+		expectedRepoURL{
+			s:        "ssh://git@bitbucket.acme.org:7999/proj/podinfo-deploy.git",
+			owner:    "proj",
+			name:     "podinfo-deploy",
+			provider: GitProviderBitbucketServer,
+			protocol: RepositoryURLProtocolSSH,
+		}),
+	Entry(
+		"gitea",
+		"git@gitea.acme.org:sympatheticmoose/podinfo-deploy.git",
+		"gitea.acme.org=gitea",
+		expectedRepoURL{
+			s:        "ssh://git@gitea.acme.org/sympatheticmoose/podinfo-deploy.git",
+			owner:    "sympatheticmoose",
+			name:     "podinfo-deploy",
+			provider: GitProviderGitea,
+			protocol: RepositoryURLProtocolSSH,
+		}),
+	Entry(
+		"plain git",
+		"ssh://git@git.acme.org/srv/git/podinfo-deploy.git",
+		"git.acme.org=git",
+		expectedRepoURL{
+			s:        "ssh://git@git.acme.org/srv/git/podinfo-deploy.git",
+			owner:    "srv/git",
+			name:     "podinfo-deploy",
+			provider: GitProviderGit,
+			protocol: RepositoryURLProtocolSSH,
+		}),
+	Entry(
+		"plain git at the root",
+		"git@git.acme.org:podinfo-deploy.git",
+		"git.acme.org=git",
+		expectedRepoURL{
+			s:        "ssh://git@git.acme.org/podinfo-deploy.git",
+			owner:    "",
+			name:     "podinfo-deploy",
+			provider: GitProviderGit,
+			protocol: RepositoryURLProtocolSSH,
+		}),
+)
+
+var _ = DescribeTable("CloneURL", func(input, expected string) {
+	viper.Set("git-host-types", "git.acme.org=git")
+
+	result, err := NewRepoURL(input)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(result.CloneURL()).To(Equal(expected))
+},
+	Entry("https", "https://git.acme.org/repos/podinfo-deploy", "https://git.acme.org/repos/podinfo-deploy"),
+	Entry("git daemon", "git://git.acme.org/podinfo-deploy.git/", "git://git.acme.org/podinfo-deploy.git"),
+	Entry("ssh user", "ssh://alice@git.acme.org:2222/srv/git/podinfo-deploy.git", "ssh://alice@git.acme.org:2222/srv/git/podinfo-deploy.git"),
+	Entry("git clone style", "git@git.acme.org:podinfo-deploy", "git@git.acme.org:podinfo-deploy"),
 )
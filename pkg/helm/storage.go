@@ -0,0 +1,81 @@
+package helm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	helmv2beta1 "github.com/fluxcd/helm-controller/api/v2beta1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// gzipMagic starts the releases helm compresses before storing them
+var gzipMagic = []byte{0x1f, 0x8b, 0x08}
+
+// GetLastRelease reads the last release of a HelmRelease from the secret the secret storage driver of helm
+// stores it in. It returns nil when the HelmRelease wasn't released yet.
+func GetLastRelease(ctx context.Context, kubeClient kube.Kube, hr *helmv2beta1.HelmRelease) (*release.Release, error) {
+	if hr.Status.LastReleaseRevision < 1 {
+		return nil, nil
+	}
+
+	name := types.NamespacedName{
+		Namespace: hr.GetStorageNamespace(),
+		Name:      fmt.Sprintf("sh.helm.release.v1.%s.v%d", hr.GetReleaseName(), hr.Status.LastReleaseRevision),
+	}
+
+	secret, err := kubeClient.GetSecret(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == nil {
+		return nil, fmt.Errorf("release secret %s not found", name)
+	}
+
+	rls, err := DecodeRelease(secret.Data["release"])
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding release secret %s: %w", name, err)
+	}
+
+	return rls, nil
+}
+
+// DecodeRelease decodes a release the way the secret storage driver of helm encodes it,
+// as base64 of the gzipped json of the release
+func DecodeRelease(data []byte) (*release.Release, error) {
+	if len(data) == 0 {
+		return nil, errors.New("no release data")
+	}
+
+	b, err := base64.StdEncoding.DecodeString(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(b, gzipMagic) {
+		r, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+
+		if b, err = ioutil.ReadAll(r); err != nil {
+			return nil, err
+		}
+	}
+
+	var rls release.Release
+	if err := json.Unmarshal(b, &rls); err != nil {
+		return nil, err
+	}
+
+	return &rls, nil
+}
@@ -0,0 +1,87 @@
+package helm_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	helmv2beta1 "github.com/fluxcd/helm-controller/api/v2beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/helm"
+	"github.com/weaveworks/weave-gitops/pkg/kube/kubefakes"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("GetLastRelease", func() {
+	var (
+		kubeClient  *kubefakes.FakeKube
+		helmRelease *helmv2beta1.HelmRelease
+	)
+
+	BeforeEach(func() {
+		kubeClient = &kubefakes.FakeKube{}
+
+		helmRelease = &helmv2beta1.HelmRelease{}
+		helmRelease.Name = "podinfo"
+		helmRelease.Namespace = "wego-system"
+		helmRelease.Spec.TargetNamespace = "apps"
+		helmRelease.Status.LastReleaseRevision = 2
+	})
+
+	It("decodes the release stored in the secret of the last revision", func() {
+		kubeClient.GetSecretReturns(releaseSecret(&release.Release{Name: "apps-podinfo", Namespace: "apps", Manifest: "kind: Deployment"}), nil)
+
+		rls, err := helm.GetLastRelease(context.Background(), kubeClient, helmRelease)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rls.Name).To(Equal("apps-podinfo"))
+		Expect(rls.Manifest).To(Equal("kind: Deployment"))
+
+		_, name := kubeClient.GetSecretArgsForCall(0)
+		Expect(name).To(Equal(types.NamespacedName{Name: "sh.helm.release.v1.apps-podinfo.v2", Namespace: "wego-system"}))
+	})
+
+	It("returns no release when the helm release wasn't released yet", func() {
+		helmRelease.Status.LastReleaseRevision = 0
+
+		rls, err := helm.GetLastRelease(context.Background(), kubeClient, helmRelease)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rls).To(BeNil())
+		Expect(kubeClient.GetSecretCallCount()).To(Equal(0))
+	})
+
+	It("fails when the secret of the release doesn't exist", func() {
+		kubeClient.GetSecretReturns(nil, nil)
+
+		_, err := helm.GetLastRelease(context.Background(), kubeClient, helmRelease)
+		Expect(err).To(MatchError("release secret wego-system/sh.helm.release.v1.apps-podinfo.v2 not found"))
+	})
+
+	It("fails when the secret has no release", func() {
+		kubeClient.GetSecretReturns(&corev1.Secret{}, nil)
+
+		_, err := helm.GetLastRelease(context.Background(), kubeClient, helmRelease)
+		Expect(err).To(MatchError("failed decoding release secret wego-system/sh.helm.release.v1.apps-podinfo.v2: no release data"))
+	})
+})
+
+// releaseSecret stores a release in a secret the way the secret storage driver of helm does
+func releaseSecret(rls *release.Release) *corev1.Secret {
+	data, err := json.Marshal(rls)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	var compressed bytes.Buffer
+
+	w := gzip.NewWriter(&compressed)
+	_, err = w.Write(data)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(w.Close()).To(Succeed())
+
+	return &corev1.Secret{
+		Data: map[string][]byte{"release": []byte(base64.StdEncoding.EncodeToString(compressed.Bytes()))},
+	}
+}
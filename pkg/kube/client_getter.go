@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/weaveworks/weave-gitops/pkg/tracing"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -35,9 +36,13 @@ func NewDefaultClientGetter(configGetter ConfigGetter, clusterName string, schem
 // Client creates a new Kubernetes client using the *rest.Config returned from its
 // ConfigGetter.
 func (g *DefaultClientGetter) Client(ctx context.Context) (client.Client, error) {
-	config := g.configGetter.Config(ctx)
+	_, span := tracing.Start(ctx, "kube.Client")
+
+	config := tracedConfig(g.configGetter.Config(ctx))
 
 	_, rawClient, err := NewKubeHTTPClientWithConfig(config, g.clusterName, g.schemeBuilder...)
+	tracing.End(span, err)
+
 	if err != nil {
 		return nil, fmt.Errorf("could not create kube http client: %w", err)
 	}
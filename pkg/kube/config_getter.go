@@ -3,6 +3,7 @@ package kube
 import (
 	"context"
 
+	"github.com/weaveworks/weave-gitops/pkg/tracing"
 	"k8s.io/client-go/rest"
 )
 
@@ -11,3 +12,11 @@ import (
 type ConfigGetter interface {
 	Config(ctx context.Context) *rest.Config
 }
+
+// tracedConfig returns a copy of a *rest.Config whose requests are traced, when they are sent as part of a trace
+func tracedConfig(config *rest.Config) *rest.Config {
+	traced := rest.CopyConfig(config)
+	traced.Wrap(tracing.Transport)
+
+	return traced
+}
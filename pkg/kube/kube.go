@@ -5,9 +5,13 @@ import (
 	"fmt"
 
 	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/tracing"
 
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	extensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
@@ -45,6 +49,17 @@ type WegoConfig struct {
 	FluxNamespace string
 	WegoNamespace string
 	ConfigRepo    string
+	// SigningKeySecret is the name of the secret, in the wego namespace, holding the key
+	// the commits of gitops are signed with
+	SigningKeySecret string `json:",omitempty"`
+	// CommitAuthorName and CommitAuthorEmail are the author of the commits of gitops
+	CommitAuthorName  string `json:",omitempty"`
+	CommitAuthorEmail string `json:",omitempty"`
+	// CommitMessageTemplate, PullRequestTitleTemplate and PullRequestBodyTemplate are the Go templates
+	// of the commit messages and pull requests of gitops
+	CommitMessageTemplate    string `json:",omitempty"`
+	PullRequestTitleTemplate string `json:",omitempty"`
+	PullRequestBodyTemplate  string `json:",omitempty"`
 }
 
 //counterfeiter:generate . Kube
@@ -65,6 +80,9 @@ type Kube interface {
 	FetchNamespaceWithLabel(ctx context.Context, key string, value string) (*corev1.Namespace, error)
 	SetWegoConfig(ctx context.Context, config WegoConfig, namespace string) (*corev1.ConfigMap, error)
 	GetWegoConfig(ctx context.Context, namespace string) (*WegoConfig, error)
+	GetDeployments(ctx context.Context, namespace string) ([]appsv1.Deployment, error)
+	GetPods(ctx context.Context, namespace string, selector *metav1.LabelSelector) ([]corev1.Pod, error)
+	GetCRDs(ctx context.Context) ([]extensionsv1.CustomResourceDefinition, error)
 	Raw() client.Client
 }
 
@@ -110,9 +128,13 @@ func NewDefaultKubeGetter(configGetter ConfigGetter, clusterName string) KubeGet
 // Kube creates a new Kube client using the *rest.Config returned from its
 // ConfigGetter.
 func (g *DefaultKubeGetter) Kube(ctx context.Context) (Kube, error) {
-	config := g.configGetter.Config(ctx)
+	_, span := tracing.Start(ctx, "kube.Kube")
+
+	config := tracedConfig(g.configGetter.Config(ctx))
 
 	kube, _, err := NewKubeHTTPClientWithConfig(config, g.clusterName)
+	tracing.End(span, err)
+
 	if err != nil {
 		return nil, fmt.Errorf("could not create kube http client: %w", err)
 	}
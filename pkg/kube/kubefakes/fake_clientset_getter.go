@@ -0,0 +1,24 @@
+package kubefakes
+
+import (
+	"context"
+
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"k8s.io/client-go/kubernetes"
+)
+
+var _ kube.ClientsetGetter = &FakeClientsetGetter{}
+
+type FakeClientsetGetter struct {
+	clientset kubernetes.Interface
+}
+
+func NewFakeClientsetGetter(clientset kubernetes.Interface) kube.ClientsetGetter {
+	return &FakeClientsetGetter{
+		clientset: clientset,
+	}
+}
+
+func (g *FakeClientsetGetter) Clientset(ctx context.Context) (kubernetes.Interface, error) {
+	return g.clientset, nil
+}
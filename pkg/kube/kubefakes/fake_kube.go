@@ -7,7 +7,10 @@ import (
 
 	"github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	extensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -109,6 +112,19 @@ type FakeKube struct {
 		result1 []v1alpha1.Application
 		result2 error
 	}
+	GetCRDsStub        func(context.Context) ([]extensionsv1.CustomResourceDefinition, error)
+	getCRDsMutex       sync.RWMutex
+	getCRDsArgsForCall []struct {
+		arg1 context.Context
+	}
+	getCRDsReturns struct {
+		result1 []extensionsv1.CustomResourceDefinition
+		result2 error
+	}
+	getCRDsReturnsOnCall map[int]struct {
+		result1 []extensionsv1.CustomResourceDefinition
+		result2 error
+	}
 	GetClusterNameStub        func(context.Context) (string, error)
 	getClusterNameMutex       sync.RWMutex
 	getClusterNameArgsForCall []struct {
@@ -133,6 +149,35 @@ type FakeKube struct {
 	getClusterStatusReturnsOnCall map[int]struct {
 		result1 kube.ClusterStatus
 	}
+	GetDeploymentsStub        func(context.Context, string) ([]appsv1.Deployment, error)
+	getDeploymentsMutex       sync.RWMutex
+	getDeploymentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getDeploymentsReturns struct {
+		result1 []appsv1.Deployment
+		result2 error
+	}
+	getDeploymentsReturnsOnCall map[int]struct {
+		result1 []appsv1.Deployment
+		result2 error
+	}
+	GetPodsStub        func(context.Context, string, *metav1.LabelSelector) ([]v1.Pod, error)
+	getPodsMutex       sync.RWMutex
+	getPodsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *metav1.LabelSelector
+	}
+	getPodsReturns struct {
+		result1 []v1.Pod
+		result2 error
+	}
+	getPodsReturnsOnCall map[int]struct {
+		result1 []v1.Pod
+		result2 error
+	}
 	GetResourceStub        func(context.Context, types.NamespacedName, kube.Resource) error
 	getResourceMutex       sync.RWMutex
 	getResourceArgsForCall []struct {
@@ -703,6 +748,70 @@ func (fake *FakeKube) GetApplicationsReturnsOnCall(i int, result1 []v1alpha1.App
 	}{result1, result2}
 }
 
+func (fake *FakeKube) GetCRDs(arg1 context.Context) ([]extensionsv1.CustomResourceDefinition, error) {
+	fake.getCRDsMutex.Lock()
+	ret, specificReturn := fake.getCRDsReturnsOnCall[len(fake.getCRDsArgsForCall)]
+	fake.getCRDsArgsForCall = append(fake.getCRDsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetCRDsStub
+	fakeReturns := fake.getCRDsReturns
+	fake.recordInvocation("GetCRDs", []interface{}{arg1})
+	fake.getCRDsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeKube) GetCRDsCallCount() int {
+	fake.getCRDsMutex.RLock()
+	defer fake.getCRDsMutex.RUnlock()
+	return len(fake.getCRDsArgsForCall)
+}
+
+func (fake *FakeKube) GetCRDsCalls(stub func(context.Context) ([]extensionsv1.CustomResourceDefinition, error)) {
+	fake.getCRDsMutex.Lock()
+	defer fake.getCRDsMutex.Unlock()
+	fake.GetCRDsStub = stub
+}
+
+func (fake *FakeKube) GetCRDsArgsForCall(i int) context.Context {
+	fake.getCRDsMutex.RLock()
+	defer fake.getCRDsMutex.RUnlock()
+	argsForCall := fake.getCRDsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeKube) GetCRDsReturns(result1 []extensionsv1.CustomResourceDefinition, result2 error) {
+	fake.getCRDsMutex.Lock()
+	defer fake.getCRDsMutex.Unlock()
+	fake.GetCRDsStub = nil
+	fake.getCRDsReturns = struct {
+		result1 []extensionsv1.CustomResourceDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKube) GetCRDsReturnsOnCall(i int, result1 []extensionsv1.CustomResourceDefinition, result2 error) {
+	fake.getCRDsMutex.Lock()
+	defer fake.getCRDsMutex.Unlock()
+	fake.GetCRDsStub = nil
+	if fake.getCRDsReturnsOnCall == nil {
+		fake.getCRDsReturnsOnCall = make(map[int]struct {
+			result1 []extensionsv1.CustomResourceDefinition
+			result2 error
+		})
+	}
+	fake.getCRDsReturnsOnCall[i] = struct {
+		result1 []extensionsv1.CustomResourceDefinition
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeKube) GetClusterName(arg1 context.Context) (string, error) {
 	fake.getClusterNameMutex.Lock()
 	ret, specificReturn := fake.getClusterNameReturnsOnCall[len(fake.getClusterNameArgsForCall)]
@@ -828,6 +937,137 @@ func (fake *FakeKube) GetClusterStatusReturnsOnCall(i int, result1 kube.ClusterS
 	}{result1}
 }
 
+func (fake *FakeKube) GetDeployments(arg1 context.Context, arg2 string) ([]appsv1.Deployment, error) {
+	fake.getDeploymentsMutex.Lock()
+	ret, specificReturn := fake.getDeploymentsReturnsOnCall[len(fake.getDeploymentsArgsForCall)]
+	fake.getDeploymentsArgsForCall = append(fake.getDeploymentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetDeploymentsStub
+	fakeReturns := fake.getDeploymentsReturns
+	fake.recordInvocation("GetDeployments", []interface{}{arg1, arg2})
+	fake.getDeploymentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeKube) GetDeploymentsCallCount() int {
+	fake.getDeploymentsMutex.RLock()
+	defer fake.getDeploymentsMutex.RUnlock()
+	return len(fake.getDeploymentsArgsForCall)
+}
+
+func (fake *FakeKube) GetDeploymentsCalls(stub func(context.Context, string) ([]appsv1.Deployment, error)) {
+	fake.getDeploymentsMutex.Lock()
+	defer fake.getDeploymentsMutex.Unlock()
+	fake.GetDeploymentsStub = stub
+}
+
+func (fake *FakeKube) GetDeploymentsArgsForCall(i int) (context.Context, string) {
+	fake.getDeploymentsMutex.RLock()
+	defer fake.getDeploymentsMutex.RUnlock()
+	argsForCall := fake.getDeploymentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeKube) GetDeploymentsReturns(result1 []appsv1.Deployment, result2 error) {
+	fake.getDeploymentsMutex.Lock()
+	defer fake.getDeploymentsMutex.Unlock()
+	fake.GetDeploymentsStub = nil
+	fake.getDeploymentsReturns = struct {
+		result1 []appsv1.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKube) GetDeploymentsReturnsOnCall(i int, result1 []appsv1.Deployment, result2 error) {
+	fake.getDeploymentsMutex.Lock()
+	defer fake.getDeploymentsMutex.Unlock()
+	fake.GetDeploymentsStub = nil
+	if fake.getDeploymentsReturnsOnCall == nil {
+		fake.getDeploymentsReturnsOnCall = make(map[int]struct {
+			result1 []appsv1.Deployment
+			result2 error
+		})
+	}
+	fake.getDeploymentsReturnsOnCall[i] = struct {
+		result1 []appsv1.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKube) GetPods(arg1 context.Context, arg2 string, arg3 *metav1.LabelSelector) ([]v1.Pod, error) {
+	fake.getPodsMutex.Lock()
+	ret, specificReturn := fake.getPodsReturnsOnCall[len(fake.getPodsArgsForCall)]
+	fake.getPodsArgsForCall = append(fake.getPodsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *metav1.LabelSelector
+	}{arg1, arg2, arg3})
+	stub := fake.GetPodsStub
+	fakeReturns := fake.getPodsReturns
+	fake.recordInvocation("GetPods", []interface{}{arg1, arg2, arg3})
+	fake.getPodsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeKube) GetPodsCallCount() int {
+	fake.getPodsMutex.RLock()
+	defer fake.getPodsMutex.RUnlock()
+	return len(fake.getPodsArgsForCall)
+}
+
+func (fake *FakeKube) GetPodsCalls(stub func(context.Context, string, *metav1.LabelSelector) ([]v1.Pod, error)) {
+	fake.getPodsMutex.Lock()
+	defer fake.getPodsMutex.Unlock()
+	fake.GetPodsStub = stub
+}
+
+func (fake *FakeKube) GetPodsArgsForCall(i int) (context.Context, string, *metav1.LabelSelector) {
+	fake.getPodsMutex.RLock()
+	defer fake.getPodsMutex.RUnlock()
+	argsForCall := fake.getPodsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeKube) GetPodsReturns(result1 []v1.Pod, result2 error) {
+	fake.getPodsMutex.Lock()
+	defer fake.getPodsMutex.Unlock()
+	fake.GetPodsStub = nil
+	fake.getPodsReturns = struct {
+		result1 []v1.Pod
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKube) GetPodsReturnsOnCall(i int, result1 []v1.Pod, result2 error) {
+	fake.getPodsMutex.Lock()
+	defer fake.getPodsMutex.Unlock()
+	fake.GetPodsStub = nil
+	if fake.getPodsReturnsOnCall == nil {
+		fake.getPodsReturnsOnCall = make(map[int]struct {
+			result1 []v1.Pod
+			result2 error
+		})
+	}
+	fake.getPodsReturnsOnCall[i] = struct {
+		result1 []v1.Pod
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeKube) GetResource(arg1 context.Context, arg2 types.NamespacedName, arg3 kube.Resource) error {
 	fake.getResourceMutex.Lock()
 	ret, specificReturn := fake.getResourceReturnsOnCall[len(fake.getResourceArgsForCall)]
@@ -1350,10 +1590,16 @@ func (fake *FakeKube) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
+	fake.getCRDsMutex.RLock()
+	defer fake.getCRDsMutex.RUnlock()
 	fake.getClusterNameMutex.RLock()
 	defer fake.getClusterNameMutex.RUnlock()
 	fake.getClusterStatusMutex.RLock()
 	defer fake.getClusterStatusMutex.RUnlock()
+	fake.getDeploymentsMutex.RLock()
+	defer fake.getDeploymentsMutex.RUnlock()
+	fake.getPodsMutex.RLock()
+	defer fake.getPodsMutex.RUnlock()
 	fake.getResourceMutex.RLock()
 	defer fake.getResourceMutex.RUnlock()
 	fake.getSecretMutex.RLock()
@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	extensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -47,6 +48,7 @@ func CreateScheme() *apiruntime.Scheme {
 	_ = corev1.AddToScheme(scheme)
 	_ = extensionsv1.AddToScheme(scheme)
 	_ = appsv1.AddToScheme(scheme)
+	_ = authorizationv1.AddToScheme(scheme)
 
 	return scheme
 }
@@ -60,6 +62,7 @@ var (
 	GVRKustomization  schema.GroupVersionResource = kustomizev2.GroupVersion.WithResource("kustomizations")
 	GVRGitRepository  schema.GroupVersionResource = sourcev1.GroupVersion.WithResource("gitrepositories")
 	GVRHelmRepository schema.GroupVersionResource = sourcev1.GroupVersion.WithResource("helmrepositories")
+	GVRBucket         schema.GroupVersionResource = sourcev1.GroupVersion.WithResource("buckets")
 	GVRHelmRelease    schema.GroupVersionResource = helmv2.GroupVersion.WithResource("helmreleases")
 )
 
@@ -383,6 +386,42 @@ func (k *KubeHTTP) GetApplications(ctx context.Context, namespace string) ([]weg
 	return result.Items, nil
 }
 
+func (k *KubeHTTP) GetDeployments(ctx context.Context, namespace string) ([]appsv1.Deployment, error) {
+	result := appsv1.DeploymentList{}
+
+	if err := k.Client.List(ctx, &result, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("could not list deployments: %w", err)
+	}
+
+	return result.Items, nil
+}
+
+// GetPods returns the pods of a namespace matching a selector, such as the selector of a deployment.
+func (k *KubeHTTP) GetPods(ctx context.Context, namespace string, selector *metav1.LabelSelector) ([]corev1.Pod, error) {
+	podSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pod selector: %w", err)
+	}
+
+	result := corev1.PodList{}
+
+	if err := k.Client.List(ctx, &result, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: podSelector}); err != nil {
+		return nil, fmt.Errorf("could not list pods: %w", err)
+	}
+
+	return result.Items, nil
+}
+
+func (k *KubeHTTP) GetCRDs(ctx context.Context) ([]extensionsv1.CustomResourceDefinition, error) {
+	result := extensionsv1.CustomResourceDefinitionList{}
+
+	if err := k.Client.List(ctx, &result); err != nil {
+		return nil, fmt.Errorf("could not list custom resource definitions: %w", err)
+	}
+
+	return result.Items, nil
+}
+
 func (k *KubeHTTP) GetResource(ctx context.Context, name types.NamespacedName, resource Resource) error {
 	if err := k.Client.Get(ctx, name, resource); err != nil {
 		if apierrors.IsNotFound(err) {
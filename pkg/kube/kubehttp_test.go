@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/gomega"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -173,6 +174,71 @@ var _ = Describe("KubeHTTP", func() {
 		Expect(list[0].Name).To(Equal(name))
 	})
 
+	It("GetDeployments", func() {
+		ctx := context.Background()
+		labels := map[string]string{"app": "my-deployment"}
+
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-deployment",
+				Namespace: namespace.Name,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "nginx", Image: "nginx"}},
+					},
+				},
+			},
+		}
+
+		Expect(k8sClient.Create(ctx, deployment)).Should(Succeed())
+
+		list, err := k.GetDeployments(ctx, namespace.Name)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(1))
+		Expect(list[0].Name).To(Equal("my-deployment"))
+	})
+
+	It("GetPods", func() {
+		ctx := context.Background()
+
+		for name, app := range map[string]string{"my-pod": "my-app", "other-pod": "other-app"} {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace.Name,
+					Labels:    map[string]string{"app": app},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "nginx", Image: "nginx"}},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, pod)).Should(Succeed())
+		}
+
+		list, err := k.GetPods(ctx, namespace.Name, &metav1.LabelSelector{MatchLabels: map[string]string{"app": "my-app"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(1))
+		Expect(list[0].Name).To(Equal("my-pod"))
+	})
+
+	It("GetCRDs", func() {
+		list, err := k.GetCRDs(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		names := []string{}
+
+		for _, crd := range list {
+			names = append(names, crd.Name)
+		}
+
+		Expect(names).To(ContainElement(kube.WeGOCRDName))
+	})
+
 	Describe("Apply", func() {
 		It("applies a namespaced manifest", func() {
 			ctx := context.Background()
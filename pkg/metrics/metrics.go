@@ -0,0 +1,140 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// The metrics are registered with the controller-runtime registry, so the gitops-server serves them
+// on the metrics endpoint of its watchers, next to the metrics of their controllers.
+
+const namespace = "gitops"
+
+// Requests not routed to an RPC are counted under this name, to keep the number of series bounded
+const UnknownRPC = "unknown"
+
+// The reasons authentication fails for
+const (
+	// AuthReasonUnauthenticated is a request to the API without a valid session
+	AuthReasonUnauthenticated = "unauthenticated"
+	// AuthReasonInvalidToken is a request to the API with a JWT that could not be verified
+	AuthReasonInvalidToken = "invalid_token"
+	// AuthReasonInvalidProviderToken is a request with a git provider token that could not be verified
+	AuthReasonInvalidProviderToken = "invalid_provider_token"
+	// AuthReasonInvalidPassword is a sign in with the wrong admin password
+	AuthReasonInvalidPassword = "invalid_password"
+	// AuthReasonOIDCExchange is an OIDC callback whose authorization code could not be exchanged for a token
+	AuthReasonOIDCExchange = "oidc_exchange"
+	// AuthReasonOIDCInvalidIDToken is an OIDC callback whose ID token is missing or could not be verified
+	AuthReasonOIDCInvalidIDToken = "oidc_invalid_id_token"
+)
+
+var (
+	requests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "server",
+		Name:      "requests_total",
+		Help:      "Number of requests handled by the gitops-server, by RPC and HTTP status code.",
+	}, []string{"rpc", "code"})
+
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "server",
+		Name:      "request_duration_seconds",
+		Help:      "Time taken to handle the requests of the gitops-server, by RPC and HTTP status code. Streams are measured until they end.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"rpc", "code"})
+
+	authFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "server",
+		Name:      "auth_failures_total",
+		Help:      "Number of requests the gitops-server failed to authenticate, by reason.",
+	}, []string{"reason"})
+
+	gitProviderRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "git_provider",
+		Name:      "requests_total",
+		Help:      "Number of calls to the API of a git provider, by provider and operation.",
+	}, []string{"provider", "operation"})
+
+	gitProviderErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "git_provider",
+		Name:      "errors_total",
+		Help:      "Number of calls to the API of a git provider that failed, by provider and operation.",
+	}, []string{"provider", "operation"})
+
+	applicationReady = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "application",
+		Name:      "ready",
+		Help:      "Whether the automation of an Application is ready (1) or not (0).",
+	}, []string{"namespace", "name"})
+
+	applicationSuspended = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "application",
+		Name:      "suspended",
+		Help:      "Whether the automation of an Application is suspended (1) or not (0).",
+	}, []string{"namespace", "name"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		requests,
+		requestDuration,
+		authFailures,
+		gitProviderRequests,
+		gitProviderErrors,
+		applicationReady,
+		applicationSuspended,
+	)
+}
+
+// ObserveRequest records a request handled by the gitops-server
+func ObserveRequest(rpc, code string, duration time.Duration) {
+	if rpc == "" {
+		rpc = UnknownRPC
+	}
+
+	requests.WithLabelValues(rpc, code).Inc()
+	requestDuration.WithLabelValues(rpc, code).Observe(duration.Seconds())
+}
+
+// AuthFailed records a request that failed to authenticate
+func AuthFailed(reason string) {
+	authFailures.WithLabelValues(reason).Inc()
+}
+
+// GitProviderCalled records a call to the API of a git provider, and whether it failed
+func GitProviderCalled(provider, operation string, err error) {
+	gitProviderRequests.WithLabelValues(provider, operation).Inc()
+
+	if err != nil {
+		gitProviderErrors.WithLabelValues(provider, operation).Inc()
+	}
+}
+
+// SetApplicationStatus records whether the automation of an Application is ready and suspended
+func SetApplicationStatus(namespace, name string, ready, suspended bool) {
+	applicationReady.WithLabelValues(namespace, name).Set(boolToFloat(ready))
+	applicationSuspended.WithLabelValues(namespace, name).Set(boolToFloat(suspended))
+}
+
+// DeleteApplicationStatus stops reporting the status of an Application once it is deleted
+func DeleteApplicationStatus(namespace, name string) {
+	applicationReady.DeleteLabelValues(namespace, name)
+	applicationSuspended.DeleteLabelValues(namespace, name)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("Metrics", func() {
+	It("records the requests by rpc and status code", func() {
+		ObserveRequest("/wego_server.v1.Applications/ListApplications", "200", time.Second)
+		ObserveRequest("", "404", time.Millisecond)
+
+		Expect(testutil.ToFloat64(requests.WithLabelValues("/wego_server.v1.Applications/ListApplications", "200"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(requests.WithLabelValues(UnknownRPC, "404"))).To(Equal(1.0))
+		Expect(testutil.CollectAndCount(requestDuration)).To(Equal(2))
+	})
+
+	It("records the git provider calls and their errors", func() {
+		GitProviderCalled("github", "GetCommits", nil)
+		GitProviderCalled("github", "GetCommits", errors.New("rate limited"))
+
+		Expect(testutil.ToFloat64(gitProviderRequests.WithLabelValues("github", "GetCommits"))).To(Equal(2.0))
+		Expect(testutil.ToFloat64(gitProviderErrors.WithLabelValues("github", "GetCommits"))).To(Equal(1.0))
+	})
+
+	It("records the auth failures by reason", func() {
+		AuthFailed(AuthReasonInvalidPassword)
+
+		Expect(testutil.ToFloat64(authFailures.WithLabelValues(AuthReasonInvalidPassword))).To(Equal(1.0))
+	})
+
+	It("reports the status of applications until they are deleted", func() {
+		SetApplicationStatus("wego-system", "podinfo", true, false)
+		SetApplicationStatus("wego-system", "podinfo", false, true)
+
+		Expect(testutil.ToFloat64(applicationReady.WithLabelValues("wego-system", "podinfo"))).To(Equal(0.0))
+		Expect(testutil.ToFloat64(applicationSuspended.WithLabelValues("wego-system", "podinfo"))).To(Equal(1.0))
+
+		DeleteApplicationStatus("wego-system", "podinfo")
+
+		Expect(testutil.CollectAndCount(applicationReady)).To(Equal(0))
+		Expect(testutil.CollectAndCount(applicationSuspended)).To(Equal(0))
+	})
+})
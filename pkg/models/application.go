@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 )
 
@@ -12,8 +14,10 @@ const (
 	AutomationTypeHelm      AutomationType = "helm"
 	AutomationTypeKustomize AutomationType = "kustomize"
 
-	SourceTypeGit  SourceType = "git"
-	SourceTypeHelm SourceType = "helm"
+	SourceTypeGit    SourceType = "git"
+	SourceTypeHelm   SourceType = "helm"
+	SourceTypeBucket SourceType = "bucket"
+	SourceTypeOCI    SourceType = "oci"
 )
 
 type Application struct {
@@ -27,6 +31,60 @@ type Application struct {
 	AutomationType      AutomationType
 	SourceType          SourceType
 	HelmTargetNamespace string
+	SourceInterval      time.Duration
+	Interval            time.Duration
+	Timeout             time.Duration
+	DisablePrune        bool
+	Wait                bool
+	HealthChecks        []HealthCheck
+	HelmValues          map[string]interface{}
+	HelmValuesFrom      []ValuesReference
+	Bucket              BucketSource
+	Substitute          map[string]string
+	SubstituteFrom      []SubstituteReference
+	DependsOn           []string
+	Decryption          Decryption
+}
+
+// Decryption holds the settings to decrypt the manifests of an application; no provider means no decryption
+type Decryption struct {
+	Provider  string
+	SecretRef string
+}
+
+// BucketSource holds the settings of an S3 compatible bucket holding the manifests of an application
+type BucketSource struct {
+	Endpoint  string
+	Name      string
+	Provider  string
+	Region    string
+	Insecure  bool
+	SecretRef string
+}
+
+// HealthCheck references a resource that must be healthy for an application to be ready
+type HealthCheck struct {
+	APIVersion string
+	Kind       string
+	Name       string
+	Namespace  string
+}
+
+// ValuesReference references a ConfigMap or Secret holding Helm values
+type ValuesReference struct {
+	Kind string
+	Name string
+}
+
+// SubstituteReference references a ConfigMap or Secret holding variables for post-build substitution
+type SubstituteReference struct {
+	Kind string
+	Name string
+}
+
+// IsHelmRepository reports whether the application is deployed from a chart in a Helm repository, including OCI registries
+func (a Application) IsHelmRepository() bool {
+	return a.SourceType == SourceTypeHelm || a.SourceType == SourceTypeOCI
 }
 
 func IsExternalConfigRepo(url string) bool {
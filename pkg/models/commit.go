@@ -0,0 +1,168 @@
+package models
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+// The actions of the commits gitops writes to the config repository
+const (
+	ActionAddApplication    = "add-application"
+	ActionUpdateApplication = "update-application"
+	ActionRemoveApplication = "remove-application"
+	ActionAssociateCluster  = "associate-cluster"
+	ActionAddProfile        = "add-profile"
+	ActionUpdateProfile     = "update-profile"
+	ActionUpgrade           = "upgrade"
+	ActionAddCluster        = "add-cluster"
+)
+
+// CommitTemplates are the Go templates of the commit messages, pull request titles and pull request bodies
+// gitops writes. A template which isn't set keeps the default text of gitops.
+type CommitTemplates struct {
+	CommitMessage    string
+	PullRequestTitle string
+	PullRequestBody  string
+}
+
+// CommitData is the data the commit templates are executed with
+type CommitData struct {
+	// Action is the change the commit makes, one of the Action constants
+	Action string
+	// Default is the text gitops writes when the template isn't set
+	Default string
+	// App is the application the commit changes, if any
+	App *Application
+	// Clusters are the clusters the commit is made for
+	Clusters []string
+	// Profile is the profile the commit changes, if any
+	Profile *CommitProfile
+}
+
+// CommitProfile is the profile of a commit adding or updating a profile
+type CommitProfile struct {
+	Name      string
+	Version   string
+	Namespace string
+}
+
+var templateFuncs = template.FuncMap{
+	"join":  strings.Join,
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+}
+
+// CommitTemplatesFromConfig returns the commit templates saved in a wego config
+func CommitTemplatesFromConfig(config *kube.WegoConfig) CommitTemplates {
+	if config == nil {
+		return CommitTemplates{}
+	}
+
+	return CommitTemplates{
+		CommitMessage:    config.CommitMessageTemplate,
+		PullRequestTitle: config.PullRequestTitleTemplate,
+		PullRequestBody:  config.PullRequestBodyTemplate,
+	}
+}
+
+// GetCommitTemplates returns the templates given, with the ones not set taken from the wego config of the namespace
+func GetCommitTemplates(ctx context.Context, kubeClient kube.Kube, namespace string, templates CommitTemplates) (CommitTemplates, error) {
+	if templates.CommitMessage != "" && templates.PullRequestTitle != "" && templates.PullRequestBody != "" {
+		return templates, nil
+	}
+
+	config, err := kubeClient.GetWegoConfig(ctx, namespace)
+	if err != nil && !errors.Is(err, kube.ErrWegoConfigNotFound) {
+		return CommitTemplates{}, fmt.Errorf("error getting wego config: %w", err)
+	}
+
+	return templates.Or(CommitTemplatesFromConfig(config)), nil
+}
+
+// Or returns the templates, with the ones not set taken from other
+func (t CommitTemplates) Or(other CommitTemplates) CommitTemplates {
+	if t.CommitMessage == "" {
+		t.CommitMessage = other.CommitMessage
+	}
+
+	if t.PullRequestTitle == "" {
+		t.PullRequestTitle = other.PullRequestTitle
+	}
+
+	if t.PullRequestBody == "" {
+		t.PullRequestBody = other.PullRequestBody
+	}
+
+	return t
+}
+
+// Validate checks the templates can be parsed
+func (t CommitTemplates) Validate() error {
+	if _, err := parseTemplate("commit message", t.CommitMessage); err != nil {
+		return err
+	}
+
+	if _, err := parseTemplate("pull request title", t.PullRequestTitle); err != nil {
+		return err
+	}
+
+	_, err := parseTemplate("pull request body", t.PullRequestBody)
+
+	return err
+}
+
+// Apply replaces the commit message, title and description of a pull request with the templates which are set.
+// Each template is executed with the data given, and the text it replaces as Default.
+func (t CommitTemplates) Apply(info gitproviders.PullRequestInfo, data CommitData) (gitproviders.PullRequestInfo, error) {
+	var err error
+
+	if info.CommitMessage, err = executeTemplate("commit message", t.CommitMessage, info.CommitMessage, data); err != nil {
+		return info, err
+	}
+
+	if info.Title, err = executeTemplate("pull request title", t.PullRequestTitle, info.Title, data); err != nil {
+		return info, err
+	}
+
+	if info.Description, err = executeTemplate("pull request body", t.PullRequestBody, info.Description, data); err != nil {
+		return info, err
+	}
+
+	return info, nil
+}
+
+func parseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s template: %w", name, err)
+	}
+
+	return tmpl, nil
+}
+
+func executeTemplate(name, text, defaultText string, data CommitData) (string, error) {
+	if text == "" {
+		return defaultText, nil
+	}
+
+	tmpl, err := parseTemplate(name, text)
+	if err != nil {
+		return "", err
+	}
+
+	data.Default = defaultText
+
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", fmt.Errorf("failed executing %s template: %w", name, err)
+	}
+
+	return out.String(), nil
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/kube/kubefakes"
+)
+
+var _ = Describe("CommitTemplates", func() {
+	defaults := gitproviders.PullRequestInfo{
+		Title:         "Gitops add podinfo",
+		Description:   "Added yamls for podinfo",
+		CommitMessage: "Add application manifests",
+		NewBranch:     "wego-podinfo",
+	}
+
+	data := CommitData{
+		Action:   ActionAddApplication,
+		App:      &Application{Name: "podinfo", Namespace: "wego-system"},
+		Clusters: []string{"kind-a", "kind-b"},
+	}
+
+	It("keeps the defaults when no template is set", func() {
+		info, err := CommitTemplates{}.Apply(defaults, data)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(info).To(Equal(defaults))
+	})
+
+	It("executes the templates with the data of the commit", func() {
+		templates := CommitTemplates{
+			CommitMessage:    "feat(gitops): {{ lower .Default }}\n\nSigned-off-by: bot <bot@example.com>",
+			PullRequestTitle: "{{ .Action }} {{ .App.Name }} to {{ join .Clusters \", \" }}",
+		}
+
+		info, err := templates.Apply(defaults, data)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(info.CommitMessage).To(Equal("feat(gitops): add application manifests\n\nSigned-off-by: bot <bot@example.com>"))
+		Expect(info.Title).To(Equal("add-application podinfo to kind-a, kind-b"))
+		Expect(info.Description).To(Equal(defaults.Description))
+		Expect(info.NewBranch).To(Equal(defaults.NewBranch))
+	})
+
+	It("fails on invalid templates", func() {
+		templates := CommitTemplates{PullRequestBody: "{{ .Profile.Name }"}
+		Expect(templates.Validate()).To(MatchError(ContainSubstring("invalid pull request body template")))
+
+		_, err := templates.Apply(defaults, data)
+		Expect(err).To(MatchError(ContainSubstring("invalid pull request body template")))
+
+		templates = CommitTemplates{PullRequestBody: "{{ .Profile.Name }}"}
+		Expect(templates.Validate()).To(Succeed())
+
+		_, err = templates.Apply(defaults, data)
+		Expect(err).To(MatchError(ContainSubstring("failed executing pull request body template")))
+	})
+
+	It("takes the templates not set from the wego config", func() {
+		fakeKube := &kubefakes.FakeKube{}
+		fakeKube.GetWegoConfigReturns(&kube.WegoConfig{
+			CommitMessageTemplate:    "chore: {{ .Default }}",
+			PullRequestTitleTemplate: "chore: {{ .Default }}",
+		}, nil)
+
+		templates, err := GetCommitTemplates(context.Background(), fakeKube, "wego-system", CommitTemplates{PullRequestTitle: "{{ .Default }}"})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(templates).To(Equal(CommitTemplates{
+			CommitMessage:    "chore: {{ .Default }}",
+			PullRequestTitle: "{{ .Default }}",
+		}))
+
+		_, namespace := fakeKube.GetWegoConfigArgsForCall(0)
+		Expect(namespace).To(Equal("wego-system"))
+
+		fakeKube.GetWegoConfigReturns(&kube.WegoConfig{}, kube.ErrWegoConfigNotFound)
+
+		templates, err = GetCommitTemplates(context.Background(), fakeKube, "wego-system", CommitTemplates{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(templates).To(Equal(CommitTemplates{}))
+
+		fakeKube.GetWegoConfigReturns(nil, errors.New("forbidden"))
+
+		_, err = GetCommitTemplates(context.Background(), fakeKube, "wego-system", CommitTemplates{})
+		Expect(err).To(MatchError("error getting wego config: forbidden"))
+	})
+})
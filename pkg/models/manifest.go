@@ -50,9 +50,12 @@ const (
 )
 
 type ManifestsParams struct {
-	ClusterName   string
-	WegoNamespace string
-	ConfigRepo    gitproviders.RepoURL
+	ClusterName      string
+	WegoNamespace    string
+	ConfigRepo       gitproviders.RepoURL
+	SigningKeySecret string
+	CommitAuthor     git.Author
+	CommitTemplates  CommitTemplates
 }
 
 // BootstrapManifests creates all yaml files that are going to be applied to the cluster
@@ -107,7 +110,7 @@ func BootstrapManifests(ctx context.Context, fluxClient flux.Flux, gitProvider g
 		fluxNs = fluxNamespace.Name
 	}
 
-	gitopsConfigMap, err := CreateGitopsConfigMap(fluxNs, params.WegoNamespace, params.ConfigRepo.String())
+	gitopsConfigMap, err := CreateGitopsConfigMap(fluxNs, params)
 	if err != nil {
 		return nil, err
 	}
@@ -292,11 +295,17 @@ func workAroundFluxDroppingDot(str string) string {
 	return "." + str
 }
 
-func CreateGitopsConfigMap(fluxNamespace string, wegoNamespace string, configRepo string) (corev1.ConfigMap, error) {
+func CreateGitopsConfigMap(fluxNamespace string, params ManifestsParams) (corev1.ConfigMap, error) {
 	config := kube.WegoConfig{
-		FluxNamespace: fluxNamespace,
-		WegoNamespace: wegoNamespace,
-		ConfigRepo:    configRepo,
+		FluxNamespace:            fluxNamespace,
+		WegoNamespace:            params.WegoNamespace,
+		ConfigRepo:               params.ConfigRepo.String(),
+		SigningKeySecret:         params.SigningKeySecret,
+		CommitAuthorName:         params.CommitAuthor.Name,
+		CommitAuthorEmail:        params.CommitAuthor.Email,
+		CommitMessageTemplate:    params.CommitTemplates.CommitMessage,
+		PullRequestTitleTemplate: params.CommitTemplates.PullRequestTitle,
+		PullRequestBodyTemplate:  params.CommitTemplates.PullRequestBody,
 	}
 
 	configBytes, err := yaml.Marshal(config)
@@ -311,7 +320,7 @@ func CreateGitopsConfigMap(fluxNamespace string, wegoNamespace string, configRep
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      WegoConfigMapName,
-			Namespace: wegoNamespace,
+			Namespace: params.WegoNamespace,
 		},
 		Data: map[string]string{
 			"config": string(configBytes),
@@ -96,7 +96,7 @@ var _ = Describe("Installer", func() {
 
 				wegoAppManifest := bytes.Join(wegoAppManifests, []byte("---\n"))
 
-				gitopsConfigMap, err := CreateGitopsConfigMap(params.WegoNamespace, params.WegoNamespace, params.ConfigRepo.String())
+				gitopsConfigMap, err := CreateGitopsConfigMap(params.WegoNamespace, params)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				wegoConfigManifest, err := yaml.Marshal(gitopsConfigMap)
@@ -202,6 +202,38 @@ var _ = Describe("Installer", func() {
 			})
 		})
 
+		Context("CreateGitopsConfigMap", func() {
+			It("saves the signing key secret only when it's set", func() {
+				cm, err := CreateGitopsConfigMap("flux-system", params)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(cm.Data["config"]).ShouldNot(ContainSubstring("SigningKeySecret"))
+
+				signingParams := params
+				signingParams.SigningKeySecret = "wego-signing-key"
+
+				cm, err = CreateGitopsConfigMap("flux-system", signingParams)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(cm.Data["config"]).Should(ContainSubstring("SigningKeySecret: wego-signing-key"))
+			})
+
+			It("saves the commit author and templates", func() {
+				cm, err := CreateGitopsConfigMap("flux-system", params)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(cm.Data["config"]).ShouldNot(ContainSubstring("Commit"))
+
+				commitParams := params
+				commitParams.CommitAuthor = git.Author{Name: "bot", Email: "bot@example.com"}
+				commitParams.CommitTemplates = CommitTemplates{CommitMessage: "chore: {{ .Default }}"}
+
+				cm, err = CreateGitopsConfigMap("flux-system", commitParams)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(cm.Data["config"]).Should(ContainSubstring("CommitAuthorName: bot"))
+				Expect(cm.Data["config"]).Should(ContainSubstring("CommitAuthorEmail: bot@example.com"))
+				Expect(cm.Data["config"]).Should(ContainSubstring("CommitMessageTemplate: 'chore: {{ .Default }}'"))
+				Expect(cm.Data["config"]).ShouldNot(ContainSubstring("PullRequestTitleTemplate"))
+			})
+		})
+
 		Context("Validate name", func() {
 			It("should pass successfully", func() {
 				Expect(ValidateApplicationName("foobar")).ShouldNot(HaveOccurred())
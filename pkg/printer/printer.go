@@ -0,0 +1,239 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	FormatTable = "table"
+	FormatWide  = "wide"
+	FormatJSON  = "json"
+	FormatYAML  = "yaml"
+
+	jsonPathPrefix   = "jsonpath="
+	goTemplatePrefix = "go-template="
+)
+
+// Formats lists the output formats accepted by New
+var Formats = []string{FormatTable, FormatWide, FormatJSON, FormatYAML, jsonPathPrefix + "<template>", goTemplatePrefix + "<template>"}
+
+// Default prints tables, as the get commands did before they supported other formats
+var Default = Printer{format: FormatTable}
+
+// Column describes a column of a table. Wide columns are only printed in the wide format.
+type Column struct {
+	Header string
+	Wide   bool
+}
+
+// Table is the human readable view of an object. Rows may be shorter than the columns,
+// in which case the missing trailing cells are not printed.
+// Empty is printed instead of the table when there are no rows; the header is printed alone if it is not set.
+type Table struct {
+	Columns []Column
+	Rows    [][]string
+	Empty   string
+}
+
+// Printer writes objects in one of the output formats of the get commands
+type Printer struct {
+	format     string
+	jsonPath   *jsonpath.JSONPath
+	goTemplate *template.Template
+}
+
+// New returns a Printer for an --output value; an empty value selects the table format
+func New(output string) (Printer, error) {
+	switch {
+	case output == "" || output == FormatTable:
+		return Default, nil
+	case output == FormatWide || output == FormatJSON || output == FormatYAML:
+		return Printer{format: output}, nil
+	case strings.HasPrefix(output, jsonPathPrefix):
+		expression := strings.TrimPrefix(output, jsonPathPrefix)
+		if expression == "" {
+			return Printer{}, fmt.Errorf("jsonpath template must not be empty")
+		}
+
+		// accept ".items[*].name" as well as "{.items[*].name}", like kubectl does
+		if !strings.Contains(expression, "{") {
+			expression = "{" + expression + "}"
+		}
+
+		j := jsonpath.New("output")
+		if err := j.Parse(expression); err != nil {
+			return Printer{}, fmt.Errorf("invalid jsonpath template %q: %w", expression, err)
+		}
+
+		return Printer{format: jsonPathPrefix, jsonPath: j}, nil
+	case strings.HasPrefix(output, goTemplatePrefix):
+		text := strings.TrimPrefix(output, goTemplatePrefix)
+		if text == "" {
+			return Printer{}, fmt.Errorf("go-template must not be empty")
+		}
+
+		t, err := template.New("output").Parse(text)
+		if err != nil {
+			return Printer{}, fmt.Errorf("invalid go-template %q: %w", text, err)
+		}
+
+		return Printer{format: goTemplatePrefix, goTemplate: t}, nil
+	}
+
+	return Printer{}, fmt.Errorf("unsupported output format %q, must be one of: %s", output, strings.Join(Formats, ", "))
+}
+
+// IsTable reports whether the printer writes human readable tables rather than the object itself
+func (p Printer) IsTable() bool {
+	return p.format == "" || p.format == FormatTable || p.format == FormatWide
+}
+
+// IsWide reports whether the printer writes tables with their wide columns
+func (p Printer) IsWide() bool {
+	return p.format == FormatWide
+}
+
+// Print writes table in the table and wide formats, and obj in the other formats.
+// Proto messages are encoded with their canonical JSON mapping, other objects with their json tags.
+func (p Printer) Print(w io.Writer, obj interface{}, table Table) error {
+	if p.IsTable() {
+		printTable(w, table, p.format == FormatWide)
+		return nil
+	}
+
+	data, err := toJSON(obj)
+	if err != nil {
+		return err
+	}
+
+	switch p.format {
+	case FormatJSON:
+		out, err := indent(data)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(w, "%s\n", out)
+
+		return err
+	case FormatYAML:
+		out, err := yaml.JSONToYAML(data)
+		if err != nil {
+			return fmt.Errorf("failed converting output to yaml: %w", err)
+		}
+
+		_, err = w.Write(out)
+
+		return err
+	}
+
+	generic, err := decode(data)
+	if err != nil {
+		return err
+	}
+
+	if p.goTemplate != nil {
+		if err := p.goTemplate.Execute(w, generic); err != nil {
+			return fmt.Errorf("failed executing go-template: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := p.jsonPath.Execute(w, generic); err != nil {
+		return fmt.Errorf("failed executing jsonpath template: %w", err)
+	}
+
+	_, err = fmt.Fprintln(w)
+
+	return err
+}
+
+func printTable(w io.Writer, table Table, wide bool) {
+	if len(table.Rows) == 0 && table.Empty != "" {
+		fmt.Fprint(w, table.Empty)
+		return
+	}
+
+	headers := []string{}
+
+	for _, c := range table.Columns {
+		if !c.Wide || wide {
+			headers = append(headers, c.Header)
+		}
+	}
+
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+
+	for _, row := range table.Rows {
+		cells := []string{}
+
+		for i, cell := range row {
+			if i < len(table.Columns) && table.Columns[i].Wide && !wide {
+				continue
+			}
+
+			cells = append(cells, cell)
+		}
+
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+}
+
+func toJSON(obj interface{}) ([]byte, error) {
+	if m, ok := obj.(proto.Message); ok {
+		data, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(m)
+		if err != nil {
+			return nil, fmt.Errorf("failed encoding output: %w", err)
+		}
+
+		return data, nil
+	}
+
+	// print an empty list rather than null
+	if v := reflect.ValueOf(obj); v.Kind() == reflect.Slice && v.IsNil() {
+		obj = []interface{}{}
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed encoding output: %w", err)
+	}
+
+	return data, nil
+}
+
+// indent re-encodes JSON with sorted keys and a fixed indentation, protojson output not being stable on purpose
+func indent(data []byte) ([]byte, error) {
+	generic, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(generic, "", "  ")
+}
+
+// decode unmarshals JSON into generic maps and slices, keeping numbers as they were written
+func decode(data []byte) (interface{}, error) {
+	var generic interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, fmt.Errorf("failed decoding output: %w", err)
+	}
+
+	return generic, nil
+}
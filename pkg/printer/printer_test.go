@@ -0,0 +1,153 @@
+package printer_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/printer"
+)
+
+type item struct {
+	Name     string   `json:"name"`
+	Versions []string `json:"versions"`
+}
+
+var _ = Describe("Printer", func() {
+	var (
+		buffer *bytes.Buffer
+		items  []item
+		table  printer.Table
+	)
+
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+		items = []item{
+			{Name: "foo", Versions: []string{"1.0.0", "1.1.0"}},
+			{Name: "bar"},
+		}
+		table = printer.Table{
+			Columns: []printer.Column{{Header: "NAME"}, {Header: "VERSIONS", Wide: true}},
+			Rows:    [][]string{{"foo", "1.0.0,1.1.0"}, {"bar"}},
+			Empty:   "No items were found.\n",
+		}
+	})
+
+	print := func(output string, obj interface{}) string {
+		p, err := printer.New(output)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(p.Print(buffer, obj, table)).To(Succeed())
+
+		return buffer.String()
+	}
+
+	It("prints tables by default", func() {
+		Expect(print("", items)).To(Equal("NAME\nfoo\nbar\n"))
+
+		buffer.Reset()
+		Expect(printer.Default.Print(buffer, items, table)).To(Succeed())
+		Expect(buffer.String()).To(Equal("NAME\nfoo\nbar\n"))
+	})
+
+	It("prints the wide columns in the wide format", func() {
+		Expect(print("wide", items)).To(Equal("NAME\tVERSIONS\nfoo\t1.0.0,1.1.0\nbar\n"))
+	})
+
+	It("tells the wide format from the other table formats", func() {
+		for output, wide := range map[string]bool{"": false, "table": false, "wide": true, "json": false} {
+			p, err := printer.New(output)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(p.IsWide()).To(Equal(wide), output)
+		}
+	})
+
+	It("prints the empty message when there are no rows", func() {
+		table.Rows = nil
+		Expect(print("table", nil)).To(Equal("No items were found.\n"))
+	})
+
+	It("prints the header alone when there is no empty message", func() {
+		table.Rows = nil
+		table.Empty = ""
+		Expect(print("table", nil)).To(Equal("NAME\n"))
+	})
+
+	It("prints json", func() {
+		Expect(print("json", items)).To(Equal(`[
+  {
+    "name": "foo",
+    "versions": [
+      "1.0.0",
+      "1.1.0"
+    ]
+  },
+  {
+    "name": "bar",
+    "versions": null
+  }
+]
+`))
+	})
+
+	It("prints an empty list rather than null", func() {
+		var none []item
+		Expect(print("json", none)).To(Equal("[]\n"))
+	})
+
+	It("prints yaml", func() {
+		Expect(print("yaml", items[0])).To(Equal(`name: foo
+versions:
+- 1.0.0
+- 1.1.0
+`))
+	})
+
+	It("prints proto messages with their json mapping and all their fields", func() {
+		commits := &pb.ListCommitsResponse{
+			Commits:       []*pb.Commit{{Hash: "abc1234", Author: "foo"}},
+			NextPageToken: 2,
+		}
+
+		Expect(print("yaml", commits)).To(Equal(`automationLastAppliedRevision: ""
+commits:
+- applied: false
+  author: foo
+  date: ""
+  hash: abc1234
+  message: ""
+  repository: ""
+  signature: ""
+  url: ""
+lastAppliedRevision: ""
+nextPageToken: 2
+`))
+	})
+
+	It("prints a jsonpath template", func() {
+		Expect(print("jsonpath={.commits[*].hash}", &pb.ListCommitsResponse{
+			Commits: []*pb.Commit{{Hash: "abc1234"}, {Hash: "def5678"}},
+		})).To(Equal("abc1234 def5678\n"))
+	})
+
+	It("adds the braces around a jsonpath expression", func() {
+		Expect(print("jsonpath=.nextPageToken", &pb.ListCommitsResponse{NextPageToken: 1000000})).To(Equal("1000000\n"))
+	})
+
+	It("prints a go-template", func() {
+		Expect(print(`go-template={{range .}}{{.name}}{{"\n"}}{{end}}`, items)).To(Equal("foo\nbar\n"))
+	})
+
+	It("rejects unknown formats", func() {
+		_, err := printer.New("xml")
+		Expect(err).To(MatchError(`unsupported output format "xml", must be one of: table, wide, json, yaml, jsonpath=<template>, go-template=<template>`))
+	})
+
+	It("rejects invalid templates", func() {
+		_, err := printer.New("go-template={{.name")
+		Expect(err).To(MatchError(ContainSubstring("invalid go-template")))
+
+		_, err = printer.New("jsonpath=")
+		Expect(err).To(MatchError("jsonpath template must not be empty"))
+	})
+})
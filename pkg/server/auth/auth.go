@@ -6,6 +6,8 @@ import (
 	"encoding/base64"
 	"net/http"
 	"net/url"
+
+	"github.com/weaveworks/weave-gitops/pkg/metrics"
 )
 
 const (
@@ -81,6 +83,9 @@ func WithAPIAuth(next http.Handler, srv *AuthServer, publicRoutes []string) http
 		principal, err := multi.Principal(r)
 		if err != nil {
 			srv.logger.Error(err, "failed to get principal")
+			metrics.AuthFailed(metrics.AuthReasonInvalidToken)
+		} else if principal == nil {
+			metrics.AuthFailed(metrics.AuthReasonUnauthenticated)
 		}
 
 		if principal == nil || err != nil {
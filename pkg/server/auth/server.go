@@ -11,6 +11,7 @@ import (
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/go-logr/logr"
+	"github.com/weaveworks/weave-gitops/pkg/metrics"
 	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/oauth2"
 	corev1 "k8s.io/api/core/v1"
@@ -208,6 +209,7 @@ func (s *AuthServer) Callback() http.HandlerFunc {
 		token, err = s.oauth2Config(nil).Exchange(ctx, code)
 		if err != nil {
 			s.logger.Error(err, "failed to exchange auth code for token", "code", code)
+			metrics.AuthFailed(metrics.AuthReasonOIDCExchange)
 			rw.WriteHeader(http.StatusInternalServerError)
 
 			return
@@ -215,12 +217,14 @@ func (s *AuthServer) Callback() http.HandlerFunc {
 
 		rawIDToken, ok := token.Extra("id_token").(string)
 		if !ok {
+			metrics.AuthFailed(metrics.AuthReasonOIDCInvalidIDToken)
 			http.Error(rw, "no id_token in token response", http.StatusInternalServerError)
 			return
 		}
 
 		_, err = s.verifier().Verify(r.Context(), rawIDToken)
 		if err != nil {
+			metrics.AuthFailed(metrics.AuthReasonOIDCInvalidIDToken)
 			http.Error(rw, fmt.Sprintf("failed to verify ID token: %v", err), http.StatusInternalServerError)
 			return
 		}
@@ -274,6 +278,7 @@ func (s *AuthServer) SignIn() http.HandlerFunc {
 
 		if err := bcrypt.CompareHashAndPassword(hashedSecret.Data["password"], []byte(loginRequest.Password)); err != nil {
 			s.logger.Error(err, "Failed to compare hash with password")
+			metrics.AuthFailed(metrics.AuthReasonInvalidPassword)
 			rw.WriteHeader(http.StatusUnauthorized)
 
 			return
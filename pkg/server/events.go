@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+)
+
+func (s *applicationServer) ListApplicationEvents(ctx context.Context, msg *pb.ListApplicationEventsRequest) (*pb.ListApplicationEventsResponse, error) {
+	cl, err := s.clientGetter.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeClient, err := s.kubeGetter.Kube(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kube service: %w", err)
+	}
+
+	app, err := kubeClient.GetApplication(ctx, types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, grpcStatus.Errorf(codes.NotFound, "not found: %s", err.Error())
+		}
+
+		return nil, fmt.Errorf("could not get application %q: %w", msg.Name, err)
+	}
+
+	source, deployment, err := findFluxObjects(app)
+	if err != nil {
+		return nil, fmt.Errorf("could not get flux objects for application %q: %w", app.Name, err)
+	}
+
+	// the objects are matched by kind, namespace and name, like the involved objects of events are described
+	involved := map[string]bool{}
+	namespaces := map[string]bool{app.Namespace: true}
+
+	for _, obj := range []client.Object{source, deployment} {
+		gvk, err := apiutil.GVKForObject(obj, s.watcher.scheme)
+		if err != nil {
+			return nil, err
+		}
+
+		involved[involvedKey(gvk.Kind, app.Namespace, app.Name)] = true
+	}
+
+	tree, err := applicationTree(ctx, cl, kubeClient, app)
+	if err != nil {
+		return nil, err
+	}
+
+	var addNode func(node *pb.ObjectNode)
+
+	addNode = func(node *pb.ObjectNode) {
+		involved[involvedKey(node.Object.GroupVersionKind.Kind, node.Object.Namespace, node.Object.Name)] = true
+
+		// client-go records the events of cluster scoped objects in the default namespace
+		namespace := node.Object.Namespace
+		if namespace == "" {
+			namespace = metav1.NamespaceDefault
+		}
+
+		namespaces[namespace] = true
+
+		for _, child := range node.Children {
+			addNode(child)
+		}
+	}
+
+	if tree != nil {
+		addNode(tree)
+	}
+
+	events := []corev1.Event{}
+
+	for ns := range namespaces {
+		list := corev1.EventList{}
+		if err := cl.List(ctx, &list, client.InNamespace(ns)); err != nil {
+			return nil, fmt.Errorf("could not list events in namespace %s: %w", ns, err)
+		}
+
+		for _, ev := range list.Items {
+			ref := ev.InvolvedObject
+			if involved[involvedKey(ref.Kind, ref.Namespace, ref.Name)] {
+				events = append(events, ev)
+			}
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(&events[i]).Before(eventTime(&events[j]))
+	})
+
+	res := &pb.ListApplicationEventsResponse{Events: []*pb.KubernetesEvent{}}
+
+	for i := range events {
+		res.Events = append(res.Events, mapEvent(&events[i]))
+	}
+
+	return res, nil
+}
+
+func involvedKey(kind, namespace, name string) string {
+	return strings.Join([]string{kind, namespace, name}, "/")
+}
+
+func mapEvent(ev *corev1.Event) *pb.KubernetesEvent {
+	ref := ev.InvolvedObject
+	gvk := ref.GroupVersionKind()
+
+	component := ev.Source.Component
+	if component == "" {
+		component = ev.ReportingController
+	}
+
+	count := ev.Count
+	if ev.Series != nil {
+		count = ev.Series.Count
+	}
+
+	return &pb.KubernetesEvent{
+		Type:    ev.Type,
+		Reason:  ev.Reason,
+		Message: ev.Message,
+		InvolvedObject: &pb.UnstructuredObject{
+			GroupVersionKind: &pb.GroupVersionKind{
+				Group:   gvk.Group,
+				Version: gvk.Version,
+				Kind:    gvk.Kind,
+			},
+			Name:      ref.Name,
+			Namespace: ref.Namespace,
+			Uid:       string(ref.UID),
+		},
+		Component: component,
+		Count:     count,
+		Timestamp: int32(eventTime(ev).Unix()),
+	}
+}
+
+// eventTime returns when an event last happened. The fields set depend on the API version the event was created with.
+func eventTime(ev *corev1.Event) time.Time {
+	switch {
+	case ev.Series != nil && !ev.Series.LastObservedTime.IsZero():
+		return ev.Series.LastObservedTime.Time
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	}
+
+	return ev.CreationTimestamp.Time
+}
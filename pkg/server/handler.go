@@ -36,7 +36,7 @@ type Config struct {
 }
 
 func NewHandlers(ctx context.Context, cfg *Config) (http.Handler, error) {
-	mux := runtime.NewServeMux(middleware.WithGrpcErrorLogging(cfg.AppConfig.Logger))
+	mux := runtime.NewServeMux(middleware.WithGrpcErrorLogging(cfg.AppConfig.Logger), middleware.RecordRPC())
 	httpHandler := middleware.WithLogging(cfg.AppConfig.Logger, mux)
 	httpHandler = middleware.WithProviderToken(cfg.AppConfig.JwtClient, httpHandler, cfg.AppConfig.Logger)
 
@@ -44,11 +44,19 @@ func NewHandlers(ctx context.Context, cfg *Config) (http.Handler, error) {
 		httpHandler = auth.WithAPIAuth(httpHandler, cfg.AuthServer, PublicRoutes)
 	}
 
+	// the requests rejected by the auth middleware are counted and traced too
+	httpHandler = middleware.WithMetrics(httpHandler)
+	httpHandler = middleware.WithTracing(httpHandler)
+
 	appsSrv := NewApplicationsServer(cfg.AppConfig, cfg.AppOptions...)
 	if err := pbapp.RegisterApplicationsHandlerServer(ctx, mux, appsSrv); err != nil {
 		return nil, fmt.Errorf("could not register application: %w", err)
 	}
 
+	if err := registerStreamHandlers(mux, appsSrv); err != nil {
+		return nil, fmt.Errorf("could not register application streams: %w", err)
+	}
+
 	profilesSrv := NewProfilesServer(cfg.ProfilesConfig)
 
 	if err := pbprofiles.RegisterProfilesHandlerServer(ctx, mux, profilesSrv); err != nil {
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/services/check"
+)
+
+func (s *applicationServer) GetSystemHealth(ctx context.Context, msg *pb.GetSystemHealthRequest) (*pb.GetSystemHealthResponse, error) {
+	kubeClient, err := s.kubeGetter.Kube(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kube service: %w", err)
+	}
+
+	namespace := msg.Namespace
+	if namespace == "" {
+		namespace = wego.DefaultNamespace
+	}
+
+	report, err := check.Health(ctx, kubeClient, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("could not get system health: %w", err)
+	}
+
+	res := &pb.GetSystemHealthResponse{
+		Healthy:    report.Healthy(),
+		Components: []*pb.ComponentHealth{},
+		Crds:       []*pb.CrdHealth{},
+	}
+
+	for _, c := range report.Components {
+		res.Components = append(res.Components, &pb.ComponentHealth{
+			Name:          c.Name,
+			Namespace:     c.Namespace,
+			PartOf:        c.PartOf,
+			Version:       c.Version,
+			Replicas:      c.Replicas,
+			ReadyReplicas: c.ReadyReplicas,
+			Restarts:      c.Restarts,
+			Ready:         c.Ready,
+			Message:       c.Message,
+		})
+	}
+
+	for _, c := range report.CRDs {
+		res.Crds = append(res.Crds, &pb.CrdHealth{
+			Name:           c.Name,
+			Version:        c.Version,
+			ServedVersions: c.ServedVersions,
+			Ready:          c.Ready,
+			Message:        c.Message,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/services/logs"
+)
+
+func (s *applicationServer) GetObjectLogs(msg *pb.GetObjectLogsRequest, stream pb.Applications_GetObjectLogsServer) error {
+	ctx := stream.Context()
+
+	kubeClient, err := s.kubeGetter.Kube(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create kube service: %w", err)
+	}
+
+	clientset, err := s.clientsetGetter.Clientset(ctx)
+	if err != nil {
+		return err
+	}
+
+	opts := logs.Options{
+		Pod:       msg.PodName,
+		Container: msg.Container,
+		Follow:    msg.Follow,
+		TailLines: msg.TailLines,
+	}
+
+	err = logs.ApplicationLogs(ctx, kubeClient, clientset, types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace}, opts, func(line logs.Line) error {
+		return stream.Send(&pb.LogLine{
+			PodName:   line.Pod,
+			Namespace: line.Namespace,
+			Container: line.Container,
+			Text:      line.Text,
+		})
+	})
+
+	if apierrors.IsNotFound(err) || errors.Is(err, logs.ErrNoContainers) {
+		return grpcStatus.Errorf(codes.NotFound, "not found: %s", err.Error())
+	}
+
+	return err
+}
@@ -4,14 +4,23 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/metadata"
 
 	"github.com/go-logr/logr"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/metrics"
 	"github.com/weaveworks/weave-gitops/pkg/services/auth"
+	"github.com/weaveworks/weave-gitops/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/oauth2"
 )
 
@@ -25,6 +34,13 @@ func (r *statusRecorder) WriteHeader(status int) {
 	r.ResponseWriter.WriteHeader(status)
 }
 
+// Flush lets the streaming handlers flush their responses through the recorder
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 var RequestOkText = "request success"
 var RequestErrorText = "request error"
 var ServerErrorText = "server error"
@@ -68,6 +84,90 @@ func WithLogging(log logr.Logger, mux *runtime.ServeMux) http.Handler {
 	})
 }
 
+// requestRPC is set by RecordRPC to the RPC a request is routed to
+type requestRPC struct {
+	name string
+}
+
+type rpcKey struct{}
+
+// withRequestRPC returns the request with a requestRPC in its context, reusing the one
+// an outer middleware already set so that the RPC is recorded for all of them.
+func withRequestRPC(r *http.Request) (*http.Request, *requestRPC) {
+	if rpc, ok := r.Context().Value(rpcKey{}).(*requestRPC); ok {
+		return r, rpc
+	}
+
+	rpc := &requestRPC{}
+
+	return r.WithContext(context.WithValue(r.Context(), rpcKey{}, rpc)), rpc
+}
+
+// RecordRPC lets WithMetrics and WithTracing know the RPC each request is routed to.
+// The gateway only tells the handlers the RPC they serve, as it annotates their context.
+func RecordRPC() runtime.ServeMuxOption {
+	return runtime.WithMetadata(func(ctx context.Context, r *http.Request) metadata.MD {
+		if rpc, ok := ctx.Value(rpcKey{}).(*requestRPC); ok {
+			rpc.name, _ = runtime.RPCMethod(ctx)
+		}
+
+		return nil
+	})
+}
+
+// WithMetrics records the number and the duration of the requests, by RPC and status code.
+// The mux must be created with RecordRPC for the RPCs to be known.
+func WithMetrics(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		r, rpc := withRequestRPC(r)
+		recorder := &statusRecorder{
+			ResponseWriter: w,
+			Status:         200,
+		}
+
+		h.ServeHTTP(recorder, r)
+
+		metrics.ObserveRequest(rpc.name, strconv.Itoa(recorder.Status), time.Since(start))
+	})
+}
+
+// WithTracing starts a span for each request, continuing the trace propagated in its headers if any.
+// The span is named after the RPC the request is routed to, the mux must be created with RecordRPC for it to be known.
+func WithTracing(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracing.Tracer().Start(ctx, r.URL.Path,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.method", r.Method),
+				attribute.String("http.target", r.URL.Path),
+			),
+		)
+
+		defer span.End()
+
+		r, rpc := withRequestRPC(r.WithContext(ctx))
+		recorder := &statusRecorder{
+			ResponseWriter: w,
+			Status:         200,
+		}
+
+		h.ServeHTTP(recorder, r)
+
+		if rpc.name != "" {
+			span.SetName(rpc.name)
+			span.SetAttributes(attribute.String("rpc.method", rpc.name))
+		}
+
+		span.SetAttributes(attribute.Int("http.status_code", recorder.Status))
+
+		if recorder.Status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(recorder.Status))
+		}
+	})
+}
+
 type contextVals struct {
 	ProviderToken *oauth2.Token
 }
@@ -101,6 +201,7 @@ func WithProviderToken(jwtClient auth.JWTClient, h http.Handler, log logr.Logger
 		claims, err := jwtClient.VerifyJWT(token)
 		if err != nil {
 			log.Info("could not parse claims: " + err.Error())
+			metrics.AuthFailed(metrics.AuthReasonInvalidProviderToken)
 			// Certain routes do not require a token, so pass the request through.
 			// If the route requires a token and it isn't present,
 			// the next handler will error and return that to the user.
@@ -4,16 +4,26 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 
 	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/weaveworks/weave-gitops/pkg/services/auth"
 	"github.com/weaveworks/weave-gitops/pkg/services/auth/authfakes"
 	"github.com/weaveworks/weave-gitops/pkg/testutils"
+	"github.com/weaveworks/weave-gitops/pkg/tracing"
 	fakelogr "github.com/weaveworks/weave-gitops/pkg/vendorfakes/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/metadata"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
 var (
@@ -101,6 +111,81 @@ var _ = Describe("WithProviderToken", func() {
 	})
 })
 
+var _ = Describe("WithMetrics", func() {
+	It("counts the requests by rpc and status code", func() {
+		mux := runtime.NewServeMux(middleware.RecordRPC())
+
+		Expect(mux.HandlePath(http.MethodGet, "/v1/things", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			_, err := runtime.AnnotateIncomingContext(r.Context(), mux, r, "/test.v1.Things/ListThings")
+			Expect(err).NotTo(HaveOccurred())
+
+			w.WriteHeader(http.StatusTeapot)
+		})).To(Succeed())
+
+		midware := middleware.WithMetrics(mux)
+
+		for _, path := range []string{"/v1/things", "/v1/things", "/v1/other"} {
+			res := httptest.NewRecorder()
+			midware.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "http://www.foo.com"+path, nil))
+		}
+
+		expected := `
+# HELP gitops_server_requests_total Number of requests handled by the gitops-server, by RPC and HTTP status code.
+# TYPE gitops_server_requests_total counter
+gitops_server_requests_total{code="404",rpc="unknown"} 1
+gitops_server_requests_total{code="418",rpc="/test.v1.Things/ListThings"} 2
+`
+		Expect(testutil.GatherAndCompare(ctrlmetrics.Registry, strings.NewReader(expected), "gitops_server_requests_total")).To(Succeed())
+	})
+})
+
+var _ = Describe("WithTracing", func() {
+	var recorder *tracetest.SpanRecorder
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+		_, err := tracing.Setup(context.Background(), tracing.Options{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	})
+
+	It("starts a span named after the rpc, continuing the trace of the request", func() {
+		mux := runtime.NewServeMux(middleware.RecordRPC())
+
+		var handlerSpan trace.SpanContext
+
+		Expect(mux.HandlePath(http.MethodGet, "/v1/things", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			_, err := runtime.AnnotateIncomingContext(r.Context(), mux, r, "/test.v1.Things/ListThings")
+			Expect(err).NotTo(HaveOccurred())
+
+			handlerSpan = trace.SpanContextFromContext(r.Context())
+
+			w.WriteHeader(http.StatusInternalServerError)
+		})).To(Succeed())
+
+		midware := middleware.WithTracing(mux)
+
+		req := httptest.NewRequest(http.MethodGet, "http://www.foo.com/v1/things", nil)
+		req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+		midware.ServeHTTP(httptest.NewRecorder(), req)
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name()).To(Equal("/test.v1.Things/ListThings"))
+		Expect(spans[0].SpanKind()).To(Equal(trace.SpanKindServer))
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		Expect(spans[0].SpanContext().TraceID().String()).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+		Expect(spans[0].Parent().SpanID().String()).To(Equal("00f067aa0ba902b7"))
+		Expect(handlerSpan.SpanID()).To(Equal(spans[0].SpanContext().SpanID()))
+	})
+})
+
 var _ = Describe("ExtractProviderToken", func() {
 	_ = BeforeEach(func() {
 		jwtClient = &authfakes.FakeJWTClient{
@@ -18,6 +18,8 @@ import (
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
 	"github.com/go-logr/logr"
 	"github.com/go-logr/zapr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
@@ -35,6 +37,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/flux"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/models"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
 	"github.com/weaveworks/weave-gitops/pkg/server/internal"
@@ -64,14 +67,18 @@ var (
 type applicationServer struct {
 	pb.UnimplementedApplicationsServer
 
-	factory        services.Factory
-	jwtClient      auth.JWTClient
-	log            logr.Logger
-	ghAuthClient   auth.GithubAuthClient
-	fetcherFactory applicationv2.FetcherFactory
-	glAuthClient   auth.GitlabAuthClient
-	clientGetter   kube.ClientGetter
-	kubeGetter     kube.KubeGetter
+	factory         services.Factory
+	jwtClient       auth.JWTClient
+	log             logr.Logger
+	ghAuthClient    auth.GithubAuthClient
+	fetcherFactory  applicationv2.FetcherFactory
+	glAuthClient    auth.GitlabAuthClient
+	bbsAuthClient   auth.HostTokenValidator
+	giteaAuthClient auth.HostTokenValidator
+	clientGetter    kube.ClientGetter
+	kubeGetter      kube.KubeGetter
+	clientsetGetter kube.ClientsetGetter
+	watcher         *watchHub
 }
 
 // An ApplicationsConfig allows for the customization of an ApplicationsServer.
@@ -83,7 +90,11 @@ type ApplicationsConfig struct {
 	GithubAuthClient auth.GithubAuthClient
 	FetcherFactory   applicationv2.FetcherFactory
 	GitlabAuthClient auth.GitlabAuthClient
-	ClusterConfig    kube.ClusterConfig
+	// BitbucketServerAuthClient and GiteaAuthClient validate the tokens of the self-hosted providers
+	// against the host of the config repository
+	BitbucketServerAuthClient auth.HostTokenValidator
+	GiteaAuthClient           auth.HostTokenValidator
+	ClusterConfig             kube.ClusterConfig
 }
 
 var _ applicationv2.FetcherFactory = &DefaultFetcherFactory{}
@@ -108,10 +119,12 @@ func NewApplicationsServer(cfg *ApplicationsConfig, setters ...ApplicationsOptio
 	configGetter := NewImpersonatingConfigGetter(cfg.ClusterConfig.DefaultConfig, false)
 	clientGetter := kube.NewDefaultClientGetter(configGetter, cfg.ClusterConfig.ClusterName)
 	kubeGetter := kube.NewDefaultKubeGetter(configGetter, cfg.ClusterConfig.ClusterName)
+	clientsetGetter := kube.NewDefaultClientsetGetter(configGetter)
 
 	args := &ApplicationsOptions{
-		ClientGetter: clientGetter,
-		KubeGetter:   kubeGetter,
+		ClientGetter:    clientGetter,
+		KubeGetter:      kubeGetter,
+		ClientsetGetter: clientsetGetter,
 	}
 
 	for _, setter := range setters {
@@ -119,14 +132,18 @@ func NewApplicationsServer(cfg *ApplicationsConfig, setters ...ApplicationsOptio
 	}
 
 	return &applicationServer{
-		jwtClient:      cfg.JwtClient,
-		log:            cfg.Logger,
-		factory:        cfg.Factory,
-		ghAuthClient:   cfg.GithubAuthClient,
-		fetcherFactory: cfg.FetcherFactory,
-		glAuthClient:   cfg.GitlabAuthClient,
-		clientGetter:   args.ClientGetter,
-		kubeGetter:     args.KubeGetter,
+		jwtClient:       cfg.JwtClient,
+		log:             cfg.Logger,
+		factory:         cfg.Factory,
+		ghAuthClient:    cfg.GithubAuthClient,
+		fetcherFactory:  cfg.FetcherFactory,
+		glAuthClient:    cfg.GitlabAuthClient,
+		bbsAuthClient:   cfg.BitbucketServerAuthClient,
+		giteaAuthClient: cfg.GiteaAuthClient,
+		clientGetter:    args.ClientGetter,
+		kubeGetter:      args.KubeGetter,
+		clientsetGetter: args.ClientsetGetter,
+		watcher:         newWatchHub(args.Cache, cfg.ClusterConfig.DefaultConfig, cfg.Logger),
 	}
 }
 
@@ -157,12 +174,14 @@ func DefaultApplicationsConfig() (*ApplicationsConfig, error) {
 	fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
 
 	return &ApplicationsConfig{
-		Logger:           logr,
-		Factory:          services.NewFactory(fluxClient, internal.NewApiLogger(zapLog)),
-		JwtClient:        jwtClient,
-		FetcherFactory:   NewDefaultFetcherFactory(),
-		GithubAuthClient: auth.NewGithubAuthClient(http.DefaultClient),
-		GitlabAuthClient: auth.NewGitlabAuthClient(http.DefaultClient),
+		Logger:                    logr,
+		Factory:                   services.NewFactory(fluxClient, internal.NewApiLogger(zapLog)),
+		JwtClient:                 jwtClient,
+		FetcherFactory:            NewDefaultFetcherFactory(),
+		GithubAuthClient:          auth.NewGithubAuthClient(http.DefaultClient),
+		GitlabAuthClient:          auth.NewGitlabAuthClient(http.DefaultClient),
+		BitbucketServerAuthClient: auth.NewBitbucketServerAuthClient(http.DefaultClient),
+		GiteaAuthClient:           auth.NewGiteaAuthClient(http.DefaultClient),
 		ClusterConfig: kube.ClusterConfig{
 			DefaultConfig: rest,
 			ClusterName:   clusterName,
@@ -258,7 +277,7 @@ func (s *applicationServer) GetApplication(ctx context.Context, msg *pb.GetAppli
 		case *helmv2.HelmRelease:
 			helmRelease = at
 			deploymentType = pb.AutomationKind_Helm
-			reconciledKinds, err = getHelmInventory(at, kubeClient)
+			reconciledKinds, err = getHelmInventory(ctx, at, kubeClient)
 
 			if err != nil {
 				return nil, err
@@ -266,6 +285,17 @@ func (s *applicationServer) GetApplication(ctx context.Context, msg *pb.GetAppli
 		}
 	}
 
+	source := mapSourceSpecToReponse(src)
+	if app.Spec.SourceType == wego.SourceTypeOCI {
+		// The v1beta1 HelmRepository type has no type field, so OCI registries are only known from the app
+		source.Type = pb.Source_OCI
+	}
+
+	dependents, err := getDependents(ctx, kubeClient, app)
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.GetApplicationResponse{Application: &pb.Application{
 		Name:                  app.Name,
 		Namespace:             app.Namespace,
@@ -274,17 +304,36 @@ func (s *applicationServer) GetApplication(ctx context.Context, msg *pb.GetAppli
 		DeploymentType:        deploymentType,
 		Kustomization:         mapKustomizationSpecToResponse(kust),
 		HelmRelease:           mapHelmReleaseSpecToResponse(helmRelease),
-		Source:                mapSourceSpecToReponse(src),
+		Source:                source,
 		ReconciledObjectKinds: reconciledKinds,
+		Conditions:            mapConditions(app.Status.Conditions),
+		SourceRevision:        app.Status.SourceRevision,
+		LastAppliedRevision:   app.Status.LastAppliedRevision,
+		LastReconcileTime:     mapTime(app.Status.LastReconcileTime),
+		Suspended:             app.Status.Suspended,
+		DependsOn:             app.Spec.DependsOn,
+		Dependents:            dependents,
 	}}, nil
 }
 
+// getDependents returns the names of the applications in the same namespace depending on an application
+func getDependents(ctx context.Context, kubeClient kube.Kube, application *wego.Application) ([]string, error) {
+	apps, err := kubeClient.GetApplications(ctx, application.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("could not list applications in namespace %s: %w", application.Namespace, err)
+	}
+
+	return app.Dependents(apps, application.Name), nil
+}
+
 func (s *applicationServer) AddApplication(ctx context.Context, msg *pb.AddApplicationRequest) (*pb.AddApplicationResponse, error) {
 	token, err := middleware.ExtractProviderToken(ctx)
 	if err != nil {
 		return nil, grpcStatus.Errorf(codes.Unauthenticated, "token error: %s", err.Error())
 	}
 
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("app.name", msg.Name), attribute.String("app.namespace", msg.Namespace))
+
 	kubeClient, err := s.kubeGetter.Kube(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create kube service: %w", err)
@@ -311,14 +360,45 @@ func (s *applicationServer) AddApplication(ctx context.Context, msg *pb.AddAppli
 	}
 
 	params := app.AddParams{
-		Name:             msg.Name,
-		Namespace:        msg.Namespace,
-		Url:              appUrl.String(),
-		Path:             msg.Path,
-		GitProviderToken: token.AccessToken,
-		Branch:           msg.Branch,
-		AutoMerge:        msg.AutoMerge,
-		ConfigRepo:       configRepo.String(),
+		Name:               msg.Name,
+		Namespace:          msg.Namespace,
+		Url:                appUrl.String(),
+		Path:               msg.Path,
+		GitProviderToken:   token.AccessToken,
+		Branch:             msg.Branch,
+		AutoMerge:          msg.AutoMerge,
+		ConfigRepo:         configRepo.String(),
+		Prune:              msg.Prune,
+		Wait:               msg.Wait,
+		HealthChecks:       msg.HealthChecks,
+		HelmValuesYaml:     msg.HelmValues,
+		HelmSetValues:      msg.HelmSetValues,
+		HelmValuesFrom:     msg.HelmValuesFrom,
+		Substitute:         msg.Substitute,
+		SubstituteFrom:     msg.SubstituteFrom,
+		DependsOn:          msg.DependsOn,
+		DecryptionProvider: msg.DecryptionProvider,
+		DecryptionSecret:   msg.DecryptionSecret,
+	}
+
+	if msg.DeploymentType == pb.AutomationKind_Helm {
+		params.DeploymentType = string(wego.DeploymentTypeHelm)
+	}
+
+	if params.SourceInterval, err = parseDuration("source_interval", msg.SourceInterval); err != nil {
+		return nil, err
+	}
+
+	if params.Interval, err = parseDuration("interval", msg.Interval); err != nil {
+		return nil, err
+	}
+
+	if params.Timeout, err = parseDuration("timeout", msg.Timeout); err != nil {
+		return nil, err
+	}
+
+	if params.PullRequestOptions, params.CommitTemplates, err = pullRequestOptions(msg.PullRequest); err != nil {
+		return nil, err
 	}
 
 	client := internal.NewGitProviderClient(token.AccessToken)
@@ -345,6 +425,35 @@ func (s *applicationServer) AddApplication(ctx context.Context, msg *pb.AddAppli
 	}, nil
 }
 
+// parseDuration parses an optional duration field of a request
+func parseDuration(field, value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, grpcStatus.Errorf(codes.InvalidArgument, "unable to parse %s %q: %s", field, value, err)
+	}
+
+	return d, nil
+}
+
+// pullRequestOptions returns the options of the pull request of a request, and the commit templates of its body template
+func pullRequestOptions(opts *pb.PullRequestOptions) (gitproviders.PullRequestOptions, models.CommitTemplates, error) {
+	templates := models.CommitTemplates{PullRequestBody: opts.GetBodyTemplate()}
+	if err := templates.Validate(); err != nil {
+		return gitproviders.PullRequestOptions{}, models.CommitTemplates{}, grpcStatus.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return gitproviders.PullRequestOptions{
+		Reviewers: opts.GetReviewers(),
+		Labels:    opts.GetLabels(),
+		Assignees: opts.GetAssignees(),
+		Draft:     opts.GetDraft(),
+	}, templates, nil
+}
+
 func (s *applicationServer) RemoveApplication(ctx context.Context, msg *pb.RemoveApplicationRequest) (*pb.RemoveApplicationResponse, error) {
 	token, err := middleware.ExtractProviderToken(ctx)
 	if err != nil {
@@ -368,11 +477,7 @@ func (s *applicationServer) RemoveApplication(ctx context.Context, msg *pb.Remov
 
 	client := internal.NewGitProviderClient(token.AccessToken)
 
-	gitClient, gitProvider, err := s.factory.GetGitClients(ctx, kubeClient, client, services.GitConfigParams{
-		URL:        application.Spec.URL,
-		ConfigRepo: application.Spec.ConfigRepo,
-		Namespace:  msg.Namespace,
-	})
+	gitClient, gitProvider, err := s.factory.GetGitClients(ctx, kubeClient, client, services.NewGitConfigParamsFromApp(application, false))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get git clients: %w", err)
 	}
@@ -383,6 +488,11 @@ func (s *applicationServer) RemoveApplication(ctx context.Context, msg *pb.Remov
 		DryRun:           false,
 		GitProviderToken: token.AccessToken,
 		AutoMerge:        msg.AutoMerge,
+		Force:            msg.Force,
+	}
+
+	if removeParams.PullRequestOptions, removeParams.CommitTemplates, err = pullRequestOptions(msg.PullRequest); err != nil {
+		return nil, err
 	}
 
 	if err := appSrv.Remove(gitClient, gitProvider, removeParams); err != nil {
@@ -392,6 +502,67 @@ func (s *applicationServer) RemoveApplication(ctx context.Context, msg *pb.Remov
 	return &pb.RemoveApplicationResponse{Success: true, RepoUrl: application.Spec.ConfigRepo}, nil
 }
 
+func (s *applicationServer) UpdateApplication(ctx context.Context, msg *pb.UpdateApplicationRequest) (*pb.UpdateApplicationResponse, error) {
+	token, err := middleware.ExtractProviderToken(ctx)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Unauthenticated, "token error: %s", err.Error())
+	}
+
+	updateParams := app.UpdateParams{
+		Name:                       msg.Name,
+		Namespace:                  msg.Namespace,
+		Branch:                     msg.Branch,
+		Path:                       msg.Path,
+		Chart:                      msg.Chart,
+		HelmReleaseTargetNamespace: msg.HelmTargetNamespace,
+		Prune:                      msg.Prune,
+		AutoMerge:                  msg.AutoMerge,
+	}
+
+	if updateParams.SourceInterval, err = parseDuration("source_interval", msg.SourceInterval); err != nil {
+		return nil, err
+	}
+
+	if updateParams.Interval, err = parseDuration("interval", msg.Interval); err != nil {
+		return nil, err
+	}
+
+	if updateParams.Timeout, err = parseDuration("timeout", msg.Timeout); err != nil {
+		return nil, err
+	}
+
+	kubeClient, err := s.kubeGetter.Kube(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kube service: %w", err)
+	}
+
+	application, err := kubeClient.GetApplication(ctx, types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace})
+	if err != nil {
+		return nil, fmt.Errorf("could not get application %q: %w", msg.Name, err)
+	}
+
+	appSrv, err := s.factory.GetAppService(ctx, kubeClient)
+	if err != nil {
+		return nil, fmt.Errorf("could not create app service: %w", err)
+	}
+
+	client := internal.NewGitProviderClient(token.AccessToken)
+
+	gitClient, gitProvider, err := s.factory.GetGitClients(ctx, kubeClient, client, services.NewGitConfigParamsFromApp(application, false))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git clients: %w", err)
+	}
+
+	if err := appSrv.Update(gitClient, gitProvider, updateParams); err != nil {
+		return nil, fmt.Errorf("error updating app: %w", err)
+	}
+
+	return &pb.UpdateApplicationResponse{
+		Success:     true,
+		Application: &pb.Application{Name: msg.Name, Namespace: msg.Namespace},
+	}, nil
+}
+
 func (s *applicationServer) SyncApplication(ctx context.Context, msg *pb.SyncApplicationRequest) (*pb.SyncApplicationResponse, error) {
 	kubeClient, err := s.kubeGetter.Kube(ctx)
 	if err != nil {
@@ -438,11 +609,12 @@ func (s *applicationServer) ListCommits(ctx context.Context, msg *pb.ListCommits
 	}
 
 	params := app.CommitParams{
-		Name:             msg.Name,
-		Namespace:        msg.Namespace,
-		GitProviderToken: providerToken.AccessToken,
-		PageSize:         int(msg.PageSize),
-		PageToken:        pageToken,
+		Name:              msg.Name,
+		Namespace:         msg.Namespace,
+		GitProviderToken:  providerToken.AccessToken,
+		PageSize:          int(msg.PageSize),
+		PageToken:         pageToken,
+		IncludeAutomation: msg.IncludeAutomation,
 	}
 
 	application := &wego.Application{}
@@ -466,30 +638,33 @@ func (s *applicationServer) ListCommits(ctx context.Context, msg *pb.ListCommits
 		return nil, fmt.Errorf("failed to get git clients: %w", err)
 	}
 
-	commits, err := appService.GetCommits(gitProvider, params, application)
+	history, err := appService.GetCommitHistory(gitProvider, params, application)
 	if err != nil {
 		return nil, err
 	}
 
 	list := []*pb.Commit{}
 
-	for _, commit := range commits {
-		c := commit.Get()
-
+	for _, c := range history.Commits {
 		list = append(list, &pb.Commit{
-			Author:  c.Author,
-			Message: utils.CleanCommitMessage(c.Message),
-			Hash:    utils.ConvertCommitHashToShort(c.Sha),
-			Date:    utils.CleanCommitCreatedAt(c.CreatedAt),
-			Url:     utils.ConvertCommitURLToShort(c.URL),
+			Author:     c.Author,
+			Message:    utils.CleanCommitMessage(c.Message),
+			Hash:       utils.ConvertCommitHashToShort(c.Sha),
+			Date:       utils.CleanCommitCreatedAt(c.CreatedAt),
+			Url:        utils.ConvertCommitURLToShort(c.URL),
+			Repository: c.Repository,
+			Applied:    c.Applied,
 		})
 	}
 
+	// the source and automation repositories are paged separately, the next page is the next page of each of them
 	nextPageToken := int32(pageToken + 1)
 
 	return &pb.ListCommitsResponse{
-		Commits:       list,
-		NextPageToken: nextPageToken,
+		Commits:                       list,
+		NextPageToken:                 nextPageToken,
+		LastAppliedRevision:           history.SourceRevision,
+		AutomationLastAppliedRevision: history.AutomationRevision,
 	}, nil
 }
 
@@ -704,7 +879,7 @@ func (s *applicationServer) ValidateProviderToken(ctx context.Context, msg *pb.V
 		return nil, grpcStatus.Error(codes.Unauthenticated, err.Error())
 	}
 
-	v, err := findValidator(msg.Provider, s)
+	v, err := findValidator(ctx, msg.Provider, s)
 	if err != nil {
 		return nil, grpcStatus.Error(codes.InvalidArgument, err.Error())
 	}
@@ -799,6 +974,20 @@ func mapSourceSpecToReponse(src client.Object) *pb.Source {
 			source.Timeout = st.Spec.Timeout.Duration.String()
 		}
 
+		source.Conditions = mapConditions(st.Status.Conditions)
+	case *sourcev1.Bucket:
+		source.Name = st.Name
+		source.Namespace = st.Namespace
+		source.Url = st.Spec.Endpoint
+		source.BucketName = st.Spec.BucketName
+		source.Type = pb.Source_Bucket
+		source.Interval = st.Spec.Interval.Duration.String()
+		source.Suspend = st.Spec.Suspend
+
+		if st.Spec.Timeout != nil {
+			source.Timeout = st.Spec.Timeout.Duration.String()
+		}
+
 		source.Conditions = mapConditions(st.Status.Conditions)
 	}
 
@@ -820,8 +1009,10 @@ func findFluxObjects(app *wego.Application) (client.Object, client.Object, error
 	switch st {
 	case wego.SourceTypeGit:
 		src = &sourcev1.GitRepository{}
-	case wego.SourceTypeHelm:
+	case wego.SourceTypeHelm, wego.SourceTypeOCI:
 		src = &sourcev1.HelmRepository{}
+	case wego.SourceTypeBucket:
+		src = &sourcev1.Bucket{}
 	}
 
 	if src == nil {
@@ -867,24 +1058,75 @@ func mapConditions(conditions []metav1.Condition) []*pb.Condition {
 	return out
 }
 
+// Convert an optional k8s timestamp to the unix seconds used in protobuf responses
+func mapTime(t *metav1.Time) int32 {
+	if t == nil {
+		return 0
+	}
+
+	return int32(t.Unix())
+}
+
 func toProtoProvider(p gitproviders.GitProviderName) pb.GitProvider {
 	switch p {
 	case gitproviders.GitProviderGitHub:
 		return pb.GitProvider_GitHub
 	case gitproviders.GitProviderGitLab:
 		return pb.GitProvider_GitLab
+	case gitproviders.GitProviderBitbucketServer:
+		return pb.GitProvider_BitbucketServer
+	case gitproviders.GitProviderGitea:
+		return pb.GitProvider_Gitea
 	}
 
 	return pb.GitProvider_Unknown
 }
 
-func findValidator(provider pb.GitProvider, s *applicationServer) (auth.ProviderTokenValidator, error) {
+func findValidator(ctx context.Context, provider pb.GitProvider, s *applicationServer) (auth.ProviderTokenValidator, error) {
 	switch provider {
 	case pb.GitProvider_GitHub:
 		return s.ghAuthClient, nil
 	case pb.GitProvider_GitLab:
 		return s.glAuthClient, nil
+	case pb.GitProvider_BitbucketServer:
+		return s.hostValidator(ctx, gitproviders.GitProviderBitbucketServer, s.bbsAuthClient)
+	case pb.GitProvider_Gitea:
+		return s.hostValidator(ctx, gitproviders.GitProviderGitea, s.giteaAuthClient)
 	}
 
 	return nil, fmt.Errorf("unknown git provider %s", provider)
 }
+
+// hostValidator validates the tokens of a self-hosted provider against the host of the config repository,
+// when the provider hosts it. The config repository is read from the wego config of the default namespace.
+func (s *applicationServer) hostValidator(ctx context.Context, provider gitproviders.GitProviderName, validator auth.HostTokenValidator) (auth.ProviderTokenValidator, error) {
+	kubeClient, err := s.kubeGetter.Kube(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kube service: %w", err)
+	}
+
+	config, err := kubeClient.GetWegoConfig(ctx, wego.DefaultNamespace)
+	if err != nil && !errors.Is(err, kube.ErrWegoConfigNotFound) {
+		return nil, fmt.Errorf("failed getting wego config: %w", err)
+	}
+
+	hostname := ""
+
+	if config != nil && config.ConfigRepo != "" {
+		configRepo, err := gitproviders.NewRepoURL(config.ConfigRepo)
+		if err == nil && configRepo.Provider() == provider {
+			hostname = configRepo.URL().Host
+		}
+	}
+
+	return hostTokenValidator{validator: validator, hostname: hostname}, nil
+}
+
+type hostTokenValidator struct {
+	validator auth.HostTokenValidator
+	hostname  string
+}
+
+func (v hostTokenValidator) ValidateToken(ctx context.Context, token string) error {
+	return v.validator.ValidateToken(ctx, v.hostname, token)
+}
@@ -1,12 +1,17 @@
 package server
 
-import "github.com/weaveworks/weave-gitops/pkg/kube"
+import (
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
 
 // ApplicationsOptions includes all the options that can be set for an
 // ApplicationsServer.
 type ApplicationsOptions struct {
-	ClientGetter kube.ClientGetter
-	KubeGetter   kube.KubeGetter
+	ClientGetter    kube.ClientGetter
+	KubeGetter      kube.KubeGetter
+	ClientsetGetter kube.ClientsetGetter
+	Cache           cache.Cache
 }
 
 // ApplicationsOption defines the signature of a function that can be used
@@ -26,3 +31,19 @@ func WithKubeGetter(kubeGetter kube.KubeGetter) ApplicationsOption {
 		args.KubeGetter = kubeGetter
 	}
 }
+
+// WithClientsetGetter allows for setting a ClientsetGetter.
+func WithClientsetGetter(clientsetGetter kube.ClientsetGetter) ApplicationsOption {
+	return func(args *ApplicationsOptions) {
+		args.ClientsetGetter = clientsetGetter
+	}
+}
+
+// WithCache allows for setting the informers cache the watches of all namespaces read from,
+// instead of the informers started for the namespace of each watch.
+// The cache must be started and stopped by the caller.
+func WithCache(c cache.Cache) ApplicationsOption {
+	return func(args *ApplicationsOptions) {
+		args.Cache = c
+	}
+}
@@ -1,10 +1,12 @@
 package server
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"io/ioutil"
 	"net/http"
@@ -25,6 +27,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
@@ -37,6 +40,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/osys/osysfakes"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
 	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
+	"github.com/weaveworks/weave-gitops/pkg/services"
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
 	"github.com/weaveworks/weave-gitops/pkg/services/applicationv2"
 	"github.com/weaveworks/weave-gitops/pkg/services/applicationv2/applicationv2fakes"
@@ -49,11 +53,16 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -125,6 +134,39 @@ var _ = Describe("ApplicationsServer", func() {
 			Expect(resp.Application.Name).To(Equal(name))
 		})
 
+		It("returns the status reported by the application controller", func() {
+			lastReconcile := metav1.NewTime(time.Date(2021, time.November, 10, 23, 0, 0, 0, time.UTC))
+
+			app.Status = wego.ApplicationStatus{
+				Conditions: []metav1.Condition{{
+					Type:               wego.ReadyCondition,
+					Status:             metav1.ConditionTrue,
+					Reason:             "ReconciliationSucceeded",
+					Message:            "Applied revision: main/abc123",
+					LastTransitionTime: lastReconcile,
+				}},
+				SourceRevision:      "main/abc123",
+				LastAppliedRevision: "main/abc123",
+				LastReconcileTime:   &lastReconcile,
+				Suspended:           true,
+			}
+			Expect(k8sClient.Status().Update(ctx, app)).Should(Succeed())
+
+			resp, err := appsClient.GetApplication(context.Background(), &pb.GetApplicationRequest{
+				Name:      name,
+				Namespace: namespace.Name,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(resp.Application.Conditions).To(HaveLen(1))
+			Expect(resp.Application.Conditions[0].Type).To(Equal(wego.ReadyCondition))
+			Expect(resp.Application.Conditions[0].Status).To(Equal("True"))
+			Expect(resp.Application.SourceRevision).To(Equal("main/abc123"))
+			Expect(resp.Application.LastAppliedRevision).To(Equal("main/abc123"))
+			Expect(resp.Application.LastReconcileTime).To(Equal(int32(lastReconcile.Unix())))
+			Expect(resp.Application.Suspended).To(BeTrue())
+		})
+
 		Describe("fetches the application source", func() {
 			It("fetches a git repository", func() {
 				git := &sourcev1.GitRepository{
@@ -467,6 +509,688 @@ var _ = Describe("ApplicationsServer", func() {
 		})
 	})
 
+	Describe("GetApplicationTree", func() {
+		It("returns the objects reconciled by an application with the objects they own", func() {
+			ctx := context.Background()
+			name := "my-app-" + rand.String(5)
+
+			app := &wego.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace.Name,
+				},
+				Spec: wego.ApplicationSpec{
+					SourceType:     wego.SourceTypeGit,
+					DeploymentType: wego.DeploymentTypeKustomize,
+				},
+			}
+			Expect(k8sClient.Create(ctx, app)).Should(Succeed())
+
+			kustomization := &kustomizev2.Kustomization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace.Name,
+				},
+				Spec: kustomizev2.KustomizationSpec{
+					SourceRef: kustomizev2.CrossNamespaceSourceReference{
+						Kind: sourcev1.GitRepositoryKind,
+						Name: name,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, kustomization)).Should(Succeed())
+
+			kustomization.Status.Inventory = &kustomizev2.ResourceInventory{
+				Entries: []kustomizev2.ResourceRef{{
+					Version: "v1",
+					ID:      namespace.Name + "_my-deployment_apps_Deployment",
+				}},
+			}
+			Expect(k8sClient.Status().Update(ctx, kustomization)).Should(Succeed())
+
+			labels := map[string]string{"app": name}
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-deployment",
+					Namespace: namespace.Name,
+					Labels: map[string]string{
+						KustomizeNameKey:      name,
+						KustomizeNamespaceKey: namespace.Name,
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: labels},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: labels},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{
+								Name:  "nginx",
+								Image: "nginx",
+							}},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, deployment)).Should(Succeed())
+
+			ownedBy := func(parent client.Object, kind string) []metav1.OwnerReference {
+				return []metav1.OwnerReference{{
+					UID:        parent.GetUID(),
+					APIVersion: "apps/v1",
+					Kind:       kind,
+					Name:       parent.GetName(),
+				}}
+			}
+
+			rs := &appsv1.ReplicaSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "my-deployment-123abcd",
+					Namespace:       namespace.Name,
+					OwnerReferences: ownedBy(deployment, "Deployment"),
+				},
+				Spec: appsv1.ReplicaSetSpec{
+					Template: deployment.Spec.Template,
+					Selector: deployment.Spec.Selector,
+				},
+			}
+			Expect(k8sClient.Create(ctx, rs)).Should(Succeed())
+
+			otherRS := rs.DeepCopy()
+			otherRS.Name = "other-123abcd"
+			otherRS.ResourceVersion = ""
+			otherRS.UID = ""
+			otherRS.OwnerReferences = nil
+			Expect(k8sClient.Create(ctx, otherRS)).Should(Succeed())
+
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "my-deployment-123abcd-xyz12",
+					Namespace:       namespace.Name,
+					OwnerReferences: ownedBy(rs, "ReplicaSet"),
+				},
+				Spec: deployment.Spec.Template.Spec,
+			}
+			Expect(k8sClient.Create(ctx, pod)).Should(Succeed())
+
+			res, err := appsClient.GetApplicationTree(ctx, &pb.GetApplicationTreeRequest{Name: name, Namespace: namespace.Name})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(res.Tree.Object.GroupVersionKind.Kind).To(Equal(kustomizev2.KustomizationKind))
+			Expect(res.Tree.Object.Name).To(Equal(name))
+			Expect(res.Tree.Children).To(HaveLen(1))
+
+			deploymentNode := res.Tree.Children[0]
+			Expect(deploymentNode.Object.Name).To(Equal(deployment.Name))
+			Expect(deploymentNode.Object.Status).NotTo(BeEmpty())
+			Expect(deploymentNode.Children).To(HaveLen(1))
+
+			rsNode := deploymentNode.Children[0]
+			Expect(rsNode.Object.GroupVersionKind.Kind).To(Equal("ReplicaSet"))
+			Expect(rsNode.Object.Name).To(Equal(rs.Name))
+			Expect(rsNode.Children).To(HaveLen(1))
+
+			podNode := rsNode.Children[0]
+			Expect(podNode.Object.GroupVersionKind.Kind).To(Equal("Pod"))
+			Expect(podNode.Object.Name).To(Equal(pod.Name))
+			Expect(podNode.Children).To(BeEmpty())
+		})
+
+		It("returns an empty tree until the application is deployed", func() {
+			ctx := context.Background()
+			name := "my-app-" + rand.String(5)
+
+			app := &wego.Application{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name}}
+			Expect(k8sClient.Create(ctx, app)).Should(Succeed())
+
+			res, err := appsClient.GetApplicationTree(ctx, &pb.GetApplicationTreeRequest{Name: name, Namespace: namespace.Name})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Tree).To(BeNil())
+		})
+
+		It("fails when the application does not exist", func() {
+			_, err := appsClient.GetApplicationTree(context.Background(), &pb.GetApplicationTreeRequest{Name: "missing", Namespace: namespace.Name})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("ListApplicationEvents", func() {
+		It("returns the events of the flux objects of an application and of the objects it reconciled", func() {
+			ctx := context.Background()
+			name := "my-app-" + rand.String(5)
+
+			app := &wego.Application{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name},
+				Spec: wego.ApplicationSpec{
+					SourceType:     wego.SourceTypeGit,
+					DeploymentType: wego.DeploymentTypeKustomize,
+				},
+			}
+			Expect(k8sClient.Create(ctx, app)).Should(Succeed())
+
+			kustomization := &kustomizev2.Kustomization{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name},
+				Spec: kustomizev2.KustomizationSpec{
+					SourceRef: kustomizev2.CrossNamespaceSourceReference{
+						Kind: sourcev1.GitRepositoryKind,
+						Name: name,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, kustomization)).Should(Succeed())
+
+			kustomization.Status.Inventory = &kustomizev2.ResourceInventory{
+				Entries: []kustomizev2.ResourceRef{{
+					Version: "v1",
+					ID:      namespace.Name + "_my-configmap__ConfigMap",
+				}},
+			}
+			Expect(k8sClient.Status().Update(ctx, kustomization)).Should(Succeed())
+
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-configmap",
+					Namespace: namespace.Name,
+					Labels: map[string]string{
+						KustomizeNameKey:      name,
+						KustomizeNamespaceKey: namespace.Name,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, cm)).Should(Succeed())
+
+			now := time.Now()
+
+			event := func(kind, objectName, reason string, at time.Time) *corev1.Event {
+				return &corev1.Event{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      objectName + "." + rand.String(5),
+						Namespace: namespace.Name,
+					},
+					InvolvedObject: corev1.ObjectReference{Kind: kind, Name: objectName, Namespace: namespace.Name},
+					Type:           corev1.EventTypeWarning,
+					Reason:         reason,
+					Message:        reason + " happened",
+					Source:         corev1.EventSource{Component: "kustomize-controller"},
+					Count:          1,
+					LastTimestamp:  metav1.NewTime(at),
+				}
+			}
+
+			for _, ev := range []*corev1.Event{
+				event("ConfigMap", "my-configmap", "Updated", now),
+				event(sourcev1.GitRepositoryKind, name, "GitOperationFailed", now.Add(-2*time.Minute)),
+				event(kustomizev2.KustomizationKind, name, "ReconciliationFailed", now.Add(-time.Minute)),
+				event("ConfigMap", "other-configmap", "Updated", now),
+			} {
+				Expect(k8sClient.Create(ctx, ev)).Should(Succeed())
+			}
+
+			res, err := appsClient.ListApplicationEvents(ctx, &pb.ListApplicationEventsRequest{Name: name, Namespace: namespace.Name})
+			Expect(err).NotTo(HaveOccurred())
+
+			reasons := []string{}
+
+			for _, ev := range res.Events {
+				reasons = append(reasons, ev.InvolvedObject.GroupVersionKind.Kind+"/"+ev.Reason)
+			}
+
+			Expect(reasons).To(Equal([]string{
+				"GitRepository/GitOperationFailed",
+				"Kustomization/ReconciliationFailed",
+				"ConfigMap/Updated",
+			}))
+
+			Expect(res.Events[2].InvolvedObject.Name).To(Equal("my-configmap"))
+			Expect(res.Events[2].Type).To(Equal(corev1.EventTypeWarning))
+			Expect(res.Events[2].Message).To(Equal("Updated happened"))
+			Expect(res.Events[2].Component).To(Equal("kustomize-controller"))
+			Expect(res.Events[2].Count).To(Equal(int32(1)))
+		})
+
+		It("returns the events of the cluster scoped objects an application reconciled from the default namespace", func() {
+			ctx := context.Background()
+			name := "my-app-" + rand.String(5)
+
+			app := &wego.Application{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name},
+				Spec: wego.ApplicationSpec{
+					SourceType:     wego.SourceTypeGit,
+					DeploymentType: wego.DeploymentTypeKustomize,
+				},
+			}
+			Expect(k8sClient.Create(ctx, app)).Should(Succeed())
+
+			kustomization := &kustomizev2.Kustomization{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name},
+				Spec: kustomizev2.KustomizationSpec{
+					SourceRef: kustomizev2.CrossNamespaceSourceReference{
+						Kind: sourcev1.GitRepositoryKind,
+						Name: name,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, kustomization)).Should(Succeed())
+
+			kustomization.Status.Inventory = &kustomizev2.ResourceInventory{
+				Entries: []kustomizev2.ResourceRef{{
+					Version: "v1",
+					ID:      "_" + name + "__Namespace",
+				}},
+			}
+			Expect(k8sClient.Status().Update(ctx, kustomization)).Should(Succeed())
+
+			Expect(k8sClient.Create(ctx, &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+					Labels: map[string]string{
+						KustomizeNameKey:      name,
+						KustomizeNamespaceKey: namespace.Name,
+					},
+				},
+			})).Should(Succeed())
+
+			Expect(k8sClient.Create(ctx, &corev1.Event{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name + "." + rand.String(5),
+					Namespace: metav1.NamespaceDefault,
+				},
+				InvolvedObject: corev1.ObjectReference{Kind: "Namespace", Name: name},
+				Type:           corev1.EventTypeNormal,
+				Reason:         "Created",
+				Count:          1,
+				LastTimestamp:  metav1.Now(),
+			})).Should(Succeed())
+
+			res, err := appsClient.ListApplicationEvents(ctx, &pb.ListApplicationEventsRequest{Name: name, Namespace: namespace.Name})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Events).To(HaveLen(1))
+			Expect(res.Events[0].InvolvedObject.Name).To(Equal(name))
+			Expect(res.Events[0].Reason).To(Equal("Created"))
+		})
+
+		It("returns the events of the source until the application is deployed", func() {
+			ctx := context.Background()
+			name := "my-app-" + rand.String(5)
+
+			app := &wego.Application{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name},
+				Spec: wego.ApplicationSpec{
+					SourceType:     wego.SourceTypeGit,
+					DeploymentType: wego.DeploymentTypeKustomize,
+				},
+			}
+			Expect(k8sClient.Create(ctx, app)).Should(Succeed())
+
+			Expect(k8sClient.Create(ctx, &corev1.Event{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name + "." + rand.String(5),
+					Namespace: namespace.Name,
+				},
+				InvolvedObject: corev1.ObjectReference{Kind: sourcev1.GitRepositoryKind, Name: name, Namespace: namespace.Name},
+				Type:           corev1.EventTypeWarning,
+				Reason:         "GitOperationFailed",
+				Count:          1,
+				LastTimestamp:  metav1.Now(),
+			})).Should(Succeed())
+
+			res, err := appsClient.ListApplicationEvents(ctx, &pb.ListApplicationEventsRequest{Name: name, Namespace: namespace.Name})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Events).To(HaveLen(1))
+			Expect(res.Events[0].Reason).To(Equal("GitOperationFailed"))
+		})
+
+		It("fails when the application does not exist", func() {
+			_, err := appsClient.ListApplicationEvents(context.Background(), &pb.ListApplicationEventsRequest{Name: "missing", Namespace: namespace.Name})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("GetObjectLogs", func() {
+		It("streams the logs of the pods of an application", func() {
+			ctx := context.Background()
+			name := "my-app-" + rand.String(5)
+
+			app := &wego.Application{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name},
+				Spec: wego.ApplicationSpec{
+					SourceType:     wego.SourceTypeGit,
+					DeploymentType: wego.DeploymentTypeKustomize,
+				},
+			}
+			Expect(k8sClient.Create(ctx, app)).Should(Succeed())
+
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-pod",
+					Namespace: namespace.Name,
+					Labels: map[string]string{
+						KustomizeNameKey:      name,
+						KustomizeNamespaceKey: namespace.Name,
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "nginx", Image: "nginx"}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, pod)).Should(Succeed())
+
+			stream, err := appsClient.GetObjectLogs(ctx, &pb.GetObjectLogsRequest{Name: name, Namespace: namespace.Name, TailLines: 100})
+			Expect(err).NotTo(HaveOccurred())
+
+			line, err := stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(line.PodName).To(Equal("my-pod"))
+			Expect(line.Namespace).To(Equal(namespace.Name))
+			Expect(line.Container).To(Equal("nginx"))
+			Expect(line.Text).To(Equal("fake logs"))
+
+			_, err = stream.Recv()
+			Expect(err).To(Equal(io.EOF))
+		})
+
+		It("fails when the application does not exist", func() {
+			stream, err := appsClient.GetObjectLogs(context.Background(), &pb.GetObjectLogsRequest{Name: "missing", Namespace: namespace.Name})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = stream.Recv()
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("GetSystemHealth", func() {
+		It("reports the components missing from a namespace", func() {
+			res, err := appsClient.GetSystemHealth(context.Background(), &pb.GetSystemHealthRequest{Namespace: namespace.Name})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Healthy).To(BeFalse())
+
+			var wegoApp *pb.ComponentHealth
+
+			for _, c := range res.Components {
+				if c.Name == "wego-app" {
+					wegoApp = c
+				}
+			}
+
+			Expect(wegoApp).NotTo(BeNil())
+			Expect(wegoApp.Namespace).To(Equal(namespace.Name))
+			Expect(wegoApp.Ready).To(BeFalse())
+			Expect(wegoApp.Message).To(Equal("deployment not found"))
+			Expect(res.Crds).NotTo(BeEmpty())
+		})
+	})
+
+	Describe("watches", func() {
+		var (
+			ctx    context.Context
+			cancel context.CancelFunc
+			name   string
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			name = "my-app-" + rand.String(5)
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		receive := func(stream interface {
+			Recv() (*pb.ApplicationEvent, error)
+		}) chan *pb.ApplicationEvent {
+			events := make(chan *pb.ApplicationEvent, 100)
+
+			go func() {
+				defer close(events)
+
+				for {
+					ev, err := stream.Recv()
+					if err != nil {
+						return
+					}
+
+					events <- ev
+				}
+			}()
+
+			return events
+		}
+
+		// waitFor skips the events about other objects until the expected one is received
+		waitFor := func(events chan *pb.ApplicationEvent, eventType pb.ApplicationEvent_Type, kind, objName string) *pb.ApplicationEvent {
+			var found *pb.ApplicationEvent
+
+			Eventually(func() bool {
+				select {
+				case ev, ok := <-events:
+					if ok && ev.Type == eventType && ev.Object.GroupVersionKind.Kind == kind && ev.Object.Name == objName {
+						found = ev
+					}
+				default:
+				}
+
+				return found != nil
+			}, "10s").Should(BeTrue())
+
+			return found
+		}
+
+		newApp := func(appName string) *wego.Application {
+			return &wego.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      appName,
+					Namespace: namespace.Name,
+				},
+				Spec: wego.ApplicationSpec{
+					SourceType:     wego.SourceTypeGit,
+					DeploymentType: wego.DeploymentTypeKustomize,
+				},
+			}
+		}
+
+		Describe("WatchApplication", func() {
+			It("streams the changes to an application, its flux objects and the objects it reconciled", func() {
+				Expect(k8sClient.Create(ctx, newApp(name))).Should(Succeed())
+
+				kustomization := &kustomizev2.Kustomization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: namespace.Name,
+					},
+					Spec: kustomizev2.KustomizationSpec{
+						SourceRef: kustomizev2.CrossNamespaceSourceReference{
+							Kind: sourcev1.GitRepositoryKind,
+							Name: name,
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, kustomization)).Should(Succeed())
+
+				kustomization.Status.Inventory = &kustomizev2.ResourceInventory{
+					Entries: []kustomizev2.ResourceRef{{
+						Version: "v1",
+						ID:      namespace.Name + "_my-config__ConfigMap",
+					}},
+				}
+				Expect(k8sClient.Status().Update(ctx, kustomization)).Should(Succeed())
+
+				reconciled := &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-config",
+						Namespace: namespace.Name,
+						Labels: map[string]string{
+							KustomizeNameKey:      name,
+							KustomizeNamespaceKey: namespace.Name,
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, reconciled)).Should(Succeed())
+
+				unrelated := &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "unrelated-config",
+						Namespace: namespace.Name,
+					},
+				}
+				Expect(k8sClient.Create(ctx, unrelated)).Should(Succeed())
+
+				stream, err := appsClient.WatchApplication(ctx, &pb.WatchApplicationRequest{Name: name, Namespace: namespace.Name})
+				Expect(err).NotTo(HaveOccurred())
+
+				events := receive(stream)
+
+				ev := waitFor(events, pb.ApplicationEvent_Added, wego.ApplicationKind, name)
+				Expect(ev.Application).To(Equal(name))
+				Expect(ev.Namespace).To(Equal(namespace.Name))
+
+				waitFor(events, pb.ApplicationEvent_Added, kustomizev2.KustomizationKind, name)
+
+				ev = waitFor(events, pb.ApplicationEvent_Added, "ConfigMap", reconciled.Name)
+				Expect(ev.Application).To(Equal(name))
+				Expect(ev.Object.Status).To(Equal("Current"))
+
+				reconciled.Data = map[string]string{"key": "value"}
+				Expect(k8sClient.Update(ctx, reconciled)).Should(Succeed())
+				waitFor(events, pb.ApplicationEvent_Modified, "ConfigMap", reconciled.Name)
+
+				Expect(k8sClient.Delete(ctx, reconciled)).Should(Succeed())
+				waitFor(events, pb.ApplicationEvent_Deleted, "ConfigMap", reconciled.Name)
+
+				unrelated.Data = map[string]string{"key": "value"}
+				Expect(k8sClient.Update(ctx, unrelated)).Should(Succeed())
+				Consistently(events).ShouldNot(Receive(WithTransform(func(ev *pb.ApplicationEvent) string {
+					return ev.Object.Name
+				}, Equal(unrelated.Name))))
+			})
+
+			It("doesn't stream the objects of the kinds the user is not allowed to watch", func() {
+				Expect(k8sClient.Create(ctx, newApp(name))).Should(Succeed())
+
+				kustomization := &kustomizev2.Kustomization{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: namespace.Name,
+					},
+					Spec: kustomizev2.KustomizationSpec{
+						SourceRef: kustomizev2.CrossNamespaceSourceReference{
+							Kind: sourcev1.GitRepositoryKind,
+							Name: name,
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, kustomization)).Should(Succeed())
+
+				kustomization.Status.Inventory = &kustomizev2.ResourceInventory{
+					Entries: []kustomizev2.ResourceRef{{
+						Version: "v1",
+						ID:      namespace.Name + "_my-secret__Secret",
+					}},
+				}
+				Expect(k8sClient.Status().Update(ctx, kustomization)).Should(Succeed())
+
+				reconciled := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-secret",
+						Namespace: namespace.Name,
+						Labels: map[string]string{
+							KustomizeNameKey:      name,
+							KustomizeNamespaceKey: namespace.Name,
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, reconciled)).Should(Succeed())
+
+				clientset, err := kubernetes.NewForConfig(env.Rest)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = clientset.RbacV1().Roles(namespace.Name).Create(ctx, &rbacv1.Role{
+					ObjectMeta: metav1.ObjectMeta{Name: "app-viewer"},
+					Rules: []rbacv1.PolicyRule{{
+						APIGroups: []string{wego.GroupVersion.Group, kustomizev2.GroupVersion.Group},
+						Resources: []string{"apps", "kustomizations"},
+						Verbs:     []string{"get", "list", "watch"},
+					}},
+				}, metav1.CreateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = clientset.RbacV1().RoleBindings(namespace.Name).Create(ctx, &rbacv1.RoleBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: "app-viewer"},
+					RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: "app-viewer"},
+					Subjects:   []rbacv1.Subject{{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "app-viewer"}},
+				}, metav1.CreateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+
+				viewerConfig := rest.CopyConfig(env.Rest)
+				viewerConfig.Impersonate = rest.ImpersonationConfig{UserName: "app-viewer"}
+
+				viewerClient, err := client.New(viewerConfig, client.Options{Scheme: scheme})
+				Expect(err).NotTo(HaveOccurred())
+
+				apps.(*applicationServer).kubeGetter = kubefakes.NewFakeKubeGetter(&kube.KubeHTTP{
+					Client:      viewerClient,
+					ClusterName: testClustername,
+					RestMapper:  viewerClient.RESTMapper(),
+				})
+
+				stream, err := appsClient.WatchApplication(ctx, &pb.WatchApplicationRequest{Name: name, Namespace: namespace.Name})
+				Expect(err).NotTo(HaveOccurred())
+
+				events := receive(stream)
+
+				waitFor(events, pb.ApplicationEvent_Added, wego.ApplicationKind, name)
+				waitFor(events, pb.ApplicationEvent_Added, kustomizev2.KustomizationKind, name)
+
+				Consistently(events).ShouldNot(Receive(WithTransform(func(ev *pb.ApplicationEvent) string {
+					return ev.Object.GroupVersionKind.Kind
+				}, Equal("Secret"))))
+			})
+
+			It("fails when the application does not exist", func() {
+				stream, err := appsClient.WatchApplication(ctx, &pb.WatchApplicationRequest{Name: name, Namespace: namespace.Name})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = stream.Recv()
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+
+		Describe("WatchApplications", func() {
+			It("streams the changes to the applications of a namespace and their flux objects", func() {
+				first := newApp(name)
+				Expect(k8sClient.Create(ctx, first)).Should(Succeed())
+
+				second := newApp(name + "-2")
+				Expect(k8sClient.Create(ctx, second)).Should(Succeed())
+
+				source := &sourcev1.GitRepository{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: namespace.Name,
+					},
+					Spec: sourcev1.GitRepositorySpec{
+						URL:      "https://github.com/owner/repo",
+						Interval: metav1.Duration{Duration: time.Minute},
+					},
+				}
+				Expect(k8sClient.Create(ctx, source)).Should(Succeed())
+
+				stream, err := appsClient.WatchApplications(ctx, &pb.WatchApplicationsRequest{Namespace: namespace.Name})
+				Expect(err).NotTo(HaveOccurred())
+
+				events := receive(stream)
+
+				waitFor(events, pb.ApplicationEvent_Added, wego.ApplicationKind, first.Name)
+				waitFor(events, pb.ApplicationEvent_Added, wego.ApplicationKind, second.Name)
+
+				ev := waitFor(events, pb.ApplicationEvent_Added, sourcev1.GitRepositoryKind, source.Name)
+				Expect(ev.Application).To(Equal(first.Name))
+
+				Expect(k8sClient.Delete(ctx, second)).Should(Succeed())
+				waitFor(events, pb.ApplicationEvent_Deleted, wego.ApplicationKind, second.Name)
+			})
+		})
+	})
+
 	Describe("GetGithubDeviceCode", func() {
 		It("returns a device code", func() {
 			ctx := context.Background()
@@ -619,6 +1343,49 @@ ConfigRepo: %s`, namespace.Name, namespace.Name, "ssh://git@github.com/some-org/
 			Expect(configGit.CommitCallCount()).To(Equal(1), "should have committed to the config git repo")
 			Expect(gitProvider.CreatePullRequestCallCount()).To(Equal(0), "should NOT have made a PR")
 		})
+		It("adds an app with the options of its pull request", func() {
+			ctx := context.Background()
+			appRequest := &pb.AddApplicationRequest{
+				Name:      "my-app",
+				Namespace: namespace.Name,
+				Url:       "ssh://git@github.com/some-org/somerepo.git",
+				Path:      "./k8s/mydir",
+				Branch:    "main",
+				PullRequest: &pb.PullRequestOptions{
+					Reviewers:    []string{"alice"},
+					Labels:       []string{"gitops"},
+					Draft:        true,
+					BodyTemplate: "{{ .Default }}\n\nCloses #12",
+				},
+			}
+			gitProvider.GetRepoVisibilityReturns(gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityInternal), nil)
+			gitProvider.CreatePullRequestReturns(testutils.DummyPullRequest{}, nil)
+
+			res, err := appsClient.AddApplication(contextWithAuth(ctx), appRequest)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Success).To(BeTrue())
+
+			_, _, prInfo := gitProvider.CreatePullRequestArgsForCall(0)
+			Expect(prInfo.Reviewers).To(Equal([]string{"alice"}))
+			Expect(prInfo.Labels).To(Equal([]string{"gitops"}))
+			Expect(prInfo.Draft).To(BeTrue())
+			Expect(prInfo.Description).To(Equal("Added yamls for my-app\n\nCloses #12"))
+		})
+		It("fails with an invalid body template", func() {
+			ctx := context.Background()
+			appRequest := &pb.AddApplicationRequest{
+				Name:        "my-app",
+				Namespace:   namespace.Name,
+				Url:         "ssh://git@github.com/some-org/somerepo.git",
+				Path:        "./k8s/mydir",
+				Branch:      "main",
+				PullRequest: &pb.PullRequestOptions{BodyTemplate: "{{ .Default }"},
+			}
+
+			_, err := appsClient.AddApplication(contextWithAuth(ctx), appRequest)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(gitProvider.CreatePullRequestCallCount()).To(Equal(0))
+		})
 	})
 
 	Context("RemoveApplication Tests", func() {
@@ -720,6 +1487,9 @@ ConfigRepo: %s`, namespace.Name, namespace.Name, "ssh://git@github.com/some-org/
 
 				Expect(configGit.CommitCallCount()).To(Equal(commitCount))
 				Expect(gitProvider.CreatePullRequestCallCount()).To(Equal(prCount))
+
+				_, _, _, params := fakeFactory.GetGitClientsArgsForCall(0)
+				Expect(params).To(Equal(services.NewGitConfigParamsFromApp(&application, false)))
 			},
 			Entry(
 				"kustomize, app repo config, auto merge",
@@ -741,6 +1511,95 @@ ConfigRepo: %s`, namespace.Name, namespace.Name, "ssh://git@github.com/some-org/
 				0))
 	})
 
+	Context("UpdateApplication Tests", func() {
+		var ctx context.Context
+
+		BeforeEach(func() {
+			ctx = context.Background()
+
+			fakeFactory.GetAppServiceReturns(&app.AppSvc{
+				Context: ctx,
+				Flux:    flux.New(osys.New(), &testutils.LocalFluxRunner{Runner: &runner.CLIRunner{}}),
+				Kube:    k,
+				Logger:  &loggerfakes.FakeLogger{},
+				Osys:    &osysfakes.FakeOsys{},
+			}, nil)
+
+			application := wego.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-app",
+					Namespace: namespace.Name,
+				},
+				Spec: wego.ApplicationSpec{
+					Branch:         "main",
+					Path:           "./k8s",
+					URL:            "ssh://git@github.com/foo/bar",
+					ConfigRepo:     "ssh://git@github.com/foo/bar",
+					SourceType:     wego.SourceTypeGit,
+					DeploymentType: wego.DeploymentTypeKustomize,
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, &application)).Should(Succeed())
+		})
+
+		It("updates an application", func() {
+			res, err := appsClient.UpdateApplication(contextWithAuth(ctx), &pb.UpdateApplicationRequest{
+				Name:      "my-app",
+				Namespace: namespace.Name,
+				Branch:    "dev",
+				Interval:  "10m",
+				AutoMerge: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Success).To(BeTrue())
+			Expect(res.Application.Name).To(Equal("my-app"))
+
+			Expect(configGit.CommitCallCount()).To(Equal(1))
+			Expect(gitProvider.CreatePullRequestCallCount()).To(Equal(0))
+		})
+
+		It("gets the git clients of the source of the application", func() {
+			chart := wego.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-chart",
+					Namespace: namespace.Name,
+				},
+				Spec: wego.ApplicationSpec{
+					Path:           "my-chart",
+					URL:            "https://charts.example.com/charts",
+					ConfigRepo:     "ssh://git@github.com/foo/bar",
+					SourceType:     wego.SourceTypeHelm,
+					DeploymentType: wego.DeploymentTypeHelm,
+				},
+			}
+			Expect(k8sClient.Create(ctx, &chart)).Should(Succeed())
+
+			fakeFactory.GetGitClientsReturns(nil, nil, errors.New("no git clients"))
+
+			_, err := appsClient.UpdateApplication(contextWithAuth(ctx), &pb.UpdateApplicationRequest{
+				Name:      "my-chart",
+				Namespace: namespace.Name,
+				Interval:  "10m",
+			})
+			Expect(err).To(HaveOccurred())
+
+			_, _, _, params := fakeFactory.GetGitClientsArgsForCall(0)
+			Expect(params).To(Equal(services.NewGitConfigParamsFromApp(&chart, false)))
+			Expect(params.IsHelmRepository).To(BeTrue())
+		})
+
+		It("rejects an invalid interval", func() {
+			_, err := appsClient.UpdateApplication(contextWithAuth(ctx), &pb.UpdateApplicationRequest{
+				Name:      "my-app",
+				Namespace: namespace.Name,
+				Interval:  "often",
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(configGit.CommitCallCount()).To(Equal(0))
+		})
+	})
+
 	Describe("ListCommits", func() {
 		It("gets commits for an app", func() {
 			testApp := &wego.Application{
@@ -913,6 +1772,8 @@ ConfigRepo: %s`, namespace.Name, namespace.Name, "ssh://git@github.com/some-org/
 			Expect(res.Commits[0].Url).To(Equal(desired.URL))
 			Expect(res.Commits[0].Message).To(Equal(desired.Message))
 			Expect(res.Commits[0].Hash).To(Equal(desired.Sha))
+			Expect(res.Commits[0].Repository).To(Equal(app.CommitRepositorySource))
+			Expect(gitProvider.GetCommitsForPathCallCount()).To(Equal(0))
 		})
 	})
 
@@ -923,6 +1784,8 @@ ConfigRepo: %s`, namespace.Name, namespace.Name, "ssh://git@github.com/some-org/
 			name     string
 		}
 		DescribeTable("parses a repo url", func(uri string, e expected) {
+			viper.Set("git-host-types", "bitbucket.example.com=bitbucket-server,gitea.example.com=gitea")
+
 			res, err := appsClient.ParseRepoURL(context.Background(), &pb.ParseRepoURLRequest{
 				Url: uri,
 			})
@@ -941,6 +1804,16 @@ ConfigRepo: %s`, namespace.Name, namespace.Name, "ssh://git@github.com/some-org/
 				owner:    "other-org",
 				name:     "cool-repo",
 			}),
+			Entry("bitbucket-server+https", "https://bitbucket.example.com/scm/proj/my-repo.git", expected{
+				provider: pb.GitProvider_BitbucketServer,
+				owner:    "proj",
+				name:     "my-repo",
+			}),
+			Entry("gitea+ssh", "ssh://git@gitea.example.com:2222/some-org/my-repo.git", expected{
+				provider: pb.GitProvider_Gitea,
+				owner:    "some-org",
+				name:     "my-repo",
+			}),
 		)
 
 		It("returns an error on an invalid URL", func() {
@@ -1005,6 +1878,8 @@ ConfigRepo: %s`, namespace.Name, namespace.Name, "ssh://git@github.com/some-org/
 	DescribeTable("ValidateProviderToken", func(provider pb.GitProvider, ctx context.Context, errResponse error, expectedCode codes.Code, valid bool) {
 		glAuthClient.ValidateTokenReturns(errResponse)
 		ghAuthClient.ValidateTokenReturns(errResponse)
+		bbsAuthClient.ValidateTokenReturns(errResponse)
+		giteaAuthClient.ValidateTokenReturns(errResponse)
 
 		res, err := appsClient.ValidateProviderToken(ctx, &pb.ValidateProviderTokenRequest{
 			Provider: provider,
@@ -1030,9 +1905,42 @@ ConfigRepo: %s`, namespace.Name, namespace.Name, "ssh://git@github.com/some-org/
 		Entry("good gitlab token", pb.GitProvider_GitLab, contextWithAuth(context.Background()), nil, codes.OK, true),
 		Entry("bad github token", pb.GitProvider_GitHub, contextWithAuth(context.Background()), errors.New("this token is bad"), codes.InvalidArgument, false),
 		Entry("good github token", pb.GitProvider_GitHub, contextWithAuth(context.Background()), nil, codes.OK, true),
+		Entry("bad bitbucket server token", pb.GitProvider_BitbucketServer, contextWithAuth(context.Background()), errors.New("this token is bad"), codes.InvalidArgument, false),
+		Entry("good bitbucket server token", pb.GitProvider_BitbucketServer, contextWithAuth(context.Background()), nil, codes.OK, true),
+		Entry("bad gitea token", pb.GitProvider_Gitea, contextWithAuth(context.Background()), errors.New("this token is bad"), codes.InvalidArgument, false),
+		Entry("good gitea token", pb.GitProvider_Gitea, contextWithAuth(context.Background()), nil, codes.OK, true),
 		Entry("no gitops jwt", pb.GitProvider_GitHub, context.Background(), errors.New("unauth error"), codes.Unauthenticated, false),
 	)
 
+	It("validates the tokens of a self-hosted provider against the host of the config repository", func() {
+		ctx := context.Background()
+
+		viper.Set("git-host-types", "bitbucket.example.com=bitbucket-server")
+
+		wegoNamespace := &corev1.Namespace{}
+		wegoNamespace.Name = wego.DefaultNamespace
+		if err := k8sClient.Create(ctx, wegoNamespace); !apierrors.IsAlreadyExists(err) {
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		cm, err := k.SetWegoConfig(ctx, kube.WegoConfig{ConfigRepo: "ssh://git@bitbucket.example.com:7999/proj/config.git"}, wego.DefaultNamespace)
+		Expect(err).NotTo(HaveOccurred())
+
+		defer func() {
+			Expect(k8sClient.Delete(ctx, cm)).To(Succeed())
+		}()
+
+		res, err := appsClient.ValidateProviderToken(contextWithAuth(ctx), &pb.ValidateProviderTokenRequest{
+			Provider: pb.GitProvider_BitbucketServer,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Valid).To(BeTrue())
+
+		_, hostname, token := bbsAuthClient.ValidateTokenArgsForCall(0)
+		Expect(hostname).To(Equal("bitbucket.example.com:7999"))
+		Expect(token).To(Equal("mytoken"))
+	})
+
 	Describe("middleware", func() {
 		Describe("logging", func() {
 			var log *fakelogr.FakeLogger
@@ -1255,6 +2163,56 @@ var _ = Describe("Applications handler", func() {
 
 		Expect(r.Applications).To(HaveLen(2))
 	})
+
+	It("streams the events of a watch", func() {
+		ctx := context.Background()
+
+		namespace := &corev1.Namespace{}
+		namespace.Name = "kube-test-" + rand.String(5)
+		Expect(k8sClient.Create(ctx, namespace)).To(Succeed())
+
+		app := &wego.Application{}
+		app.Name = "my-app"
+		app.Namespace = namespace.Name
+		Expect(k8sClient.Create(ctx, app)).To(Succeed())
+
+		cfg := ApplicationsConfig{
+			Logger:        testutils.MakeFakeLogr(),
+			Factory:       &servicesfakes.FakeFactory{},
+			ClusterConfig: kube.ClusterConfig{},
+		}
+
+		handler, err := NewHandlers(ctx, &Config{
+			AppConfig:  &cfg,
+			AppOptions: []ApplicationsOption{WithKubeGetter(kubefakes.NewFakeKubeGetter(k)), WithCache(informerCache)},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		ts := httptest.NewServer(handler)
+		defer ts.Close()
+
+		res, err := http.Get(ts.URL + "/v1/watch/applications/my-app?namespace=" + namespace.Name)
+		Expect(err).NotTo(HaveOccurred())
+
+		defer res.Body.Close()
+
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+		line, err := bufio.NewReader(res.Body).ReadBytes('\n')
+		Expect(err).NotTo(HaveOccurred())
+
+		chunk := struct {
+			Result json.RawMessage `json:"result"`
+		}{}
+		Expect(json.Unmarshal(line, &chunk)).To(Succeed())
+
+		ev := &pb.ApplicationEvent{}
+		Expect(protojson.Unmarshal(chunk.Result, ev)).To(Succeed())
+
+		Expect(ev.Type).To(Equal(pb.ApplicationEvent_Added))
+		Expect(ev.Application).To(Equal("my-app"))
+		Expect(ev.Object.GroupVersionKind.Kind).To(Equal(wego.ApplicationKind))
+	})
 })
 
 type fakeCommit struct {
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+)
+
+// The in-process gateway handlers generated for the Applications service don't support streaming calls,
+// so the watches and the logs are served by these handlers instead. Like the gateway does for streaming calls
+// made over a grpc connection, they write the messages as newline delimited JSON, each in a "result" field,
+// or the error ending the stream in an "error" field.
+// They must be registered after the generated handlers for the same paths, to take precedence over them.
+func registerStreamHandlers(mux *runtime.ServeMux, srv pb.ApplicationsServer) error {
+	watchApplications := func(stream *gatewayStream, r *http.Request, pathParams map[string]string) error {
+		msg := &pb.WatchApplicationsRequest{}
+		if err := runtime.PopulateQueryParameters(msg, r.Form, utilities.NewDoubleArray(nil)); err != nil {
+			return grpcStatus.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		return srv.WatchApplications(msg, &applicationEventStream{stream})
+	}
+
+	watchApplication := func(stream *gatewayStream, r *http.Request, pathParams map[string]string) error {
+		msg := &pb.WatchApplicationRequest{}
+		if err := runtime.PopulateQueryParameters(msg, r.Form, utilities.NewDoubleArray(nil)); err != nil {
+			return grpcStatus.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		msg.Name = pathParams["name"]
+
+		return srv.WatchApplication(msg, &applicationEventStream{stream})
+	}
+
+	getObjectLogs := func(stream *gatewayStream, r *http.Request, pathParams map[string]string) error {
+		msg := &pb.GetObjectLogsRequest{}
+		if err := runtime.PopulateQueryParameters(msg, r.Form, utilities.NewDoubleArray(nil)); err != nil {
+			return grpcStatus.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		msg.Name = pathParams["name"]
+
+		return srv.GetObjectLogs(msg, &logLineStream{stream})
+	}
+
+	if err := mux.HandlePath(http.MethodGet, "/v1/watch/applications",
+		streamHandler(mux, "/wego_server.v1.Applications/WatchApplications", "/v1/watch/applications", watchApplications)); err != nil {
+		return fmt.Errorf("could not register WatchApplications: %w", err)
+	}
+
+	if err := mux.HandlePath(http.MethodGet, "/v1/watch/applications/{name}",
+		streamHandler(mux, "/wego_server.v1.Applications/WatchApplication", "/v1/watch/applications/{name}", watchApplication)); err != nil {
+		return fmt.Errorf("could not register WatchApplication: %w", err)
+	}
+
+	if err := mux.HandlePath(http.MethodGet, "/v1/applications/{name}/logs",
+		streamHandler(mux, "/wego_server.v1.Applications/GetObjectLogs", "/v1/applications/{name}/logs", getObjectLogs)); err != nil {
+		return fmt.Errorf("could not register GetObjectLogs: %w", err)
+	}
+
+	return nil
+}
+
+type streamFunc func(stream *gatewayStream, r *http.Request, pathParams map[string]string) error
+
+func streamHandler(mux *runtime.ServeMux, method, pattern string, serve streamFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := runtime.AnnotateIncomingContext(ctx, mux, r, method, runtime.WithHTTPPathPattern(pattern))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, grpcStatus.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+
+		stream := &gatewayStream{ctx: ctx, messages: make(chan proto.Message)}
+		done := make(chan error, 1)
+
+		go func() {
+			done <- serve(stream, r, pathParams)
+		}()
+
+		// send blocks until the message is received, so all the messages are forwarded before the end of the stream
+		recv := func() (proto.Message, error) {
+			select {
+			case msg := <-stream.messages:
+				return msg, nil
+			case err := <-done:
+				if err == nil {
+					return nil, io.EOF
+				}
+
+				return nil, err
+			}
+		}
+
+		runtime.ForwardResponseStream(runtime.NewServerMetadataContext(ctx, runtime.ServerMetadata{}), mux, outbound, w, r, recv)
+	}
+}
+
+// gatewayStream hands the messages sent by a streaming call over to the HTTP handler forwarding them.
+// The calls only use Context and Send, the other grpc.ServerStream methods are not implemented.
+type gatewayStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	messages chan proto.Message
+}
+
+func (s *gatewayStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *gatewayStream) send(msg proto.Message) error {
+	select {
+	case s.messages <- msg:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+type applicationEventStream struct {
+	*gatewayStream
+}
+
+func (s *applicationEventStream) Send(ev *pb.ApplicationEvent) error {
+	return s.send(ev)
+}
+
+type logLineStream struct {
+	*gatewayStream
+}
+
+func (s *logLineStream) Send(line *pb.LogLine) error {
+	return s.send(line)
+}
@@ -30,6 +30,8 @@ import (
 	"google.golang.org/grpc/test/bufconn"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/rand"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -55,10 +57,14 @@ var k kube.Kube
 var ghAuthClient *authfakes.FakeGithubAuthClient
 var gitProvider *gitprovidersfakes.FakeGitProvider
 var glAuthClient *authfakes.FakeGitlabAuthClient
+var bbsAuthClient *authfakes.FakeHostTokenValidator
+var giteaAuthClient *authfakes.FakeHostTokenValidator
 var configGit *gitfakes.FakeGit
 var env *testutils.K8sTestEnv
 var fakeFactory *servicesfakes.FakeFactory
 var jwtClient auth.JWTClient
+var informerCache cache.Cache
+var stopInformers context.CancelFunc
 
 func bufDialer(context.Context, string) (net.Conn, error) {
 	return lis.Dial()
@@ -74,9 +80,23 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 
 	k8sClient = env.Client
+
+	informerCache, err = cache.New(env.Rest, cache.Options{Scheme: scheme})
+	Expect(err).NotTo(HaveOccurred())
+
+	var ctx context.Context
+	ctx, stopInformers = context.WithCancel(context.Background())
+
+	go func() {
+		defer GinkgoRecover()
+		Expect(informerCache.Start(ctx)).To(Succeed())
+	}()
+
+	Expect(informerCache.WaitForCacheSync(ctx)).To(BeTrue())
 })
 
 var _ = AfterSuite(func() {
+	stopInformers()
 	env.Stop()
 }, 60)
 
@@ -119,21 +139,26 @@ var _ = BeforeEach(func() {
 
 	ghAuthClient = &authfakes.FakeGithubAuthClient{}
 	glAuthClient = &authfakes.FakeGitlabAuthClient{}
+	bbsAuthClient = &authfakes.FakeHostTokenValidator{}
+	giteaAuthClient = &authfakes.FakeHostTokenValidator{}
 	jwtClient = auth.NewJwtClient(secretKey)
 	fakeFetcherFactory := applicationv2fakes.NewFakeFetcherFactory(applicationv2.NewFetcher(k8sClient))
 	fakeClientGetter := kubefakes.NewFakeClientGetter(k8sClient)
 	fakeKubeGetter := kubefakes.NewFakeKubeGetter(k)
+	fakeClientsetGetter := kubefakes.NewFakeClientsetGetter(kubefake.NewSimpleClientset())
 
 	cfg := ApplicationsConfig{
-		Factory:          fakeFactory,
-		JwtClient:        jwtClient,
-		GithubAuthClient: ghAuthClient,
-		FetcherFactory:   fakeFetcherFactory,
-		GitlabAuthClient: glAuthClient,
-		ClusterConfig:    kube.ClusterConfig{},
+		Factory:                   fakeFactory,
+		JwtClient:                 jwtClient,
+		GithubAuthClient:          ghAuthClient,
+		FetcherFactory:            fakeFetcherFactory,
+		GitlabAuthClient:          glAuthClient,
+		BitbucketServerAuthClient: bbsAuthClient,
+		GiteaAuthClient:           giteaAuthClient,
+		ClusterConfig:             kube.ClusterConfig{},
 	}
 	apps = NewApplicationsServer(&cfg,
-		WithClientGetter(fakeClientGetter), WithKubeGetter(fakeKubeGetter))
+		WithClientGetter(fakeClientGetter), WithKubeGetter(fakeKubeGetter), WithClientsetGetter(fakeClientsetGetter), WithCache(informerCache))
 	pb.RegisterApplicationsServer(s, apps)
 
 	go func() {
@@ -0,0 +1,228 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/cli-utils/pkg/kstatus/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+// The kinds of the objects created by the built-in controllers for the objects of a kind.
+// Only the objects of these kinds are looked up when walking the owner references of a tree.
+var childKinds = map[schema.GroupKind][]schema.GroupVersionKind{
+	appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind():  {appsv1.SchemeGroupVersion.WithKind("ReplicaSet")},
+	appsv1.SchemeGroupVersion.WithKind("ReplicaSet").GroupKind():  {corev1.SchemeGroupVersion.WithKind("Pod")},
+	appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind(): {corev1.SchemeGroupVersion.WithKind("Pod")},
+	appsv1.SchemeGroupVersion.WithKind("DaemonSet").GroupKind():   {corev1.SchemeGroupVersion.WithKind("Pod")},
+	batchv1.SchemeGroupVersion.WithKind("CronJob").GroupKind():    {batchv1.SchemeGroupVersion.WithKind("Job")},
+	batchv1.SchemeGroupVersion.WithKind("Job").GroupKind():        {corev1.SchemeGroupVersion.WithKind("Pod")},
+}
+
+func (s *applicationServer) GetApplicationTree(ctx context.Context, msg *pb.GetApplicationTreeRequest) (*pb.GetApplicationTreeResponse, error) {
+	cl, err := s.clientGetter.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeClient, err := s.kubeGetter.Kube(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kube service: %w", err)
+	}
+
+	name := types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace}
+
+	app, err := kubeClient.GetApplication(ctx, name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, grpcStatus.Errorf(codes.NotFound, "not found: %s", err.Error())
+		}
+
+		return nil, fmt.Errorf("could not get application %q: %w", msg.Name, err)
+	}
+
+	tree, err := applicationTree(ctx, cl, kubeClient, app)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetApplicationTreeResponse{Tree: tree}, nil
+}
+
+// applicationTree returns the Kustomization or HelmRelease of an application with the objects it reconciled as children,
+// or nil if it doesn't exist yet.
+func applicationTree(ctx context.Context, cl client.Client, kubeClient kube.Kube, app *wego.Application) (*pb.ObjectNode, error) {
+	_, deployment, err := findFluxObjects(app)
+	if err != nil {
+		return nil, fmt.Errorf("could not get flux objects for application %q: %w", app.Name, err)
+	}
+
+	if err := kubeClient.GetResource(ctx, types.NamespacedName{Name: app.Name, Namespace: app.Namespace}, deployment); err != nil {
+		return nil, fmt.Errorf("could not get deployment for app %s: %w", app.Name, err)
+	}
+
+	// GetResource leaves a missing object empty, the automation is created once the application is reconciled
+	if deployment.GetName() == "" {
+		return nil, nil
+	}
+
+	var (
+		gvk    schema.GroupVersionKind
+		labels client.MatchingLabels
+		kinds  []*pb.GroupVersionKind
+	)
+
+	switch at := deployment.(type) {
+	case *kustomizev2.Kustomization:
+		gvk = kustomizev2.GroupVersion.WithKind(kustomizev2.KustomizationKind)
+		labels = client.MatchingLabels{KustomizeNameKey: at.Name, KustomizeNamespaceKey: at.Namespace}
+		kinds, err = getKustomizeInventory(at)
+	case *helmv2.HelmRelease:
+		gvk = helmv2.GroupVersion.WithKind(helmv2.HelmReleaseKind)
+		labels = client.MatchingLabels{HelmNameKey: at.Name, HelmNamespaceKey: at.Namespace}
+		kinds, err = getHelmInventory(ctx, at, kubeClient)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deployment)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert %s %s: %w", gvk.Kind, app.Name, err)
+	}
+
+	root := &unstructured.Unstructured{Object: content}
+	root.SetGroupVersionKind(gvk)
+
+	b := &treeBuilder{ctx: ctx, client: cl, lists: map[string][]unstructured.Unstructured{}}
+
+	tree, err := b.node(root)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, kind := range kinds {
+		list := unstructured.UnstructuredList{}
+
+		list.SetGroupVersionKind(schema.GroupVersionKind{
+			Group:   kind.Group,
+			Kind:    kind.Kind,
+			Version: kind.Version,
+		})
+
+		if err := cl.List(ctx, &list, labels); err != nil {
+			return nil, fmt.Errorf("could not get unstructured list: %s", err)
+		}
+
+		for i := range list.Items {
+			child, err := b.node(&list.Items[i])
+			if err != nil {
+				return nil, err
+			}
+
+			tree.Children = append(tree.Children, child)
+		}
+	}
+
+	return tree, nil
+}
+
+// treeBuilder walks the owner references of the objects of a tree.
+// The objects of each child kind are listed once per namespace.
+type treeBuilder struct {
+	ctx    context.Context
+	client client.Client
+	lists  map[string][]unstructured.Unstructured
+}
+
+func (b *treeBuilder) node(obj *unstructured.Unstructured) (*pb.ObjectNode, error) {
+	res, err := status.Compute(obj)
+	if err != nil {
+		return nil, fmt.Errorf("could not get status for %s: %w", obj.GetName(), err)
+	}
+
+	gvk := obj.GroupVersionKind()
+
+	node := &pb.ObjectNode{
+		Object: &pb.UnstructuredObject{
+			GroupVersionKind: &pb.GroupVersionKind{
+				Group:   gvk.Group,
+				Version: gvk.Version,
+				Kind:    gvk.Kind,
+			},
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+			Status:    res.Status.String(),
+			Uid:       string(obj.GetUID()),
+		},
+		Children: []*pb.ObjectNode{},
+	}
+
+	for _, childKind := range childKinds[gvk.GroupKind()] {
+		candidates, err := b.list(childKind, obj.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range candidates {
+			if !isOwnedBy(&candidates[i], obj.GetUID()) {
+				continue
+			}
+
+			child, err := b.node(&candidates[i])
+			if err != nil {
+				return nil, err
+			}
+
+			node.Children = append(node.Children, child)
+		}
+	}
+
+	return node, nil
+}
+
+func (b *treeBuilder) list(gvk schema.GroupVersionKind, namespace string) ([]unstructured.Unstructured, error) {
+	key := namespace + "/" + gvk.String()
+
+	if items, ok := b.lists[key]; ok {
+		return items, nil
+	}
+
+	list := unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk)
+
+	if err := b.client.List(b.ctx, &list, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("could not list %s in namespace %s: %w", gvk.Kind, namespace, err)
+	}
+
+	b.lists[key] = list.Items
+
+	return list.Items, nil
+}
+
+func isOwnedBy(obj *unstructured.Unstructured, uid types.UID) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == uid {
+			return true
+		}
+	}
+
+	return false
+}
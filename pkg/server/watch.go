@@ -0,0 +1,730 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/cli-utils/pkg/kstatus/status"
+	"sigs.k8s.io/cli-utils/pkg/object"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+// watchBufferSize is the number of events queued for a watch before it is dropped for not keeping up
+const watchBufferSize = 256
+
+// informerSyncTimeout is how long a watch waits for the informer of a kind to sync before skipping the kind.
+// The informer of a kind the server isn't allowed to list never syncs.
+const informerSyncTimeout = 10 * time.Second
+
+var (
+	errWatchTooSlow = grpcStatus.Error(codes.ResourceExhausted, "events were not read fast enough, watch again to resume")
+
+	// errInformerNotSynced is returned when the objects of a kind can't be read from its informer
+	errInformerNotSynced = errors.New("informer did not sync")
+)
+
+var (
+	applicationKind = wego.GroupVersion.WithKind(wego.ApplicationKind)
+
+	// The kinds of the sources and automations that applications are deployed with
+	fluxKinds = []schema.GroupVersionKind{
+		sourcev1.GroupVersion.WithKind(sourcev1.GitRepositoryKind),
+		sourcev1.GroupVersion.WithKind(sourcev1.HelmRepositoryKind),
+		sourcev1.GroupVersion.WithKind(sourcev1.BucketKind),
+		kustomizev2.GroupVersion.WithKind(kustomizev2.KustomizationKind),
+		helmv2.GroupVersion.WithKind(helmv2.HelmReleaseKind),
+	}
+)
+
+// WatchApplications streams the changes to the applications of a namespace and to their Flux objects.
+// The objects are read from informers shared by the watches, and only the kinds the user is allowed to list
+// and watch in the namespace are streamed.
+func (s *applicationServer) WatchApplications(msg *pb.WatchApplicationsRequest, stream pb.Applications_WatchApplicationsServer) error {
+	ctx := stream.Context()
+
+	kubeClient, err := s.kubeGetter.Kube(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create kube service: %w", err)
+	}
+
+	if _, err := kubeClient.GetApplications(ctx, msg.Namespace); err != nil {
+		return fmt.Errorf("could not list applications: %w", err)
+	}
+
+	filter := func(gvk schema.GroupVersionKind, obj *unstructured.Unstructured) (types.NamespacedName, bool) {
+		name := types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}
+
+		if msg.Namespace != "" && name.Namespace != msg.Namespace {
+			return name, false
+		}
+
+		if gvk == applicationKind {
+			return name, true
+		}
+
+		// Flux objects are named after the application they deploy
+		return name, isFluxKind(gvk) && s.watcher.exists(applicationKind, name)
+	}
+
+	targets := []watchTarget{{namespace: msg.Namespace, gvk: applicationKind}}
+
+	for _, gvk := range fluxKinds {
+		targets = append(targets, watchTarget{namespace: msg.Namespace, gvk: gvk})
+	}
+
+	return s.streamEvents(stream, kubeClient, filter, targets, nil)
+}
+
+// WatchApplication streams the changes to an application, to its Flux objects and to the objects it reconciled.
+// The objects are read from informers shared by the watches, and only the kinds the user is allowed to list
+// and watch in their namespace are streamed.
+func (s *applicationServer) WatchApplication(msg *pb.WatchApplicationRequest, stream pb.Applications_WatchApplicationServer) error {
+	ctx := stream.Context()
+
+	kubeClient, err := s.kubeGetter.Kube(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create kube service: %w", err)
+	}
+
+	name := types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace}
+
+	application, err := kubeClient.GetApplication(ctx, name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return grpcStatus.Errorf(codes.NotFound, "not found: %s", err.Error())
+		}
+
+		return fmt.Errorf("could not get application %q: %w", msg.Name, err)
+	}
+
+	src, deployment, err := findFluxObjects(application)
+	if err != nil {
+		return fmt.Errorf("could not get flux objects for application %q: %w", application.Name, err)
+	}
+
+	kinds := []schema.GroupVersionKind{applicationKind}
+
+	for _, obj := range []runtime.Object{src, deployment} {
+		gvk, err := apiutil.GVKForObject(obj, s.watcher.scheme)
+		if err != nil {
+			return err
+		}
+
+		kinds = append(kinds, gvk)
+	}
+
+	targets := []watchTarget{}
+
+	for _, gvk := range kinds {
+		targets = append(targets, watchTarget{namespace: name.Namespace, gvk: gvk})
+	}
+
+	filter := func(gvk schema.GroupVersionKind, obj *unstructured.Unstructured) (types.NamespacedName, bool) {
+		if obj.GetName() == name.Name && obj.GetNamespace() == name.Namespace {
+			for _, kind := range kinds {
+				if gvk == kind {
+					return name, true
+				}
+			}
+		}
+
+		return name, isReconciledBy(obj, name)
+	}
+
+	return s.streamEvents(stream, kubeClient, filter, targets, reconciledTargets(stream.Context(), name, kubeClient))
+}
+
+// isReconciledBy tells whether an object was applied by the Kustomization or the HelmRelease of an application
+func isReconciledBy(obj *unstructured.Unstructured, application types.NamespacedName) bool {
+	labels := obj.GetLabels()
+
+	return labels[KustomizeNameKey] == application.Name && labels[KustomizeNamespaceKey] == application.Namespace ||
+		labels[HelmNameKey] == application.Name && labels[HelmNamespaceKey] == application.Namespace
+}
+
+func isFluxKind(gvk schema.GroupVersionKind) bool {
+	for _, kind := range fluxKinds {
+		if gvk == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reconciledTargets returns a function listing the kinds and namespaces in the inventory of the automation of an
+// application, for the watch to follow the objects added to the application after it started
+func reconciledTargets(ctx context.Context, application types.NamespacedName, kubeClient kube.Kube) func(objectEvent) ([]watchTarget, error) {
+	var lastRelease int
+
+	return func(ev objectEvent) ([]watchTarget, error) {
+		if ev.eventType == pb.ApplicationEvent_Deleted || ev.object.GetName() != application.Name || ev.object.GetNamespace() != application.Namespace {
+			return nil, nil
+		}
+
+		targets := []watchTarget{}
+
+		switch ev.gvk {
+		case kustomizev2.GroupVersion.WithKind(kustomizev2.KustomizationKind):
+			kustomization := &kustomizev2.Kustomization{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(ev.object.Object, kustomization); err != nil {
+				return nil, fmt.Errorf("could not read kustomization %s: %w", application.Name, err)
+			}
+
+			if kustomization.Status.Inventory == nil {
+				return nil, nil
+			}
+
+			for _, entry := range kustomization.Status.Inventory.Entries {
+				objMeta, err := object.ParseObjMetadata(entry.ID)
+				if err != nil {
+					return nil, fmt.Errorf("invalid inventory item '%s', error: %w", entry.ID, err)
+				}
+
+				targets = append(targets, watchTarget{
+					namespace: objMeta.Namespace,
+					gvk:       objMeta.GroupKind.WithVersion(entry.Version),
+				})
+			}
+		case helmv2.GroupVersion.WithKind(helmv2.HelmReleaseKind):
+			helmRelease := &helmv2.HelmRelease{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(ev.object.Object, helmRelease); err != nil {
+				return nil, fmt.Errorf("could not read helm release %s: %w", application.Name, err)
+			}
+
+			// the inventory of a release is read from its storage secret, only read it again when a new release is made
+			if helmRelease.Status.LastReleaseRevision == lastRelease {
+				return nil, nil
+			}
+
+			lastRelease = helmRelease.Status.LastReleaseRevision
+
+			objects, err := getHelmObjects(ctx, helmRelease, kubeClient)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, obj := range objects {
+				namespace := obj.GetNamespace()
+				if namespace == "" {
+					// helm installs the namespaced objects without a namespace in the one of the release
+					namespace = helmRelease.GetReleaseNamespace()
+				}
+
+				targets = append(targets, watchTarget{namespace: namespace, gvk: obj.GroupVersionKind()})
+			}
+		}
+
+		return targets, nil
+	}
+}
+
+// canWatch tells whether the user of a watch is allowed to list and watch a resource in a namespace, or in all
+// namespaces when it is empty. The informers run with the service account of the server, the access of the user
+// is checked before their objects are streamed.
+func canWatch(ctx context.Context, kubeClient kube.Kube, target watchTarget, resource string) (bool, error) {
+	for _, verb := range []string{"list", "watch"} {
+		review := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: target.namespace,
+					Verb:      verb,
+					Group:     target.gvk.Group,
+					Version:   target.gvk.Version,
+					Resource:  resource,
+				},
+			},
+		}
+
+		if err := kubeClient.Raw().Create(ctx, review); err != nil {
+			return false, fmt.Errorf("could not check access to %s: %w", resource, err)
+		}
+
+		if !review.Status.Allowed {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// eventStream is the server side of the watch RPCs
+type eventStream interface {
+	Context() context.Context
+	Send(*pb.ApplicationEvent) error
+}
+
+// streamEvents sends the existing objects of the given targets that match the filter as added, then the changes
+// to the objects matching the filter, until the client goes away. The targets the user is not allowed to list and
+// watch are skipped, as are the targets whose informers don't sync.
+// moreTargets is called after each event with its object, and may return more targets to watch.
+func (s *applicationServer) streamEvents(stream eventStream, kubeClient kube.Kube, filter watchFilter, targets []watchTarget, moreTargets func(objectEvent) ([]watchTarget, error)) error {
+	ctx := stream.Context()
+
+	sub := s.watcher.subscribe(filter)
+	defer s.watcher.unsubscribe(sub)
+
+	var (
+		send    func(objectEvent) error
+		watch   func([]watchTarget) error
+		watched = map[watchTarget]bool{}
+	)
+
+	send = func(ev objectEvent) error {
+		msg, err := toApplicationEvent(ev)
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(msg); err != nil {
+			return err
+		}
+
+		if moreTargets == nil {
+			return nil
+		}
+
+		targets, err := moreTargets(ev)
+		if err != nil {
+			return err
+		}
+
+		return watch(targets)
+	}
+
+	watch = func(targets []watchTarget) error {
+		for _, target := range targets {
+			mapping, err := kubeClient.Raw().RESTMapper().RESTMapping(target.gvk.GroupKind(), target.gvk.Version)
+			if err != nil {
+				return fmt.Errorf("could not find the resource of %s: %w", target.gvk.Kind, err)
+			}
+
+			if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+				target.namespace = ""
+			}
+
+			if watched[target] {
+				continue
+			}
+
+			watched[target] = true
+
+			allowed, err := canWatch(ctx, kubeClient, target, mapping.Resource.Resource)
+			if err != nil {
+				return err
+			}
+
+			if !allowed {
+				continue
+			}
+
+			existing, err := s.watcher.watch(ctx, sub, target)
+			if errors.Is(err, errInformerNotSynced) {
+				s.log.Error(err, "skipping watch", "kind", target.gvk.Kind, "namespace", target.namespace)
+				continue
+			} else if err != nil {
+				return err
+			}
+
+			for _, obj := range existing {
+				if application, ok := filter(target.gvk, obj); ok {
+					if err := send(objectEvent{eventType: pb.ApplicationEvent_Added, gvk: target.gvk, object: obj, application: application}); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+
+	if err := watch(targets); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.dropped:
+			return errWatchTooSlow
+		case ev := <-sub.events:
+			if err := send(ev); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func toApplicationEvent(ev objectEvent) (*pb.ApplicationEvent, error) {
+	res, err := status.Compute(ev.object)
+	if err != nil {
+		return nil, fmt.Errorf("could not get status for %s: %w", ev.object.GetName(), err)
+	}
+
+	return &pb.ApplicationEvent{
+		Type:        ev.eventType,
+		Application: ev.application.Name,
+		Namespace:   ev.application.Namespace,
+		Object: &pb.UnstructuredObject{
+			GroupVersionKind: &pb.GroupVersionKind{
+				Group:   ev.gvk.Group,
+				Version: ev.gvk.Version,
+				Kind:    ev.gvk.Kind,
+			},
+			Name:      ev.object.GetName(),
+			Namespace: ev.object.GetNamespace(),
+			Status:    res.Status.String(),
+			Uid:       string(ev.object.GetUID()),
+		},
+	}, nil
+}
+
+// watchTarget is a kind of objects watched in a namespace, or in all namespaces when it is empty
+type watchTarget struct {
+	namespace string
+	gvk       schema.GroupVersionKind
+}
+
+// watchFilter tells whether a watch wants an object, and the application the object belongs to
+type watchFilter func(gvk schema.GroupVersionKind, obj *unstructured.Unstructured) (types.NamespacedName, bool)
+
+// objectEvent is a change to an object seen by the informers
+type objectEvent struct {
+	eventType   pb.ApplicationEvent_Type
+	gvk         schema.GroupVersionKind
+	object      *unstructured.Unstructured
+	application types.NamespacedName
+}
+
+// subscription receives the events of the targets it is allowed to watch that are matched by its filter,
+// until it is dropped for not keeping up
+type subscription struct {
+	filter  watchFilter
+	events  chan objectEvent
+	dropped chan struct{}
+	drop    sync.Once
+
+	mu      sync.Mutex
+	allowed map[watchTarget]bool
+
+	// the informers the subscription gets events from, guarded by the mutex of the hub
+	joined map[*namespaceInformers]bool
+}
+
+func (sub *subscription) allow(target watchTarget) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	sub.allowed[target] = true
+}
+
+// allows tells whether the subscription may receive the objects of a kind in a namespace
+func (sub *subscription) allows(gvk schema.GroupVersionKind, namespace string) bool {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	return sub.allowed[watchTarget{namespace: namespace, gvk: gvk}] || sub.allowed[watchTarget{gvk: gvk}]
+}
+
+// send queues an event without waiting, the subscription is dropped when its queue is full
+func (sub *subscription) send(ev objectEvent) {
+	select {
+	case sub.events <- ev:
+	default:
+		// don't hold the informers up for the other watches
+		sub.drop.Do(func() {
+			close(sub.dropped)
+		})
+	}
+}
+
+// namespaceInformers are the informers of a namespace, or of all namespaces when it is empty, and the
+// subscriptions getting their events
+type namespaceInformers struct {
+	namespace     string
+	cache         cache.Cache
+	stop          context.CancelFunc
+	subscriptions map[*subscription]bool
+	watched       map[schema.GroupVersionKind]bool
+}
+
+// watchHub fans the events of informers shared by all the watches out to their subscriptions.
+// Handlers can't be removed from informers, so each informer is only given one handler, and the
+// subscriptions come and go. The informers of a namespace are started by the first watch of the
+// namespace and stopped when its last watch goes away, unless a cache was given to the server,
+// which is then used for all namespaces.
+type watchHub struct {
+	config *rest.Config
+	scheme *runtime.Scheme
+	log    logr.Logger
+	shared cache.Cache
+
+	mu         sync.Mutex
+	namespaces map[string]*namespaceInformers
+}
+
+func newWatchHub(c cache.Cache, config *rest.Config, log logr.Logger) *watchHub {
+	return &watchHub{
+		config:     config,
+		scheme:     kube.CreateScheme(),
+		log:        log,
+		shared:     c,
+		namespaces: map[string]*namespaceInformers{},
+	}
+}
+
+func (h *watchHub) subscribe(filter watchFilter) *subscription {
+	return &subscription{
+		filter:  filter,
+		events:  make(chan objectEvent, watchBufferSize),
+		dropped: make(chan struct{}),
+		allowed: map[watchTarget]bool{},
+		joined:  map[*namespaceInformers]bool{},
+	}
+}
+
+// unsubscribe removes a subscription from the informers it joined, and stops the informers it was the last
+// subscription of
+func (h *watchHub) unsubscribe(sub *subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for informers := range sub.joined {
+		delete(informers.subscriptions, sub)
+
+		if len(informers.subscriptions) == 0 && informers.stop != nil {
+			informers.stop()
+			delete(h.namespaces, informers.namespace)
+		}
+	}
+
+	sub.joined = map[*namespaceInformers]bool{}
+}
+
+// join adds a subscription to the informers of a namespace, starting them when it is the first one
+func (h *watchHub) join(ctx context.Context, sub *subscription, namespace string) (*namespaceInformers, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.shared != nil {
+		// the cache given to the server has the objects of all namespaces
+		namespace = ""
+	}
+
+	informers, ok := h.namespaces[namespace]
+	if !ok {
+		c := h.shared
+
+		var stop context.CancelFunc
+
+		if c == nil {
+			if h.config == nil {
+				return nil, errors.New("no cluster config to watch applications with")
+			}
+
+			var err error
+
+			c, err = cache.New(h.config, cache.Options{Scheme: h.scheme, Namespace: namespace})
+			if err != nil {
+				return nil, fmt.Errorf("could not create informers: %w", err)
+			}
+
+			var cacheCtx context.Context
+
+			cacheCtx, stop = context.WithCancel(context.Background())
+
+			go func() {
+				if err := c.Start(cacheCtx); err != nil {
+					h.log.Error(err, "informers stopped", "namespace", namespace)
+				}
+			}()
+		}
+
+		// the cache is only waited for by the first watch of the namespace, when it has no informers that
+		// might never sync yet
+		startCtx, cancel := context.WithTimeout(ctx, informerSyncTimeout)
+		defer cancel()
+
+		if !c.WaitForCacheSync(startCtx) {
+			if stop != nil {
+				stop()
+			}
+
+			return nil, fmt.Errorf("informers did not start: %w", startCtx.Err())
+		}
+
+		informers = &namespaceInformers{
+			namespace:     namespace,
+			cache:         c,
+			stop:          stop,
+			subscriptions: map[*subscription]bool{},
+			watched:       map[schema.GroupVersionKind]bool{},
+		}
+
+		h.namespaces[namespace] = informers
+	}
+
+	informers.subscriptions[sub] = true
+	sub.joined[informers] = true
+
+	return informers, nil
+}
+
+// watch makes sure the objects of a target are sent to a subscription. The informer of a kind sends the
+// existing objects to its handler when it is added; when the kind was already watched, the existing
+// objects are returned instead for the caller to send them.
+// Getting an informer waits for it to sync, which is bounded by informerSyncTimeout; errInformerNotSynced
+// is returned when it doesn't sync in time.
+func (h *watchHub) watch(ctx context.Context, sub *subscription, target watchTarget) ([]*unstructured.Unstructured, error) {
+	informers, err := h.join(ctx, sub, target.namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	sub.allow(target)
+
+	syncCtx, cancel := context.WithTimeout(ctx, informerSyncTimeout)
+	defer cancel()
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(target.gvk)
+
+	informer, err := informers.cache.GetInformer(syncCtx, obj)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		return nil, fmt.Errorf("could not watch %s: %v: %w", target.gvk.Kind, err, errInformerNotSynced)
+	}
+
+	if !h.markWatched(informers, target.gvk) {
+		gvk := target.gvk
+
+		informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				h.publish(informers, pb.ApplicationEvent_Added, gvk, obj)
+			},
+			UpdateFunc: func(_, obj interface{}) {
+				h.publish(informers, pb.ApplicationEvent_Modified, gvk, obj)
+			},
+			DeleteFunc: func(obj interface{}) {
+				if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+
+				h.publish(informers, pb.ApplicationEvent_Deleted, gvk, obj)
+			},
+		})
+
+		return nil, nil
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(target.gvk.GroupVersion().WithKind(target.gvk.Kind + "List"))
+
+	if err := informers.cache.List(ctx, list, client.InNamespace(target.namespace)); err != nil {
+		return nil, fmt.Errorf("could not list %s: %w", target.gvk.Kind, err)
+	}
+
+	existing := []*unstructured.Unstructured{}
+
+	for i := range list.Items {
+		existing = append(existing, &list.Items[i])
+	}
+
+	return existing, nil
+}
+
+// markWatched records that the objects of a kind are sent to the subscriptions of the informers of a namespace,
+// and tells whether they already were
+func (h *watchHub) markWatched(informers *namespaceInformers, gvk schema.GroupVersionKind) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	watched := informers.watched[gvk]
+	informers.watched[gvk] = true
+
+	return watched
+}
+
+// exists tells whether an object of a watched kind is in the informers of its namespace
+func (h *watchHub) exists(gvk schema.GroupVersionKind, name types.NamespacedName) bool {
+	h.mu.Lock()
+
+	var found *namespaceInformers
+
+	for _, namespace := range []string{name.Namespace, ""} {
+		if informers, ok := h.namespaces[namespace]; ok && informers.watched[gvk] {
+			found = informers
+			break
+		}
+	}
+
+	h.mu.Unlock()
+
+	if found == nil {
+		return false
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+
+	return found.cache.Get(context.Background(), name, obj) == nil
+}
+
+func (h *watchHub) publish(informers *namespaceInformers, eventType pb.ApplicationEvent_Type, gvk schema.GroupVersionKind, obj interface{}) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	h.mu.Lock()
+
+	subscriptions := []*subscription{}
+
+	for sub := range informers.subscriptions {
+		subscriptions = append(subscriptions, sub)
+	}
+
+	h.mu.Unlock()
+
+	// the filters may read the informers, so they are called without holding the hub
+	for _, sub := range subscriptions {
+		if !sub.allows(gvk, u.GetNamespace()) {
+			continue
+		}
+
+		application, ok := sub.filter(gvk, u)
+		if !ok {
+			continue
+		}
+
+		sub.send(objectEvent{eventType: eventType, gvk: gvk, object: u, application: application})
+	}
+}
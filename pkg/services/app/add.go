@@ -4,8 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
 	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
@@ -13,7 +17,16 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/services/automation"
 	"github.com/weaveworks/weave-gitops/pkg/services/gitopswriter"
 	"github.com/weaveworks/weave-gitops/pkg/services/gitrepo"
+	"github.com/weaveworks/weave-gitops/pkg/services/sops"
+	"github.com/weaveworks/weave-gitops/pkg/tracing"
 	"github.com/weaveworks/weave-gitops/pkg/utils"
+	"go.opentelemetry.io/otel/attribute"
+
+	"helm.sh/helm/v3/pkg/cli/values"
+	"helm.sh/helm/v3/pkg/getter"
+	extensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
 
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 )
@@ -38,21 +51,65 @@ type AddParams struct {
 	GitProviderToken           string
 	HelmReleaseTargetNamespace string
 	MigrateToNewDirStructure   func(string) string
+	SourceInterval             time.Duration
+	Interval                   time.Duration
+	Timeout                    time.Duration
+	Prune                      *bool
+	Wait                       bool
+	HealthChecks               []string
+	HelmValuesFiles            []string
+	HelmValuesYaml             string
+	HelmSetValues              []string
+	HelmValuesFrom             []string
+	Clusters                   []string
+	BucketName                 string
+	BucketProvider             string
+	BucketRegion               string
+	BucketInsecure             bool
+	BucketSecretRef            string
+	Substitute                 []string
+	SubstituteFrom             []string
+	DependsOn                  []string
+	DecryptionProvider         string
+	DecryptionSecret           string
+	// CommitTemplates are the templates of the commit and pull request, the ones not set are taken from the wego config
+	CommitTemplates models.CommitTemplates
+	// PullRequestOptions are the reviewers, labels, assignees and draft status of the pull request
+	PullRequestOptions gitproviders.PullRequestOptions
 }
 
 const (
+	// ociScheme prefixes the URL of helm repositories hosted in OCI registries
+	ociScheme = "oci://"
+	// helmRepositoryCRDName is the name of the CRD of the HelmRepositories of source-controller
+	helmRepositoryCRDName = "helmrepositories.source.toolkit.fluxcd.io"
+
 	DefaultPath           = "./"
 	DefaultBranch         = "main"
 	DefaultDeploymentType = "kustomize"
 )
 
+// substituteVarName matches the variable names supported by the post-build substitution of flux
+var substituteVarName = regexp.MustCompile(`^[_a-zA-Z][_a-zA-Z0-9]*$`)
+
 func (a AddParams) IsHelmRepository() bool {
 	return a.Chart != ""
 }
 
+func (a AddParams) IsBucket() bool {
+	return a.BucketName != ""
+}
+
 func (a *AppSvc) Add(configGit git.Git, gitProvider gitproviders.GitProvider, params AddParams) error {
-	ctx := context.Background()
+	ctx, span := tracing.Start(a.Context, "app.Add", attribute.String("app.name", params.Name), attribute.String("app.namespace", params.Namespace))
+
+	err := a.add(ctx, configGit, gitProvider, params)
+	tracing.End(span, err)
 
+	return err
+}
+
+func (a *AppSvc) add(ctx context.Context, configGit git.Git, gitProvider gitproviders.GitProvider, params AddParams) error {
 	params, err := a.updateParametersIfNecessary(ctx, gitProvider, params)
 	if err != nil {
 		return fmt.Errorf("could not update parameters: %w", err)
@@ -64,12 +121,12 @@ func (a *AppSvc) Add(configGit git.Git, gitProvider gitproviders.GitProvider, pa
 		return err
 	}
 
-	clusterName, err := a.Kube.GetClusterName(ctx)
+	app, err := makeApplication(params)
 	if err != nil {
 		return err
 	}
 
-	app, err := makeApplication(params)
+	clusterNames, err := a.targetClusters(ctx, gitProvider, app.ConfigRepo, params.Clusters)
 	if err != nil {
 		return err
 	}
@@ -85,6 +142,8 @@ func (a *AppSvc) Add(configGit git.Git, gitProvider gitproviders.GitProvider, pa
 		return err
 	}
 
+	clusterApps := map[string]models.Application{}
+
 	for _, wegoapp := range wegoapps {
 		clusterApp, err := automation.WegoAppToApp(wegoapp)
 		if err != nil {
@@ -94,13 +153,24 @@ func (a *AppSvc) Add(configGit git.Git, gitProvider gitproviders.GitProvider, pa
 		if appHash == automation.GetAppHash(clusterApp) {
 			return fmt.Errorf("unable to create resource, resource already exists in cluster")
 		}
+
+		clusterApps[clusterApp.Name] = clusterApp
+	}
+
+	if err := validateDependencies(app, clusterApps); err != nil {
+		return err
 	}
 
 	if params.DryRun {
 		return nil
 	}
 
-	return a.addApp(ctx, configGit, gitProvider, app, clusterName, params.AutoMerge)
+	templates, err := models.GetCommitTemplates(ctx, a.Kube, params.Namespace, params.CommitTemplates)
+	if err != nil {
+		return err
+	}
+
+	return a.addApp(ctx, configGit, gitProvider, app, clusterNames, params.AutoMerge, templates, params.PullRequestOptions)
 }
 
 func (a *AppSvc) printAddSummary(params AddParams) {
@@ -115,17 +185,78 @@ func (a *AppSvc) printAddSummary(params AddParams) {
 		a.Logger.Println("Chart: %s", params.Chart)
 	}
 
+	if params.BucketName != "" {
+		a.Logger.Println("Bucket: %s", params.BucketName)
+	}
+
+	if params.SourceInterval != 0 {
+		a.Logger.Println("Source interval: %s", params.SourceInterval)
+	}
+
+	if params.Interval != 0 {
+		a.Logger.Println("Interval: %s", params.Interval)
+	}
+
+	if params.Timeout != 0 {
+		a.Logger.Println("Timeout: %s", params.Timeout)
+	}
+
+	if len(params.Clusters) > 0 {
+		a.Logger.Println("Clusters: %s", strings.Join(params.Clusters, ", "))
+	}
+
+	if len(params.DependsOn) > 0 {
+		a.Logger.Println("Depends on: %s", strings.Join(params.DependsOn, ", "))
+	}
+
 	a.Logger.Println("")
 }
 
+// checkOCIHelmRepositories checks that the cluster serves the HelmRepositories of OCI registries. Their type
+// field is only known to the v1beta2 HelmRepository CRD of newer source-controllers, older ones would prune it
+// and pull the chart over HTTP.
+func (a *AppSvc) checkOCIHelmRepositories(ctx context.Context) error {
+	crd := &extensionsv1.CustomResourceDefinition{}
+	if err := a.Kube.GetResource(ctx, types.NamespacedName{Name: helmRepositoryCRDName}, crd); err != nil {
+		return fmt.Errorf("failed getting the HelmRepository CRD: %w", err)
+	}
+
+	for _, version := range crd.Spec.Versions {
+		if version.Name != automation.OCIHelmRepositoryVersion || !version.Served || version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+			continue
+		}
+
+		if _, ok := version.Schema.OpenAPIV3Schema.Properties["spec"].Properties["type"]; ok {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("charts in OCI registries need %s/%s HelmRepositories, please upgrade flux on the cluster",
+		sourcev1.GroupVersion.Group, automation.OCIHelmRepositoryVersion)
+}
+
 func (a *AppSvc) updateParametersIfNecessary(ctx context.Context, gitProvider gitproviders.GitProvider, params AddParams) (AddParams, error) {
 	params.SourceType = wego.SourceTypeGit
 
 	var appRepoUrl gitproviders.RepoURL
 
+	if !params.IsBucket() && (params.BucketProvider != "" || params.BucketRegion != "" || params.BucketInsecure || params.BucketSecretRef != "") {
+		return params, errors.New("--bucket must be specified to use the other bucket flags")
+	}
+
 	switch {
+	case params.Chart != "" && params.IsBucket():
+		return params, errors.New("--chart and --bucket can't be used together")
 	case params.Chart != "":
 		params.SourceType = wego.SourceTypeHelm
+		if strings.HasPrefix(params.Url, ociScheme) {
+			if err := a.checkOCIHelmRepositories(ctx); err != nil {
+				return params, err
+			}
+
+			params.SourceType = wego.SourceTypeOCI
+		}
+
 		params.DeploymentType = string(wego.DeploymentTypeHelm)
 		params.Path = params.Chart
 
@@ -145,6 +276,38 @@ func (a *AppSvc) updateParametersIfNecessary(ctx context.Context, gitProvider gi
 		if params.ConfigRepo == "" {
 			return params, errors.New("--config-repo should be provided")
 		}
+	case params.IsBucket():
+		params.SourceType = wego.SourceTypeBucket
+
+		if params.DeploymentType == string(wego.DeploymentTypeHelm) {
+			return params, errors.New("bucket sources only support kustomize deployments")
+		}
+
+		switch params.BucketProvider {
+		case "", sourcev1.GenericBucketProvider, sourcev1.AmazonBucketProvider, sourcev1.GoogleBucketProvider:
+		default:
+			return params, fmt.Errorf("invalid bucket provider %q, must be one of generic, aws or gcp", params.BucketProvider)
+		}
+
+		if params.Name == "" {
+			if err := models.ValidateApplicationName(params.BucketName); err != nil {
+				return params, fmt.Errorf("unable to use bucket name %q as the application name; please specify name with '--name' :%s",
+					params.BucketName, err)
+			}
+
+			params.Name = params.BucketName
+		}
+
+		if params.Url == "" {
+			return params, fmt.Errorf("--url must be specified with the endpoint of the bucket")
+		}
+
+		if params.ConfigRepo == "" {
+			return params, errors.New("--config-repo should be provided")
+		}
+
+		// resetting Dir param since the bucket holds the manifests
+		params.Dir = ""
 	default:
 		var err error
 
@@ -186,7 +349,7 @@ func (a *AppSvc) updateParametersIfNecessary(ctx context.Context, gitProvider gi
 		params.DeploymentType = DefaultDeploymentType
 	}
 
-	if params.Branch == "" {
+	if params.Branch == "" && params.SourceType != wego.SourceTypeBucket {
 		params.Branch = DefaultBranch
 
 		if params.SourceType == wego.SourceTypeGit {
@@ -214,27 +377,72 @@ func (a *AppSvc) updateParametersIfNecessary(ctx context.Context, gitProvider gi
 		}
 	}
 
+	if params.DeploymentType == string(wego.DeploymentTypeHelm) {
+		if params.Prune != nil && !*params.Prune {
+			return params, errors.New("--prune can only be disabled for kustomize deployments")
+		}
+
+		if params.Wait || len(params.HealthChecks) > 0 {
+			return params, errors.New("--wait and --health-check are only supported for kustomize deployments")
+		}
+
+		if len(params.Substitute) > 0 || len(params.SubstituteFrom) > 0 {
+			return params, errors.New("--substitute and --substitute-from are only supported for kustomize deployments")
+		}
+
+		if params.DecryptionProvider != "" {
+			return params, errors.New("--decryption-provider is only supported for kustomize deployments")
+		}
+	} else if len(params.HelmValuesFiles) > 0 || params.HelmValuesYaml != "" || len(params.HelmSetValues) > 0 || len(params.HelmValuesFrom) > 0 {
+		return params, errors.New("--values, --set and --values-from are only supported for helm deployments")
+	}
+
+	if params.DecryptionSecret != "" && params.DecryptionProvider == "" {
+		return params, errors.New("--decryption-secret requires --decryption-provider")
+	}
+
+	if params.DecryptionProvider != "" && params.DecryptionProvider != sops.DecryptionProvider {
+		return params, fmt.Errorf("invalid decryption provider %q, only %s is supported", params.DecryptionProvider, sops.DecryptionProvider)
+	}
+
+	for _, d := range []time.Duration{params.SourceInterval, params.Interval, params.Timeout} {
+		if d < 0 {
+			return params, fmt.Errorf("invalid duration %s: must not be negative", d)
+		}
+	}
+
 	return params, nil
 }
 
-func (a *AppSvc) addApp(ctx context.Context, configGit git.Git, gitProvider gitproviders.GitProvider, app models.Application, clusterName string, autoMerge bool) error {
+func (a *AppSvc) addApp(ctx context.Context, configGit git.Git, gitProvider gitproviders.GitProvider, app models.Application, clusterNames []string, autoMerge bool, templates models.CommitTemplates, prOptions gitproviders.PullRequestOptions) error {
 	repoWriter := gitrepo.NewRepoWriter(app.ConfigRepo, gitProvider, configGit, a.Logger)
 	automationGen := automation.NewAutomationGenerator(gitProvider, a.Flux, a.Logger)
-	gitOpsDirWriter := gitopswriter.NewGitOpsDirectoryWriter(automationGen, repoWriter, a.Osys, a.Logger)
+	gitOpsDirWriter := gitopswriter.NewGitOpsDirectoryWriter(automationGen, repoWriter, a.Osys, a.Logger, templates, prOptions)
 
-	return gitOpsDirWriter.AddApplication(ctx, app, clusterName, autoMerge)
+	return gitOpsDirWriter.AddApplication(ctx, app, clusterNames, autoMerge)
 }
 
 func makeApplication(params AddParams) (models.Application, error) {
 	var (
 		gitSourceURL  gitproviders.RepoURL
 		helmSourceURL string
+		bucket        models.BucketSource
 		err           error
 	)
 
-	if models.SourceType(params.SourceType) == models.SourceTypeHelm {
+	switch models.SourceType(params.SourceType) {
+	case models.SourceTypeHelm, models.SourceTypeOCI:
 		helmSourceURL = params.Url
-	} else {
+	case models.SourceTypeBucket:
+		bucket = models.BucketSource{
+			Endpoint:  params.Url,
+			Name:      params.BucketName,
+			Provider:  params.BucketProvider,
+			Region:    params.BucketRegion,
+			Insecure:  params.BucketInsecure,
+			SecretRef: params.BucketSecretRef,
+		}
+	default:
 		gitSourceURL, err = gitproviders.NewRepoURL(params.Url)
 		if err != nil {
 			return models.Application{}, err
@@ -252,6 +460,31 @@ func makeApplication(params AddParams) (models.Application, error) {
 		configRepo = curl
 	}
 
+	healthChecks, err := parseHealthChecks(params.HealthChecks, params.Namespace)
+	if err != nil {
+		return models.Application{}, err
+	}
+
+	helmValues, err := mergeHelmValues(params)
+	if err != nil {
+		return models.Application{}, err
+	}
+
+	helmValuesFrom, err := parseValuesFrom(params.HelmValuesFrom)
+	if err != nil {
+		return models.Application{}, err
+	}
+
+	substitute, err := parseSubstitute(params.Substitute)
+	if err != nil {
+		return models.Application{}, err
+	}
+
+	substituteFrom, err := parseSubstituteFrom(params.SubstituteFrom)
+	if err != nil {
+		return models.Application{}, err
+	}
+
 	app := models.Application{
 		Name:                params.Name,
 		Namespace:           params.Namespace,
@@ -263,7 +496,200 @@ func makeApplication(params AddParams) (models.Application, error) {
 		SourceType:          models.SourceType(params.SourceType),
 		AutomationType:      models.AutomationType(params.DeploymentType),
 		HelmTargetNamespace: params.HelmReleaseTargetNamespace,
+		SourceInterval:      params.SourceInterval,
+		Interval:            params.Interval,
+		Timeout:             params.Timeout,
+		DisablePrune:        params.Prune != nil && !*params.Prune,
+		Wait:                params.Wait,
+		HealthChecks:        healthChecks,
+		HelmValues:          helmValues,
+		HelmValuesFrom:      helmValuesFrom,
+		Bucket:              bucket,
+		Substitute:          substitute,
+		SubstituteFrom:      substituteFrom,
+		DependsOn:           params.DependsOn,
+		Decryption: models.Decryption{
+			Provider:  params.DecryptionProvider,
+			SecretRef: params.DecryptionSecret,
+		},
 	}
 
 	return app, nil
 }
+
+// validateDependencies checks that the apps an application depends on exist in its namespace.
+// Flux only orders Kustomizations after Kustomizations and HelmReleases after HelmReleases,
+// so the dependencies must also have the same deployment type.
+func validateDependencies(app models.Application, clusterApps map[string]models.Application) error {
+	for _, name := range app.DependsOn {
+		if name == app.Name {
+			return fmt.Errorf("application %s can't depend on itself", app.Name)
+		}
+
+		dependency, ok := clusterApps[name]
+		if !ok {
+			return fmt.Errorf("application %s depends on %s, which does not exist in namespace %s", app.Name, name, app.Namespace)
+		}
+
+		if dependency.AutomationType != app.AutomationType {
+			return fmt.Errorf("application %s is a %s deployment and can only depend on %s deployments, %s is a %s deployment",
+				app.Name, app.AutomationType, app.AutomationType, name, dependency.AutomationType)
+		}
+	}
+
+	return nil
+}
+
+// mergeHelmValues merges the helm values of an application the same way helm does.
+// The values yaml comes first, followed by the values files in order and the --set values.
+func mergeHelmValues(params AddParams) (map[string]interface{}, error) {
+	base := map[string]interface{}{}
+
+	if params.HelmValuesYaml != "" {
+		if err := yaml.Unmarshal([]byte(params.HelmValuesYaml), &base); err != nil {
+			return nil, fmt.Errorf("failed to parse helm values: %w", err)
+		}
+	}
+
+	opts := values.Options{
+		ValueFiles: params.HelmValuesFiles,
+		Values:     params.HelmSetValues,
+	}
+
+	// no getters are passed in, so values files can only be read from disk
+	merged, err := opts.MergeValues(getter.Providers{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read helm values: %w", err)
+	}
+
+	result := mergeMaps(base, merged)
+	if len(result) == 0 {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+// mergeMaps is helm's algorithm to merge values, where b takes precedence over a
+func mergeMaps(a, b map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(a))
+	for k, v := range a {
+		out[k] = v
+	}
+
+	for k, v := range b {
+		if v, ok := v.(map[string]interface{}); ok {
+			if bv, ok := out[k]; ok {
+				if bv, ok := bv.(map[string]interface{}); ok {
+					out[k] = mergeMaps(bv, v)
+					continue
+				}
+			}
+		}
+
+		out[k] = v
+	}
+
+	return out
+}
+
+// parseValuesFrom parses references to helm values in the '<kind>/<name>' format used by flux
+func parseValuesFrom(refs []string) ([]models.ValuesReference, error) {
+	var result []models.ValuesReference
+
+	for _, ref := range refs {
+		kindName := strings.SplitN(ref, "/", 2)
+		if len(kindName) != 2 || kindName[1] == "" {
+			return nil, fmt.Errorf("invalid values reference %q, must be in the format '<kind>/<name>'", ref)
+		}
+
+		if kindName[0] != "ConfigMap" && kindName[0] != "Secret" {
+			return nil, fmt.Errorf("invalid values reference %q, kind must be ConfigMap or Secret", ref)
+		}
+
+		result = append(result, models.ValuesReference{Kind: kindName[0], Name: kindName[1]})
+	}
+
+	return result, nil
+}
+
+// parseSubstitute parses post-build variables in the key=value format
+func parseSubstitute(vars []string) (map[string]string, error) {
+	if len(vars) == 0 {
+		return nil, nil
+	}
+
+	result := map[string]string{}
+
+	for _, v := range vars {
+		keyValue := strings.SplitN(v, "=", 2)
+		if len(keyValue) != 2 || !substituteVarName.MatchString(keyValue[0]) {
+			return nil, fmt.Errorf("invalid substitute variable %q, must be in the format '<name>=<value>' with a name made of letters, digits and underscores", v)
+		}
+
+		result[keyValue[0]] = keyValue[1]
+	}
+
+	return result, nil
+}
+
+// parseSubstituteFrom parses references to post-build variables in the '<kind>/<name>' format.
+// The kind is matched case insensitively, so 'configmap/vars' works as well as 'ConfigMap/vars'.
+func parseSubstituteFrom(refs []string) ([]models.SubstituteReference, error) {
+	var result []models.SubstituteReference
+
+	for _, ref := range refs {
+		kindName := strings.SplitN(ref, "/", 2)
+		if len(kindName) != 2 || kindName[1] == "" {
+			return nil, fmt.Errorf("invalid substitute reference %q, must be in the format '<kind>/<name>'", ref)
+		}
+
+		var kind string
+
+		switch strings.ToLower(kindName[0]) {
+		case "configmap":
+			kind = "ConfigMap"
+		case "secret":
+			kind = "Secret"
+		default:
+			return nil, fmt.Errorf("invalid substitute reference %q, kind must be ConfigMap or Secret", ref)
+		}
+
+		result = append(result, models.SubstituteReference{Kind: kind, Name: kindName[1]})
+	}
+
+	return result, nil
+}
+
+// parseHealthChecks parses health checks in the '<kind>/<name>.<namespace>' format used by flux.
+// The namespace defaults to the namespace of the application.
+func parseHealthChecks(healthChecks []string, namespace string) ([]models.HealthCheck, error) {
+	var result []models.HealthCheck
+
+	for _, hc := range healthChecks {
+		kindName := strings.SplitN(hc, "/", 2)
+		if len(kindName) != 2 || kindName[0] == "" || kindName[1] == "" {
+			return nil, fmt.Errorf("invalid health check %q, must be in the format '<kind>/<name>.<namespace>'", hc)
+		}
+
+		check := models.HealthCheck{
+			Kind:      kindName[0],
+			Name:      kindName[1],
+			Namespace: namespace,
+		}
+
+		if nameNamespace := strings.SplitN(kindName[1], ".", 2); len(nameNamespace) == 2 {
+			check.Name = nameNamespace[0]
+			check.Namespace = nameNamespace[1]
+		}
+
+		// the api version of other kinds is resolved by the kustomize-controller
+		if check.Kind == helmv2.HelmReleaseKind {
+			check.APIVersion = helmv2.GroupVersion.String()
+		}
+
+		result = append(result, check)
+	}
+
+	return result, nil
+}
@@ -5,7 +5,9 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/go-git/go-billy/v5/memfs"
 	gogit "github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
@@ -16,7 +18,10 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/models"
 	"github.com/weaveworks/weave-gitops/pkg/utils"
+	extensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/yaml"
 )
 
@@ -67,6 +72,45 @@ var _ = Describe("Add", func() {
 		Expect(kubeClient.GetClusterNameCallCount()).To(Equal(1))
 	})
 
+	It("adds the app to the given clusters", func() {
+		addParams.Clusters = []string{"cluster-a", "cluster-b", "cluster-a"}
+		gitProviders.GetRepoDirFilesReturns([]*gitprovider.CommitFile{{}}, nil)
+
+		Expect(appSrv.Add(gitClient, gitProviders, addParams)).Should(Succeed())
+		Expect(kubeClient.GetClusterNameCallCount()).To(Equal(0))
+
+		paths := []string{}
+		for i := 0; i < gitClient.WriteCallCount(); i++ {
+			path, _ := gitClient.WriteArgsForCall(i)
+			paths = append(paths, path)
+		}
+
+		Expect(paths).To(ContainElements(
+			".weave-gitops/clusters/cluster-a/user/kustomization.yaml",
+			".weave-gitops/clusters/cluster-b/user/kustomization.yaml",
+		))
+		Expect(paths).To(HaveLen(6))
+	})
+
+	It("rejects a cluster not installed in the config repo", func() {
+		addParams.Clusters = []string{"cluster-a"}
+		gitProviders.GetRepoDirFilesReturns(nil, nil)
+
+		err := appSrv.Add(gitClient, gitProviders, addParams)
+		Expect(err).To(MatchError(`cluster "cluster-a" not found in the config repository`))
+
+		_, _, dirPath, _ := gitProviders.GetRepoDirFilesArgsForCall(0)
+		Expect(dirPath).To(Equal(".weave-gitops/clusters/cluster-a/user"))
+		Expect(gitClient.WriteCallCount()).To(Equal(0))
+	})
+
+	It("rejects an empty cluster name", func() {
+		addParams.Clusters = []string{"cluster-a", " "}
+
+		err := appSrv.Add(gitClient, gitProviders, addParams)
+		Expect(err).To(MatchError("cluster names must not be empty"))
+	})
+
 	It("validates invalid chartname is handled", func() {
 		addParams.Chart = "invalid_Chartname.bar"
 		addParams.Url = "https://my-chart.com"
@@ -251,6 +295,298 @@ var _ = Describe("Add", func() {
 
 		})
 	})
+
+	Context("reconciliation settings", func() {
+		It("passes the settings to the application", func() {
+			prune := false
+			addParams.SourceInterval = 10 * time.Minute
+			addParams.Interval = 5 * time.Minute
+			addParams.Timeout = 2 * time.Minute
+			addParams.Prune = &prune
+			addParams.Wait = true
+			addParams.HealthChecks = []string{"Deployment/podinfo.default", "HelmRelease/redis"}
+
+			app, err := makeApplication(addParams)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(app.SourceInterval).To(Equal(10 * time.Minute))
+			Expect(app.Interval).To(Equal(5 * time.Minute))
+			Expect(app.Timeout).To(Equal(2 * time.Minute))
+			Expect(app.DisablePrune).To(BeTrue())
+			Expect(app.Wait).To(BeTrue())
+			Expect(app.HealthChecks).To(Equal([]models.HealthCheck{
+				{Kind: "Deployment", Name: "podinfo", Namespace: "default"},
+				{APIVersion: "helm.toolkit.fluxcd.io/v2beta1", Kind: "HelmRelease", Name: "redis", Namespace: wego.DefaultNamespace},
+			}))
+		})
+
+		It("prunes by default", func() {
+			app, err := makeApplication(addParams)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(app.DisablePrune).To(BeFalse())
+		})
+
+		It("fails on an invalid health check", func() {
+			addParams.HealthChecks = []string{"podinfo"}
+
+			_, err := makeApplication(addParams)
+			Expect(err).To(MatchError("invalid health check \"podinfo\", must be in the format '<kind>/<name>.<namespace>'"))
+		})
+
+		It("rejects kustomize only settings for helm deployments", func() {
+			addParams.DeploymentType = string(wego.DeploymentTypeHelm)
+			addParams.Wait = true
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError("--wait and --health-check are only supported for kustomize deployments"))
+		})
+	})
+
+	Context("helm values", func() {
+		BeforeEach(func() {
+			addParams.DeploymentType = string(wego.DeploymentTypeHelm)
+		})
+
+		It("merges the values yaml, values files and set values in order", func() {
+			dir, err := os.MkdirTemp("", "values")
+			Expect(err).ShouldNot(HaveOccurred())
+			defer os.RemoveAll(dir)
+
+			valuesFile := filepath.Join(dir, "values.yaml")
+			Expect(os.WriteFile(valuesFile, []byte("replicaCount: 2\nimage:\n  tag: v1\n  pullPolicy: Always\n"), 0600)).To(Succeed())
+
+			addParams.HelmValuesYaml = "replicaCount: 1\nservice:\n  type: ClusterIP\n"
+			addParams.HelmValuesFiles = []string{valuesFile}
+			addParams.HelmSetValues = []string{"image.tag=v2"}
+			addParams.HelmValuesFrom = []string{"ConfigMap/podinfo-values", "Secret/podinfo-secrets"}
+
+			app, err := makeApplication(addParams)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(app.HelmValues).To(Equal(map[string]interface{}{
+				"replicaCount": float64(2),
+				"service":      map[string]interface{}{"type": "ClusterIP"},
+				"image":        map[string]interface{}{"tag": "v2", "pullPolicy": "Always"},
+			}))
+			Expect(app.HelmValuesFrom).To(Equal([]models.ValuesReference{
+				{Kind: "ConfigMap", Name: "podinfo-values"},
+				{Kind: "Secret", Name: "podinfo-secrets"},
+			}))
+		})
+
+		It("has no values by default", func() {
+			app, err := makeApplication(addParams)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(app.HelmValues).To(BeNil())
+		})
+
+		It("fails on an invalid values reference", func() {
+			addParams.HelmValuesFrom = []string{"Deployment/podinfo"}
+
+			_, err := makeApplication(addParams)
+			Expect(err).To(MatchError("invalid values reference \"Deployment/podinfo\", kind must be ConfigMap or Secret"))
+		})
+
+		It("rejects values for kustomize deployments", func() {
+			addParams.DeploymentType = string(wego.DeploymentTypeKustomize)
+			addParams.HelmSetValues = []string{"image.tag=v2"}
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError("--values, --set and --values-from are only supported for helm deployments"))
+		})
+	})
+
+	Context("post-build substitution", func() {
+		It("parses the variables and references", func() {
+			addParams.Substitute = []string{"cluster_env=prod", "replicas=2=3"}
+			addParams.SubstituteFrom = []string{"ConfigMap/cluster-vars", "Secret/cluster-secrets"}
+
+			app, err := makeApplication(addParams)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(app.Substitute).To(Equal(map[string]string{"cluster_env": "prod", "replicas": "2=3"}))
+			Expect(app.SubstituteFrom).To(Equal([]models.SubstituteReference{
+				{Kind: "ConfigMap", Name: "cluster-vars"},
+				{Kind: "Secret", Name: "cluster-secrets"},
+			}))
+		})
+
+		It("fails on an invalid variable", func() {
+			addParams.Substitute = []string{"cluster-env=prod"}
+
+			_, err := makeApplication(addParams)
+			Expect(err).To(MatchError("invalid substitute variable \"cluster-env=prod\", must be in the format '<name>=<value>' with a name made of letters, digits and underscores"))
+		})
+
+		It("fails on an invalid reference", func() {
+			addParams.SubstituteFrom = []string{"deployment/podinfo"}
+
+			_, err := makeApplication(addParams)
+			Expect(err).To(MatchError("invalid substitute reference \"deployment/podinfo\", kind must be ConfigMap or Secret"))
+		})
+
+		It("matches the kind of a reference case insensitively", func() {
+			addParams.SubstituteFrom = []string{"configmap/cluster-vars", "SECRET/cluster-secrets"}
+
+			app, err := makeApplication(addParams)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(app.SubstituteFrom).To(Equal([]models.SubstituteReference{
+				{Kind: "ConfigMap", Name: "cluster-vars"},
+				{Kind: "Secret", Name: "cluster-secrets"},
+			}))
+		})
+
+		It("rejects substitution for helm deployments", func() {
+			addParams.DeploymentType = string(wego.DeploymentTypeHelm)
+			addParams.Substitute = []string{"cluster_env=prod"}
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError("--substitute and --substitute-from are only supported for kustomize deployments"))
+		})
+	})
+
+	Context("decryption", func() {
+		It("passes the settings to the application", func() {
+			addParams.DecryptionProvider = "sops"
+			addParams.DecryptionSecret = "sops-age"
+
+			params, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			app, err := makeApplication(params)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(app.Decryption).To(Equal(models.Decryption{Provider: "sops", SecretRef: "sops-age"}))
+		})
+
+		It("rejects an unknown provider", func() {
+			addParams.DecryptionProvider = "vault"
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError("invalid decryption provider \"vault\", only sops is supported"))
+		})
+
+		It("rejects a secret without a provider", func() {
+			addParams.DecryptionSecret = "sops-age"
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError("--decryption-secret requires --decryption-provider"))
+		})
+
+		It("rejects decryption for helm deployments", func() {
+			addParams.DeploymentType = string(wego.DeploymentTypeHelm)
+			addParams.DecryptionProvider = "sops"
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError("--decryption-provider is only supported for kustomize deployments"))
+		})
+	})
+
+	Context("dependencies", func() {
+		BeforeEach(func() {
+			certManager := wego.Application{}
+			certManager.Name = "cert-manager"
+			certManager.Spec = wego.ApplicationSpec{
+				URL:            "https://github.com/foo/cert-manager",
+				Path:           "./deploy",
+				SourceType:     wego.SourceTypeGit,
+				DeploymentType: wego.DeploymentTypeKustomize,
+			}
+
+			kubeClient.GetApplicationsReturns([]wego.Application{certManager}, nil)
+
+			addParams.DependsOn = []string{"cert-manager"}
+		})
+
+		It("adds an app depending on an existing app", func() {
+			Expect(appSrv.Add(gitClient, gitProviders, addParams)).Should(Succeed())
+		})
+
+		It("fails when the dependency does not exist", func() {
+			addParams.DependsOn = []string{"cert-manager", "ingress"}
+
+			err := appSrv.Add(gitClient, gitProviders, addParams)
+			Expect(err).To(MatchError("application bar depends on ingress, which does not exist in namespace wego-system"))
+		})
+
+		It("fails when the dependency has another deployment type", func() {
+			addParams.DeploymentType = string(wego.DeploymentTypeHelm)
+
+			err := appSrv.Add(gitClient, gitProviders, addParams)
+			Expect(err).To(MatchError("application bar is a helm deployment and can only depend on helm deployments, cert-manager is a kustomize deployment"))
+		})
+
+		It("fails when the app depends on itself", func() {
+			addParams.DependsOn = []string{"bar"}
+
+			err := appSrv.Add(gitClient, gitProviders, addParams)
+			Expect(err).To(MatchError("application bar can't depend on itself"))
+		})
+	})
+
+	Context("bucket and oci sources", func() {
+		It("uses the bucket endpoint as the source of the app", func() {
+			addParams.Url = "minio.example.com:9000"
+			addParams.ConfigRepo = "ssh://git@github.com/foo/config.git"
+			addParams.BucketName = "manifests"
+			addParams.BucketProvider = "aws"
+			addParams.BucketSecretRef = "minio-credentials"
+
+			params, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(params.SourceType).To(Equal(wego.SourceTypeBucket))
+			Expect(params.Dir).To(BeEmpty())
+
+			app, err := makeApplication(params)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(app.Bucket).To(Equal(models.BucketSource{
+				Endpoint:  "minio.example.com:9000",
+				Name:      "manifests",
+				Provider:  "aws",
+				SecretRef: "minio-credentials",
+			}))
+		})
+
+		It("rejects an invalid bucket provider", func() {
+			addParams.Url = "minio.example.com:9000"
+			addParams.ConfigRepo = "ssh://git@github.com/foo/config.git"
+			addParams.BucketName = "manifests"
+			addParams.BucketProvider = "azure"
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError("invalid bucket provider \"azure\", must be one of generic, aws or gcp"))
+		})
+
+		It("rejects bucket flags without a bucket", func() {
+			addParams.BucketRegion = "us-east-1"
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError("--bucket must be specified to use the other bucket flags"))
+		})
+
+		It("uses an oci source for charts in an oci registry", func() {
+			kubeClient.GetResourceStub = helmRepositoryCRD("v1beta1", "v1beta2")
+			addParams.Url = "oci://ghcr.io/stefanprodan/charts"
+			addParams.ConfigRepo = "ssh://git@github.com/foo/config.git"
+			addParams.Chart = "podinfo"
+
+			params, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(params.SourceType).To(Equal(wego.SourceTypeOCI))
+			Expect(params.DeploymentType).To(Equal(string(wego.DeploymentTypeHelm)))
+
+			_, crdName, _ := kubeClient.GetResourceArgsForCall(0)
+			Expect(crdName.Name).To(Equal("helmrepositories.source.toolkit.fluxcd.io"))
+		})
+
+		It("rejects charts in an oci registry when the HelmRepositories of the cluster have no type", func() {
+			kubeClient.GetResourceStub = helmRepositoryCRD("v1beta1")
+			addParams.Url = "oci://ghcr.io/stefanprodan/charts"
+			addParams.ConfigRepo = "ssh://git@github.com/foo/config.git"
+			addParams.Chart = "podinfo"
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError("charts in OCI registries need source.toolkit.fluxcd.io/v1beta2 HelmRepositories, please upgrade flux on the cluster"))
+		})
+	})
 })
 
 var _ = Describe("Add Gitlab", func() {
@@ -415,3 +751,34 @@ var _ = Describe("New directory structure", func() {
 	})
 
 })
+
+// helmRepositoryCRD stubs GetResource with a HelmRepository CRD serving the given versions, as source-controller
+// defines them, only v1beta2 has the type field of OCI registries
+func helmRepositoryCRD(versions ...string) func(context.Context, types.NamespacedName, kube.Resource) error {
+	return func(_ context.Context, name types.NamespacedName, resource kube.Resource) error {
+		crd := resource.(*extensionsv1.CustomResourceDefinition)
+		crd.Name = name.Name
+
+		for _, version := range versions {
+			spec := extensionsv1.JSONSchemaProps{
+				Properties: map[string]extensionsv1.JSONSchemaProps{"url": {Type: "string"}},
+			}
+
+			if version == "v1beta2" {
+				spec.Properties["type"] = extensionsv1.JSONSchemaProps{Type: "string"}
+			}
+
+			crd.Spec.Versions = append(crd.Spec.Versions, extensionsv1.CustomResourceDefinitionVersion{
+				Name:   version,
+				Served: true,
+				Schema: &extensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &extensionsv1.JSONSchemaProps{
+						Properties: map[string]extensionsv1.JSONSchemaProps{"spec": spec},
+					},
+				},
+			})
+		}
+
+		return nil
+	}
+}
@@ -2,7 +2,9 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/benbjohnson/clock"
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -16,6 +18,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/logger"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -27,10 +30,16 @@ type AppService interface {
 	Get(name types.NamespacedName) (*wego.Application, error)
 	// GetCommits returns a list of commits for an application
 	GetCommits(gitProvider gitproviders.GitProvider, params CommitParams, application *wego.Application) ([]gitprovider.Commit, error)
+	// GetCommitHistory returns the commits to the source of an application and to its automation in the config repository
+	GetCommitHistory(gitProvider gitproviders.GitProvider, params CommitParams, application *wego.Application) (CommitHistory, error)
+	// Update changes an existing application through the config repository
+	Update(configGit git.Git, gitProvider gitproviders.GitProvider, params UpdateParams) error
 	// Remove removes an application from the cluster
 	Remove(configGit git.Git, gitProvider gitproviders.GitProvider, params RemoveParams) error
 	// Status returns flux resources status and the last successful reconciliation time
 	Status(params StatusParams) (string, string, error)
+	// Watch reports the reconciliation of an app until it is ready or the timeout expires
+	Watch(ctx context.Context, informers cache.Informers, params WatchParams) error
 	// Pause pauses the gitops automation for an app
 	Pause(params PauseParams) error
 	// Unpause resumes the gitops automation for an app
@@ -62,6 +71,53 @@ func New(ctx context.Context, logger logger.Logger, flux flux.Flux, kube kube.Ku
 // Make sure App implements all the required methods.
 var _ AppService = &AppSvc{}
 
+// targetClusters returns the names of the clusters whose config an app change applies to,
+// defaulting to the cluster of the current kube context when none are given. The clusters
+// given must have been installed in the config repo.
+func (a *AppSvc) targetClusters(ctx context.Context, gitProvider gitproviders.GitProvider, configRepo gitproviders.RepoURL, clusters []string) ([]string, error) {
+	if len(clusters) == 0 {
+		clusterName, err := a.Kube.GetClusterName(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{clusterName}, nil
+	}
+
+	seen := map[string]bool{}
+	names := []string{}
+
+	for _, c := range clusters {
+		c = strings.TrimSpace(c)
+		if c == "" {
+			return nil, errors.New("cluster names must not be empty")
+		}
+
+		if !seen[c] {
+			seen[c] = true
+			names = append(names, c)
+		}
+	}
+
+	branch, err := gitProvider.GetDefaultBranch(ctx, configRepo)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting the default branch of the config repository: %w", err)
+	}
+
+	for _, name := range names {
+		files, err := gitProvider.GetRepoDirFiles(ctx, configRepo, git.GetUserPath(name), branch)
+		if err != nil {
+			return nil, fmt.Errorf("cluster %q not found in the config repository: %w", name, err)
+		}
+
+		if len(files) == 0 {
+			return nil, fmt.Errorf("cluster %q not found in the config repository", name)
+		}
+	}
+
+	return names, nil
+}
+
 func (a *AppSvc) getDeploymentType(ctx context.Context, name string, namespace string) (wego.DeploymentType, error) {
 	app, err := a.Kube.GetApplication(ctx, types.NamespacedName{Name: name, Namespace: namespace})
 	if err != nil {
@@ -2,10 +2,20 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+	"github.com/weaveworks/weave-gitops/pkg/services/automation"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 type CommitParams struct {
@@ -14,23 +24,247 @@ type CommitParams struct {
 	GitProviderToken string
 	PageSize         int
 	PageToken        int
+	// IncludeAutomation adds the commits to the automation of the application in the config repository to its history
+	IncludeAutomation bool
+	// Verifier verifies the signatures of the commits in the history when it's set
+	Verifier *git.Verifier
+}
+
+// The repositories the commits in the history of an application come from
+const (
+	// CommitRepositorySource is the repository the application is deployed from
+	CommitRepositorySource = "source"
+	// CommitRepositoryAutomation is the config repository holding the automation of the application
+	CommitRepositoryAutomation = "automation"
+)
+
+// The statuses of the signatures of the commits in the history of an application, besides "verified <key>"
+const (
+	// SignatureUnsigned is the status of the commits which aren't signed
+	SignatureUnsigned = "unsigned"
+	// SignatureUntrusted is the status of the commits signed with a key which isn't trusted
+	SignatureUntrusted = "untrusted"
+	// SignatureInvalid is the status of the commits whose signature doesn't match their content
+	SignatureInvalid = "invalid"
+	// SignatureUnknown is the status of the commits listed with a provider which doesn't return their signature
+	SignatureUnknown = "unknown"
+)
+
+// HistoryCommit is a commit in the history of an application
+type HistoryCommit struct {
+	gitprovider.CommitInfo
+	// Repository is the repository the commit was made to, CommitRepositorySource or CommitRepositoryAutomation
+	Repository string
+	// Applied is true for the commit the cluster last applied from its repository
+	Applied bool
+	// Signature is the status of the signature of the commit, only set when the signatures are verified
+	Signature string
+}
+
+// CommitHistory is a page of the commits to the source of an application and to its automation, newest first
+type CommitHistory struct {
+	Commits []HistoryCommit
+	// SourceRevision is the revision the Kustomization or HelmRelease of the application last applied, e.g. main/<sha>
+	SourceRevision string
+	// AutomationRevision is the revision of the config repository the user Kustomization of the cluster last applied
+	AutomationRevision string
 }
 
 // GetCommits gets a list of commits from the repo/branch saved in the app manifest
 func (a *AppSvc) GetCommits(gitProvider gitproviders.GitProvider, params CommitParams, application *wego.Application) ([]gitprovider.Commit, error) {
-	if application.Spec.SourceType == wego.SourceTypeHelm {
+	if application.IsHelmRepository() {
 		return nil, fmt.Errorf("unable to get commits for a helm chart")
 	}
 
+	if !application.IsGitRepository() {
+		return nil, fmt.Errorf("unable to get commits for a %s source", application.Spec.SourceType)
+	}
+
 	repoUrl, err := gitproviders.NewRepoURL(application.Spec.URL)
 	if err != nil {
 		return nil, fmt.Errorf("error creating normalized url: %w", err)
 	}
 
-	commits, err := gitProvider.GetCommits(context.Background(), repoUrl, application.Spec.Branch, params.PageSize, params.PageToken)
+	commits, err := gitProvider.GetCommits(a.Context, repoUrl, application.Spec.Branch, params.PageSize, params.PageToken)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get commits for repo: %w", err)
 	}
 
 	return commits, nil
 }
+
+// GetCommitHistory gets a page of the commits to the source of an application, merged with the commits to its
+// automation directory in the config repository when params.IncludeAutomation is set. The commits the cluster runs
+// are marked as applied. Only the automation is listed for applications not deployed from a git repository.
+//
+// Each repository is paged separately with the page size and token of the params, and the two pages are merged.
+// A page may then hold up to twice the page size, and the commits are only sorted within a page: a commit of one
+// repository may be on a later page than older commits of the other.
+func (a *AppSvc) GetCommitHistory(gitProvider gitproviders.GitProvider, params CommitParams, application *wego.Application) (CommitHistory, error) {
+	ctx := a.Context
+	history := CommitHistory{Commits: []HistoryCommit{}}
+
+	sourceRevision, err := a.lastAppliedSourceRevision(ctx, application)
+	if err != nil {
+		return CommitHistory{}, err
+	}
+
+	history.SourceRevision = sourceRevision
+
+	// GetCommits fails for sources other than git repositories, which are only listed without their automation
+	if application.IsGitRepository() || !params.IncludeAutomation {
+		commits, err := a.GetCommits(gitProvider, params, application)
+		if err != nil {
+			return CommitHistory{}, err
+		}
+
+		history.add(commits, CommitRepositorySource, sourceRevision, params.Verifier)
+	}
+
+	if !params.IncludeAutomation || application.Spec.ConfigRepo == "" {
+		return history, nil
+	}
+
+	configRepo, err := gitproviders.NewRepoURL(application.Spec.ConfigRepo)
+	if err != nil {
+		return CommitHistory{}, fmt.Errorf("error creating normalized url for config repo: %w", err)
+	}
+
+	app, err := automation.WegoAppToApp(*application)
+	if err != nil {
+		return CommitHistory{}, err
+	}
+
+	branch, err := gitProvider.GetDefaultBranch(ctx, configRepo)
+	if err != nil {
+		return CommitHistory{}, fmt.Errorf("failed getting default branch of config repo: %w", err)
+	}
+
+	commits, err := gitProvider.GetCommitsForPath(ctx, configRepo, branch, automation.AppYamlDir(app), params.PageSize, params.PageToken)
+	if err != nil {
+		return CommitHistory{}, fmt.Errorf("unable to get commits for config repo: %w", err)
+	}
+
+	automationRevision, err := a.lastAppliedAutomationRevision(ctx, application.Namespace)
+	if err != nil {
+		return CommitHistory{}, err
+	}
+
+	history.AutomationRevision = automationRevision
+	history.add(commits, CommitRepositoryAutomation, automationRevision, params.Verifier)
+
+	// Both lists are sorted already, keep each in its order when the dates are equal
+	sort.SliceStable(history.Commits, func(i, j int) bool {
+		return history.Commits[i].CreatedAt.After(history.Commits[j].CreatedAt)
+	})
+
+	return history, nil
+}
+
+// add appends the commits of a repository, skipping the ones already in the history
+// for applications whose automation is in their source repository
+func (h *CommitHistory) add(commits []gitprovider.Commit, repository, appliedRevision string, verifier *git.Verifier) {
+	seen := map[string]bool{}
+
+	for _, c := range h.Commits {
+		seen[c.Sha] = true
+	}
+
+	appliedSha := revisionSha(appliedRevision)
+
+	for _, commit := range commits {
+		info := commit.Get()
+
+		if seen[info.Sha] {
+			continue
+		}
+
+		historyCommit := HistoryCommit{
+			CommitInfo: info,
+			Repository: repository,
+			Applied:    appliedSha != "" && info.Sha == appliedSha,
+		}
+
+		if verifier != nil {
+			historyCommit.Signature = verifySignature(verifier, commit)
+		}
+
+		h.Commits = append(h.Commits, historyCommit)
+	}
+}
+
+// verifySignature returns the status of the signature of a commit
+func verifySignature(verifier *git.Verifier, commit gitprovider.Commit) string {
+	payload, signature, ok := gitproviders.CommitSignature(commit)
+	if !ok {
+		return SignatureUnknown
+	}
+
+	if signature == "" {
+		return SignatureUnsigned
+	}
+
+	key, err := verifier.Verify(payload, signature)
+	if err != nil {
+		if errors.Is(err, git.ErrUntrustedSignature) {
+			return SignatureUntrusted
+		}
+
+		return SignatureInvalid
+	}
+
+	return "verified " + key
+}
+
+// lastAppliedSourceRevision returns the revision the automation of an application last applied. The status written
+// by the application controller is preferred, with a fall back to the flux objects for clusters where it has not
+// reconciled the app yet.
+func (a *AppSvc) lastAppliedSourceRevision(ctx context.Context, application *wego.Application) (string, error) {
+	if application.Status.LastAppliedRevision != "" {
+		return application.Status.LastAppliedRevision, nil
+	}
+
+	name := types.NamespacedName{Name: application.Name, Namespace: application.Namespace}
+
+	switch application.Spec.DeploymentType {
+	case wego.DeploymentTypeKustomize:
+		kust := &kustomizev2.Kustomization{}
+		if err := a.Kube.GetResource(ctx, name, kust); err != nil {
+			return "", fmt.Errorf("failed getting kustomization %s: %w", name, err)
+		}
+
+		return kust.Status.LastAppliedRevision, nil
+	case wego.DeploymentTypeHelm:
+		helm := &helmv2.HelmRelease{}
+		if err := a.Kube.GetResource(ctx, name, helm); err != nil {
+			return "", fmt.Errorf("failed getting helm release %s: %w", name, err)
+		}
+
+		return helm.Status.LastAppliedRevision, nil
+	}
+
+	return "", nil
+}
+
+// lastAppliedAutomationRevision returns the revision of the config repository last applied by the
+// user Kustomization of the cluster, which applies the automation of the applications
+func (a *AppSvc) lastAppliedAutomationRevision(ctx context.Context, namespace string) (string, error) {
+	clusterName, err := a.Kube.GetClusterName(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed getting cluster name: %w", err)
+	}
+
+	name := types.NamespacedName{Name: models.ConstrainResourceName(fmt.Sprintf("%s-user", clusterName)), Namespace: namespace}
+
+	kust := &kustomizev2.Kustomization{}
+	if err := a.Kube.GetResource(ctx, name, kust); err != nil {
+		return "", fmt.Errorf("failed getting kustomization %s: %w", name, err)
+	}
+
+	return kust.Status.LastAppliedRevision, nil
+}
+
+// revisionSha returns the sha of a git revision applied by flux, <branch>/<sha>
+func revisionSha(revision string) string {
+	return revision[strings.LastIndex(revision, "/")+1:]
+}
@@ -1,12 +1,28 @@
 package app
 
 import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/google/go-github/v41/github"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/vendorfakes/fakegitprovider"
+	"golang.org/x/crypto/ssh"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 var _ = Describe("Get Commits", func() {
@@ -61,6 +77,163 @@ var _ = Describe("Get Commits", func() {
 	})
 })
 
+var _ = Describe("Get Commit History", func() {
+	var application *wego.Application
+
+	commitAt := func(sha string, createdAt time.Time) gitprovider.Commit {
+		c := &fakegitprovider.Commit{}
+		c.GetReturns(gitprovider.CommitInfo{Sha: sha, CreatedAt: createdAt})
+
+		return c
+	}
+
+	now := time.Now()
+
+	BeforeEach(func() {
+		application = &wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: wego.DefaultNamespace},
+			Spec: wego.ApplicationSpec{
+				URL:            "https://github.com/foo/bar",
+				Branch:         "main",
+				ConfigRepo:     "https://github.com/foo/config",
+				SourceType:     wego.SourceTypeGit,
+				DeploymentType: wego.DeploymentTypeKustomize,
+			},
+			Status: wego.ApplicationStatus{LastAppliedRevision: "main/source-2"},
+		}
+
+		gitProviders.GetCommitsReturns([]gitprovider.Commit{
+			commitAt("source-1", now),
+			commitAt("source-2", now.Add(-2*time.Hour)),
+		}, nil)
+		gitProviders.GetDefaultBranchReturns("main", nil)
+		gitProviders.GetCommitsForPathReturns([]gitprovider.Commit{
+			commitAt("automation-1", now.Add(-time.Hour)),
+		}, nil)
+
+		kubeClient.GetResourceStub = func(_ context.Context, name types.NamespacedName, resource kube.Resource) error {
+			switch r := resource.(type) {
+			case *kustomizev2.Kustomization:
+				if name.Name == "test-cluster-user" {
+					r.Status.LastAppliedRevision = "main/automation-1"
+				}
+			case *helmv2.HelmRelease:
+				r.Status.LastAppliedRevision = "1.0.0"
+			}
+
+			return nil
+		}
+	})
+
+	It("merges the commits of the source and the automation, newest first", func() {
+		history, err := appSrv.GetCommitHistory(gitProviders, CommitParams{PageSize: 10, IncludeAutomation: true}, application)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(history.SourceRevision).To(Equal("main/source-2"))
+		Expect(history.AutomationRevision).To(Equal("main/automation-1"))
+
+		summary := []string{}
+
+		for _, c := range history.Commits {
+			summary = append(summary, fmt.Sprintf("%s %s %t", c.Sha, c.Repository, c.Applied))
+		}
+
+		Expect(summary).To(Equal([]string{
+			"source-1 source false",
+			"automation-1 automation true",
+			"source-2 source true",
+		}))
+
+		_, repoUrl, branch, path, pageSize, _ := gitProviders.GetCommitsForPathArgsForCall(0)
+		Expect(repoUrl.String()).To(Equal("ssh://git@github.com/foo/config.git"))
+		Expect(branch).To(Equal("main"))
+		Expect(path).To(Equal(".weave-gitops/apps/test"))
+		Expect(pageSize).To(Equal(10))
+	})
+
+	It("lists the commits of the source only unless the automation is included", func() {
+		history, err := appSrv.GetCommitHistory(gitProviders, CommitParams{PageSize: 10}, application)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(history.Commits).To(HaveLen(2))
+		Expect(history.Commits[1].Applied).To(BeTrue())
+		Expect(gitProviders.GetCommitsForPathCallCount()).To(Equal(0))
+	})
+
+	It("doesn't list the commits of the automation twice when it is in the source repository", func() {
+		gitProviders.GetCommitsForPathReturns([]gitprovider.Commit{commitAt("source-2", now.Add(-2*time.Hour))}, nil)
+
+		history, err := appSrv.GetCommitHistory(gitProviders, CommitParams{PageSize: 10, IncludeAutomation: true}, application)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(history.Commits).To(HaveLen(2))
+		Expect(history.Commits[1].Repository).To(Equal(CommitRepositorySource))
+	})
+
+	It("lists the automation of a helm chart", func() {
+		application.Spec.SourceType = wego.SourceTypeHelm
+		application.Spec.DeploymentType = wego.DeploymentTypeHelm
+		application.Status.LastAppliedRevision = ""
+
+		history, err := appSrv.GetCommitHistory(gitProviders, CommitParams{PageSize: 10, IncludeAutomation: true}, application)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(history.SourceRevision).To(Equal("1.0.0"))
+		Expect(history.Commits).To(HaveLen(1))
+		Expect(history.Commits[0].Repository).To(Equal(CommitRepositoryAutomation))
+		Expect(gitProviders.GetCommitsCallCount()).To(Equal(0))
+
+		_, err = appSrv.GetCommitHistory(gitProviders, CommitParams{PageSize: 10}, application)
+		Expect(err).To(MatchError("unable to get commits for a helm chart"))
+	})
+
+	It("verifies the signatures of the commits", func() {
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		signer, err := git.NewSigner(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), "")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		signature, err := signer.Sign(strings.NewReader("tree source-1"))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		sshPublicKey, err := ssh.NewPublicKey(publicKey)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		verifier, err := git.NewVerifier(ssh.MarshalAuthorizedKey(sshPublicKey))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		signed := commitAt("source-1", now).(*fakegitprovider.Commit)
+		signed.APIObjectReturns(&github.Commit{Verification: &github.SignatureVerification{
+			Payload:   github.String("tree source-1"),
+			Signature: github.String(string(signature)),
+		}})
+
+		tampered := commitAt("source-2", now.Add(-time.Hour)).(*fakegitprovider.Commit)
+		tampered.APIObjectReturns(&github.Commit{Verification: &github.SignatureVerification{
+			Payload:   github.String("tree source-2"),
+			Signature: github.String(string(signature)),
+		}})
+
+		unsigned := commitAt("source-3", now.Add(-2*time.Hour)).(*fakegitprovider.Commit)
+		unsigned.APIObjectReturns(&github.Commit{Verification: &github.SignatureVerification{}})
+
+		gitProviders.GetCommitsReturns([]gitprovider.Commit{signed, tampered, unsigned, commitAt("source-4", now.Add(-3*time.Hour))}, nil)
+
+		history, err := appSrv.GetCommitHistory(gitProviders, CommitParams{PageSize: 10, Verifier: verifier}, application)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(history.Commits).To(HaveLen(4))
+		Expect(history.Commits[0].Signature).To(Equal("verified " + ssh.FingerprintSHA256(sshPublicKey)))
+		Expect(history.Commits[1].Signature).To(Equal(SignatureInvalid))
+		Expect(history.Commits[2].Signature).To(Equal(SignatureUnsigned))
+		Expect(history.Commits[3].Signature).To(Equal(SignatureUnknown))
+
+		history, err = appSrv.GetCommitHistory(gitProviders, CommitParams{PageSize: 10}, application)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(history.Commits[0].Signature).To(BeEmpty())
+	})
+})
+
 type fakeCommit struct {
 	commitInfo gitprovider.CommitInfo
 }
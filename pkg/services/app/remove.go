@@ -2,7 +2,10 @@ package app
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/models"
@@ -18,6 +21,12 @@ type RemoveParams struct {
 	DryRun           bool
 	AutoMerge        bool
 	GitProviderToken string
+	Clusters         []string
+	Force            bool
+	// CommitTemplates are the templates of the commit and pull request, the ones not set are taken from the wego config
+	CommitTemplates models.CommitTemplates
+	// PullRequestOptions are the reviewers, labels, assignees and draft status of the pull request
+	PullRequestOptions gitproviders.PullRequestOptions
 }
 
 // Remove removes the Weave GitOps automation for an application
@@ -26,11 +35,17 @@ func (a *AppSvc) Remove(configGit git.Git, gitProvider gitproviders.GitProvider,
 		return nil
 	}
 
-	ctx := context.Background()
+	ctx := a.Context
 
-	clusterName, err := a.Kube.GetClusterName(ctx)
-	if err != nil {
-		return err
+	if !params.Force {
+		wegoapps, err := a.Kube.GetApplications(ctx, params.Namespace)
+		if err != nil {
+			return err
+		}
+
+		if dependents := Dependents(wegoapps, params.Name); len(dependents) > 0 {
+			return fmt.Errorf("application %s is a dependency of %s; remove those first or force the removal", params.Name, strings.Join(dependents, ", "))
+		}
 	}
 
 	application, err := a.Kube.GetApplication(ctx, types.NamespacedName{Namespace: params.Namespace, Name: params.Name})
@@ -44,13 +59,39 @@ func (a *AppSvc) Remove(configGit git.Git, gitProvider gitproviders.GitProvider,
 		return err
 	}
 
-	return a.removeApp(ctx, configGit, gitProvider, app, clusterName, params.AutoMerge)
+	clusterNames, err := a.targetClusters(ctx, gitProvider, app.ConfigRepo, params.Clusters)
+	if err != nil {
+		return err
+	}
+
+	templates, err := models.GetCommitTemplates(ctx, a.Kube, params.Namespace, params.CommitTemplates)
+	if err != nil {
+		return err
+	}
+
+	return a.removeApp(ctx, configGit, gitProvider, app, clusterNames, params.AutoMerge, templates, params.PullRequestOptions)
 }
 
-func (a *AppSvc) removeApp(ctx context.Context, configGit git.Git, gitProvider gitproviders.GitProvider, app models.Application, clusterName string, autoMerge bool) error {
+func (a *AppSvc) removeApp(ctx context.Context, configGit git.Git, gitProvider gitproviders.GitProvider, app models.Application, clusterNames []string, autoMerge bool, templates models.CommitTemplates, prOptions gitproviders.PullRequestOptions) error {
 	repoWriter := gitrepo.NewRepoWriter(app.ConfigRepo, gitProvider, configGit, a.Logger)
 	automationGen := automation.NewAutomationGenerator(gitProvider, a.Flux, a.Logger)
-	gitOpsDirWriter := gitopswriter.NewGitOpsDirectoryWriter(automationGen, repoWriter, a.Osys, a.Logger)
+	gitOpsDirWriter := gitopswriter.NewGitOpsDirectoryWriter(automationGen, repoWriter, a.Osys, a.Logger, templates, prOptions)
+
+	return gitOpsDirWriter.RemoveApplication(ctx, app, clusterNames, autoMerge)
+}
+
+// Dependents returns the names of the applications depending on the named application
+func Dependents(apps []wego.Application, name string) []string {
+	var result []string
+
+	for _, app := range apps {
+		for _, dependency := range app.Spec.DependsOn {
+			if dependency == name {
+				result = append(result, app.Name)
+				break
+			}
+		}
+	}
 
-	return gitOpsDirWriter.RemoveApplication(ctx, app, clusterName, autoMerge)
+	return result
 }
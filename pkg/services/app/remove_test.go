@@ -0,0 +1,61 @@
+package app
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+)
+
+var _ = Describe("Remove", func() {
+	var removeParams RemoveParams
+
+	BeforeEach(func() {
+		removeParams = RemoveParams{
+			Name:      "cert-manager",
+			Namespace: wego.DefaultNamespace,
+			AutoMerge: true,
+		}
+
+		certificates := wego.Application{}
+		certificates.Name = "certificates"
+		certificates.Spec.DependsOn = []string{"cert-manager"}
+
+		podinfo := wego.Application{}
+		podinfo.Name = "podinfo"
+
+		kubeClient.GetApplicationsReturns([]wego.Application{certificates, podinfo}, nil)
+	})
+
+	It("refuses to remove an app other apps depend on", func() {
+		err := appSrv.Remove(gitClient, gitProviders, removeParams)
+		Expect(err).To(MatchError("application cert-manager is a dependency of certificates; remove those first or force the removal"))
+		Expect(kubeClient.GetApplicationCallCount()).To(Equal(0))
+	})
+
+	It("skips the dependency check when forced", func() {
+		removeParams.Force = true
+		kubeClient.GetApplicationReturns(nil, errors.New("application not found"))
+
+		err := appSrv.Remove(gitClient, gitProviders, removeParams)
+		Expect(err).To(MatchError("application not found"))
+		Expect(kubeClient.GetApplicationsCallCount()).To(Equal(0))
+	})
+})
+
+var _ = Describe("Dependents", func() {
+	It("lists the apps depending on an app", func() {
+		apps := []wego.Application{
+			{Spec: wego.ApplicationSpec{DependsOn: []string{"a", "b"}}},
+			{Spec: wego.ApplicationSpec{DependsOn: []string{"b"}}},
+			{},
+		}
+		apps[0].Name = "c"
+		apps[1].Name = "d"
+		apps[2].Name = "e"
+
+		Expect(Dependents(apps, "b")).To(Equal([]string{"c", "d"}))
+		Expect(Dependents(apps, "c")).To(BeEmpty())
+	})
+})
@@ -3,11 +3,15 @@ package app
 import (
 	"context"
 	"fmt"
+	"strings"
+	"text/tabwriter"
 
 	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
 	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
 	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -17,25 +21,91 @@ type StatusParams struct {
 	Name      string
 }
 
+// Status returns a table of the source and automation of an application, as reported by the
+// application controller in its status, and the time of its last successful reconciliation
 func (a *AppSvc) Status(params StatusParams) (string, string, error) {
-	fluxOutput, err := a.Flux.GetAllResourcesStatus(params.Name, params.Namespace)
-	if err != nil {
-		return "", "", fmt.Errorf("failed getting app status: %w", err)
-	}
-
 	ctx := context.Background()
 
-	deploymentType, err := a.getDeploymentType(ctx, params.Name, params.Namespace)
+	application, err := a.Kube.GetApplication(ctx, types.NamespacedName{Name: params.Name, Namespace: params.Namespace})
 	if err != nil {
-		return "", "", fmt.Errorf("failed getting app deployment type: %w", err)
+		return "", "", fmt.Errorf("failed getting application: %w", err)
+	}
+
+	// Prefer the status written by the application controller, and fall back to
+	// the flux objects for clusters where it has not reconciled the app yet.
+	if lastRecon, ok := lastSuccessfulReconciliationFromStatus(application); ok {
+		return statusTable(application), lastRecon, nil
 	}
 
-	lastRecon, err := a.getLastSuccessfulReconciliation(ctx, deploymentType, params)
+	lastRecon, err := a.getLastSuccessfulReconciliation(ctx, application.Spec.DeploymentType, params)
 	if err != nil {
 		return "", "", fmt.Errorf("failed getting last successful reconciliation: %w", err)
 	}
 
-	return string(fluxOutput), lastRecon, nil
+	return statusTable(application), lastRecon, nil
+}
+
+// statusTable prints the SourceReady and Ready conditions of an application like `flux get` prints its objects
+func statusTable(application *wego.Application) string {
+	sourceKind, automationKind := fluxKinds(application)
+
+	out := &strings.Builder{}
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "NAME\tREADY\tMESSAGE\tREVISION\tSUSPENDED")
+
+	rows := []struct {
+		kind      string
+		condition string
+		revision  string
+		suspended bool
+	}{
+		{sourceKind, wego.SourceReadyCondition, application.Status.SourceRevision, false},
+		{automationKind, wego.ReadyCondition, application.Status.LastAppliedRevision, application.Status.Suspended},
+	}
+
+	for _, row := range rows {
+		status, message := metav1.ConditionUnknown, "waiting for the application controller"
+
+		if condition := apimeta.FindStatusCondition(application.Status.Conditions, row.condition); condition != nil {
+			status, message = condition.Status, condition.Message
+		}
+
+		fmt.Fprintf(w, "%s/%s\t%s\t%s\t%s\t%t\n", strings.ToLower(row.kind), application.Name, status, message, row.revision, row.suspended)
+	}
+
+	w.Flush()
+
+	return out.String()
+}
+
+func fluxKinds(application *wego.Application) (string, string) {
+	sourceKind := sourcev1.GitRepositoryKind
+
+	switch application.Spec.SourceType {
+	case wego.SourceTypeHelm, wego.SourceTypeOCI:
+		sourceKind = sourcev1.HelmRepositoryKind
+	case wego.SourceTypeBucket:
+		sourceKind = sourcev1.BucketKind
+	}
+
+	if application.Spec.DeploymentType == wego.DeploymentTypeHelm {
+		return sourceKind, helmv2.HelmReleaseKind
+	}
+
+	return sourceKind, kustomizev2.KustomizationKind
+}
+
+func lastSuccessfulReconciliationFromStatus(application *wego.Application) (string, bool) {
+	if application.Status.LastReconcileTime == nil {
+		return "", false
+	}
+
+	if !apimeta.IsStatusConditionTrue(application.Status.Conditions, wego.ReadyCondition) {
+		return "", false
+	}
+
+	return application.Status.LastReconcileTime.String(), true
 }
 
 func (a *AppSvc) getLastSuccessfulReconciliation(ctx context.Context, deploymentType wego.DeploymentType, params StatusParams) (string, error) {
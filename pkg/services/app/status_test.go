@@ -23,21 +23,41 @@ var _ = Describe("Status", func() {
 			Namespace: "my-namespace",
 		}
 
-		fluxClient.GetAllResourcesStatusStub = func(s1, s2 string) ([]byte, error) {
-			return []byte("status"), nil
+		kubeClient.GetApplicationStub = func(ctx context.Context, name types.NamespacedName) (*wego.Application, error) {
+			return &wego.Application{
+				Spec: wego.ApplicationSpec{DeploymentType: wego.DeploymentTypeKustomize},
+			}, nil
 		}
+	})
 
+	It("prints the status written by the application controller", func() {
 		kubeClient.GetApplicationStub = func(ctx context.Context, name types.NamespacedName) (*wego.Application, error) {
 			return &wego.Application{
-				Spec: wego.ApplicationSpec{DeploymentType: wego.DeploymentTypeKustomize},
+				ObjectMeta: metav1.ObjectMeta{Name: "my-app"},
+				Spec:       wego.ApplicationSpec{DeploymentType: wego.DeploymentTypeHelm, SourceType: wego.SourceTypeHelm},
+				Status: wego.ApplicationStatus{
+					Conditions: []metav1.Condition{
+						{Type: wego.SourceReadyCondition, Status: metav1.ConditionTrue, Message: "Fetched revision: 6.0.0"},
+						{Type: wego.ReadyCondition, Status: metav1.ConditionFalse, Message: "install retries exhausted"},
+					},
+					SourceRevision: "6.0.0",
+					Suspended:      true,
+				},
 			}, nil
 		}
+
+		status, _, err := appSrv.Status(statusParams)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(status).To(Equal(`NAME                    READY   MESSAGE                     REVISION   SUSPENDED
+helmrepository/my-app   True    Fetched revision: 6.0.0     6.0.0      false
+helmrelease/my-app      False   install retries exhausted              true
+`))
 	})
 
-	It("gets all flux resources status", func() {
-		fluxOutput, _, err := appSrv.Status(statusParams)
+	It("prints an unknown status for an application the controller did not reconcile yet", func() {
+		status, _, err := appSrv.Status(statusParams)
 		Expect(err).ShouldNot(HaveOccurred())
-		Expect(fluxOutput).To(Equal("status"))
+		Expect(status).To(MatchRegexp(`kustomization/\s+Unknown\s+waiting for the application controller`))
 	})
 
 	Context("last successful reconciliation", func() {
@@ -95,6 +115,26 @@ var _ = Describe("Status", func() {
 			Expect(lastRecon).To(Equal("2009-11-10 23:00:00 +0000 UTC"))
 		})
 
+		It("returns the time reported by the application controller", func() {
+			lastReconcile := metav1.NewTime(t)
+
+			kubeClient.GetApplicationStub = func(ctx context.Context, name types.NamespacedName) (*wego.Application, error) {
+				return &wego.Application{
+					Spec: wego.ApplicationSpec{DeploymentType: wego.DeploymentTypeKustomize},
+					Status: wego.ApplicationStatus{
+						Conditions:        conditions,
+						LastReconcileTime: &lastReconcile,
+					},
+				}, nil
+			}
+
+			_, lastRecon, err := appSrv.Status(statusParams)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(kubeClient.GetResourceCallCount()).To(Equal(0))
+			Expect(lastRecon).To(Equal("2009-11-10 23:00:00 +0000 UTC"))
+		})
+
 		It("returns safe message when no succesfull reconciliation", func() {
 			kubeClient.GetResourceStub = func(c context.Context, nn types.NamespacedName, r kube.Resource) error {
 				kust, ok := r.(*kustomizev2.Kustomization)
@@ -52,8 +52,10 @@ func (a *AppSvc) syncSource(ctx context.Context, app *wego.Application) error {
 	switch app.Spec.SourceType {
 	case wego.SourceTypeGit:
 		source = &sourcev1.GitRepository{}
-	case wego.SourceTypeHelm:
+	case wego.SourceTypeHelm, wego.SourceTypeOCI:
 		source = &sourcev1.HelmRepository{}
+	case wego.SourceTypeBucket:
+		source = &sourcev1.Bucket{}
 	}
 
 	return a.syncResource(ctx, app, source)
@@ -0,0 +1,192 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+	"github.com/weaveworks/weave-gitops/pkg/services/applicationv2"
+	"github.com/weaveworks/weave-gitops/pkg/services/automation"
+	"github.com/weaveworks/weave-gitops/pkg/services/gitopswriter"
+	"github.com/weaveworks/weave-gitops/pkg/services/gitrepo"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// UpdateParams holds the fields to change on an application. Empty fields are left unchanged.
+type UpdateParams struct {
+	Name                       string
+	Namespace                  string
+	Branch                     string
+	Path                       string
+	Chart                      string
+	HelmReleaseTargetNamespace string
+	SourceInterval             time.Duration
+	Interval                   time.Duration
+	Timeout                    time.Duration
+	Prune                      *bool
+	Clusters                   []string
+	DryRun                     bool
+	AutoMerge                  bool
+	// CommitTemplates are the templates of the commit and pull request, the ones not set are taken from the wego config
+	CommitTemplates models.CommitTemplates
+	// PullRequestOptions are the reviewers, labels, assignees and draft status of the pull request
+	PullRequestOptions gitproviders.PullRequestOptions
+}
+
+// Update changes the spec of an existing application and regenerates its automation in the config repo
+func (a *AppSvc) Update(configGit git.Git, gitProvider gitproviders.GitProvider, params UpdateParams) error {
+	ctx := a.Context
+
+	original, err := applicationv2.NewFetcher(a.Kube.Raw()).Get(ctx, params.Name, params.Namespace)
+	if err != nil {
+		if errors.Is(err, applicationv2.ErrNotFound) {
+			return fmt.Errorf("application %q not found in namespace %q", params.Name, params.Namespace)
+		}
+
+		return fmt.Errorf("failed getting application: %w", err)
+	}
+
+	updated, err := a.applyUpdate(ctx, original, params)
+	if err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(original, updated) {
+		a.Logger.Println("Application %s is already up to date", params.Name)
+		return nil
+	}
+
+	a.printUpdateSummary(original, updated)
+
+	if params.DryRun {
+		return nil
+	}
+
+	clusterNames, err := a.targetClusters(ctx, gitProvider, original.ConfigRepo, params.Clusters)
+	if err != nil {
+		return err
+	}
+
+	appIdentifier, err := a.appIdentifier(ctx, original)
+	if err != nil {
+		return err
+	}
+
+	templates, err := models.GetCommitTemplates(ctx, a.Kube, params.Namespace, params.CommitTemplates)
+	if err != nil {
+		return err
+	}
+
+	repoWriter := gitrepo.NewRepoWriter(original.ConfigRepo, gitProvider, configGit, a.Logger)
+	automationGen := automation.NewAutomationGenerator(gitProvider, a.Flux, a.Logger)
+	gitOpsDirWriter := gitopswriter.NewGitOpsDirectoryWriter(automationGen, repoWriter, a.Osys, a.Logger, templates, params.PullRequestOptions)
+
+	return gitOpsDirWriter.UpdateApplication(ctx, updated, appIdentifier, clusterNames, params.AutoMerge)
+}
+
+// appIdentifier returns the app identifier label of a deployed application. It is computed from the spec
+// the application was added with, so it is read from the application instead of the spec being updated.
+func (a *AppSvc) appIdentifier(ctx context.Context, app models.Application) (string, error) {
+	wegoApp := &wego.Application{}
+	if err := a.Kube.Raw().Get(ctx, types.NamespacedName{Name: app.Name, Namespace: app.Namespace}, wegoApp); err != nil {
+		return "", fmt.Errorf("failed getting application: %w", err)
+	}
+
+	if appIdentifier := wegoApp.Labels[automation.WeGOAppIdentifierLabelKey]; appIdentifier != "" {
+		return appIdentifier, nil
+	}
+
+	return automation.GetAppHash(app), nil
+}
+
+func (a *AppSvc) applyUpdate(ctx context.Context, app models.Application, params UpdateParams) (models.Application, error) {
+	if params.Branch != "" {
+		if app.SourceType != models.SourceTypeGit {
+			return app, errors.New("--branch can only be set for applications with a git repository source")
+		}
+
+		app.Branch = params.Branch
+	}
+
+	if params.Chart != "" {
+		if !app.IsHelmRepository() {
+			return app, errors.New("--chart can only be set for applications with a helm repository source")
+		}
+
+		// the chart of apps with a helm repository source is stored as their path
+		app.Path = params.Chart
+	}
+
+	if params.Path != "" {
+		if app.IsHelmRepository() {
+			return app, errors.New("--path can't be set for applications with a helm repository source, use --chart instead")
+		}
+
+		app.Path = params.Path
+	}
+
+	if params.HelmReleaseTargetNamespace != "" {
+		if app.AutomationType != models.AutomationTypeHelm {
+			return app, errors.New("--helm-release-target-namespace can only be set for helm deployments")
+		}
+
+		if err := utils.ValidateNamespace(params.HelmReleaseTargetNamespace); err != nil {
+			return app, err
+		}
+
+		if ok, _ := a.Kube.NamespacePresent(ctx, params.HelmReleaseTargetNamespace); !ok {
+			return app, fmt.Errorf("Helm Release Target Namespace %s does not exist", params.HelmReleaseTargetNamespace)
+		}
+
+		app.HelmTargetNamespace = params.HelmReleaseTargetNamespace
+	}
+
+	if params.SourceInterval != 0 {
+		app.SourceInterval = params.SourceInterval
+	}
+
+	if params.Interval != 0 {
+		app.Interval = params.Interval
+	}
+
+	if params.Timeout != 0 {
+		app.Timeout = params.Timeout
+	}
+
+	if params.Prune != nil {
+		if app.AutomationType == models.AutomationTypeHelm {
+			return app, errors.New("--prune can only be set for kustomize deployments")
+		}
+
+		app.DisablePrune = !*params.Prune
+	}
+
+	return app, nil
+}
+
+func (a *AppSvc) printUpdateSummary(original, updated models.Application) {
+	a.Logger.Println("Updating application %s:\n", updated.Name)
+
+	printChange := func(field string, from, to interface{}) {
+		if !reflect.DeepEqual(from, to) {
+			a.Logger.Println("%s: %v -> %v", field, from, to)
+		}
+	}
+
+	printChange("Branch", original.Branch, updated.Branch)
+	printChange("Path", original.Path, updated.Path)
+	printChange("Helm release target namespace", original.HelmTargetNamespace, updated.HelmTargetNamespace)
+	printChange("Source interval", original.SourceInterval, updated.SourceInterval)
+	printChange("Interval", original.Interval, updated.Interval)
+	printChange("Timeout", original.Timeout, updated.Timeout)
+	printChange("Prune", !original.DisablePrune, !updated.DisablePrune)
+
+	a.Logger.Println("")
+}
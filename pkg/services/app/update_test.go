@@ -0,0 +1,131 @@
+package app
+
+import (
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/services/automation"
+	"github.com/weaveworks/weave-gitops/pkg/testutils"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Update", func() {
+	var (
+		wegoApp      *wego.Application
+		updateParams UpdateParams
+	)
+
+	BeforeEach(func() {
+		wegoApp = &wego.Application{}
+		wegoApp.Name = "bar"
+		wegoApp.Namespace = wego.DefaultNamespace
+		wegoApp.Spec = wego.ApplicationSpec{
+			URL:            "ssh://git@github.com/foo/bar.git",
+			ConfigRepo:     "ssh://git@github.com/foo/config.git",
+			Branch:         "main",
+			Path:           "./kustomize",
+			SourceType:     wego.SourceTypeGit,
+			DeploymentType: wego.DeploymentTypeKustomize,
+		}
+
+		k8s := fake.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(wegoApp).Build()
+		kubeClient.RawReturns(k8s)
+
+		gitProviders.GetDefaultBranchReturns("main", nil)
+		gitProviders.CreatePullRequestReturns(testutils.DummyPullRequest{}, nil)
+
+		updateParams = UpdateParams{
+			Name:      "bar",
+			Namespace: wego.DefaultNamespace,
+			AutoMerge: true,
+		}
+	})
+
+	It("writes the updated app with its original identifier", func() {
+		updateParams.Branch = "dev"
+		updateParams.Interval = 10 * time.Minute
+
+		Expect(appSrv.Update(gitClient, gitProviders, updateParams)).To(Succeed())
+
+		original, err := automation.WegoAppToApp(*wegoApp)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		path, content := gitClient.WriteArgsForCall(0)
+		Expect(path).To(Equal(".weave-gitops/apps/bar/app.yaml"))
+
+		var written wego.Application
+		Expect(yaml.Unmarshal(content, &written)).To(Succeed())
+		Expect(written.Spec.Branch).To(Equal("dev"))
+		Expect(written.Spec.Interval.Duration).To(Equal(10 * time.Minute))
+		Expect(written.Labels[automation.WeGOAppIdentifierLabelKey]).To(Equal(automation.GetAppHash(original)))
+	})
+
+	It("keeps the identifier label of an application updated before", func() {
+		wegoApp.Labels = map[string]string{automation.WeGOAppIdentifierLabelKey: "wego-first-identifier"}
+		k8s := fake.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(wegoApp).Build()
+		kubeClient.RawReturns(k8s)
+
+		updateParams.Branch = "dev"
+
+		Expect(appSrv.Update(gitClient, gitProviders, updateParams)).To(Succeed())
+
+		_, content := gitClient.WriteArgsForCall(0)
+
+		var written wego.Application
+		Expect(yaml.Unmarshal(content, &written)).To(Succeed())
+		Expect(written.Labels[automation.WeGOAppIdentifierLabelKey]).To(Equal("wego-first-identifier"))
+	})
+
+	It("updates the application in the given clusters", func() {
+		updateParams.Branch = "dev"
+		updateParams.Clusters = []string{"prod-eu", "prod-us"}
+		gitProviders.GetRepoDirFilesReturns([]*gitprovider.CommitFile{{}}, nil)
+
+		Expect(appSrv.Update(gitClient, gitProviders, updateParams)).To(Succeed())
+		Expect(kubeClient.GetClusterNameCallCount()).To(Equal(0))
+		Expect(gitClient.WriteCallCount()).To(Equal(4))
+	})
+
+	It("does nothing when nothing changes", func() {
+		updateParams.Branch = "main"
+
+		Expect(appSrv.Update(gitClient, gitProviders, updateParams)).To(Succeed())
+		Expect(gitClient.CloneCallCount()).To(Equal(0))
+		Expect(gitClient.WriteCallCount()).To(Equal(0))
+	})
+
+	It("does not write anything on a dry run", func() {
+		updateParams.Path = "./deploy"
+		updateParams.DryRun = true
+
+		Expect(appSrv.Update(gitClient, gitProviders, updateParams)).To(Succeed())
+		Expect(gitClient.WriteCallCount()).To(Equal(0))
+		Expect(gitProviders.CreatePullRequestCallCount()).To(Equal(0))
+	})
+
+	It("fails when the application does not exist", func() {
+		updateParams.Name = "foo"
+
+		err := appSrv.Update(gitClient, gitProviders, updateParams)
+		Expect(err).To(MatchError(`application "foo" not found in namespace "wego-system"`))
+	})
+
+	It("rejects a chart for a git source", func() {
+		updateParams.Chart = "podinfo"
+
+		err := appSrv.Update(gitClient, gitProviders, updateParams)
+		Expect(err).To(MatchError("--chart can only be set for applications with a helm repository source"))
+	})
+
+	It("rejects a target namespace for kustomize deployments", func() {
+		updateParams.HelmReleaseTargetNamespace = "other"
+
+		err := appSrv.Update(gitClient, gitProviders, updateParams)
+		Expect(err).To(MatchError("--helm-release-target-namespace can only be set for helm deployments"))
+	})
+})
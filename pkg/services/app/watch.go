@@ -0,0 +1,398 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/helm"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// ErrWatchTimeout is returned by Watch when the application is not ready before the timeout expires
+var ErrWatchTimeout = errors.New("timed out waiting for the application to be ready")
+
+// ErrNotReady is returned by Watch when the automation of the application failed to apply the latest revision
+var ErrNotReady = errors.New("the application failed to become ready")
+
+// WatchParams holds the application to watch and how long to wait for it to be ready
+type WatchParams struct {
+	Name      string
+	Namespace string
+	Timeout   time.Duration
+}
+
+// Watch prints the condition transitions and revision changes of the source and automation of an application,
+// and the events of the objects it deployed, until the automation is ready or the timeout expires.
+// It returns nil once the application is ready, an error wrapping ErrNotReady as soon as the automation
+// fails, and an error wrapping ErrWatchTimeout otherwise.
+func (a *AppSvc) Watch(ctx context.Context, informers cache.Informers, params WatchParams) error {
+	application, err := a.Kube.GetApplication(ctx, types.NamespacedName{Name: params.Name, Namespace: params.Namespace})
+	if err != nil {
+		return fmt.Errorf("failed getting application: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, params.Timeout)
+	defer cancel()
+
+	w := newStatusWatcher(application, a.Clock.Now())
+	w.helmInventory = func(helmRelease *helmv2.HelmRelease) map[string]bool {
+		inventory, err := a.helmReleaseInventory(ctx, helmRelease)
+		if err != nil {
+			a.Logger.Warningf("failed listing the objects of %s, their events won't be reported: %v", objectName(helmRelease), err)
+		}
+
+		return inventory
+	}
+	updates := make(chan interface{})
+
+	send := func(obj interface{}) {
+		select {
+		case updates <- obj:
+		case <-ctx.Done():
+		}
+	}
+
+	handler := toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    send,
+		UpdateFunc: func(_, obj interface{}) { send(obj) },
+	}
+
+	for _, obj := range []client.Object{w.source, w.automation, &corev1.Event{}} {
+		informer, err := informers.GetInformer(ctx, obj)
+		if err != nil {
+			return fmt.Errorf("failed watching %T: %w", obj, err)
+		}
+
+		informer.AddEventHandler(handler)
+	}
+
+	go func() {
+		if err := informers.Start(ctx); err != nil {
+			a.Logger.Warningf("failed starting the informers: %v", err)
+		}
+	}()
+
+	a.Logger.Waitingf("Waiting for application %s to be ready", params.Name)
+
+	for {
+		select {
+		case <-ctx.Done():
+			if w.notReadyReason != "" {
+				return fmt.Errorf("%w: %s", ErrWatchTimeout, w.notReadyReason)
+			}
+
+			return ErrWatchTimeout
+		case obj := <-updates:
+			for _, line := range w.handle(obj) {
+				a.Logger.Println("%s", line)
+			}
+
+			if w.ready {
+				a.Logger.Successf("Application %s is ready", params.Name)
+				return nil
+			}
+
+			if w.failed {
+				return fmt.Errorf("%w: %s", ErrNotReady, w.notReadyReason)
+			}
+		}
+	}
+}
+
+// statusWatcher keeps the last seen state of the objects of an application, to only report what changes
+type statusWatcher struct {
+	source         client.Object
+	automation     client.Object
+	since          time.Time
+	conditions     map[string]metav1.Condition
+	revisions      map[string]string
+	inventory      map[string]bool
+	ready          bool
+	failed         bool
+	notReadyReason string
+	// lastAutomation is the last state of the automation, its readiness is weighed again when the source changes
+	lastAutomation client.Object
+	// helmInventory lists the objects of the last release of a HelmRelease, it returns nil when they are unknown
+	helmInventory   func(*helmv2.HelmRelease) map[string]bool
+	releaseRevision int
+}
+
+func newStatusWatcher(application *wego.Application, since time.Time) *statusWatcher {
+	var source, automation client.Object
+
+	switch application.Spec.SourceType {
+	case wego.SourceTypeHelm, wego.SourceTypeOCI:
+		source = &sourcev1.HelmRepository{}
+	case wego.SourceTypeBucket:
+		source = &sourcev1.Bucket{}
+	default:
+		source = &sourcev1.GitRepository{}
+	}
+
+	if application.Spec.DeploymentType == wego.DeploymentTypeHelm {
+		automation = &helmv2.HelmRelease{}
+	} else {
+		automation = &kustomizev2.Kustomization{}
+	}
+
+	for _, obj := range []client.Object{source, automation} {
+		obj.SetName(application.Name)
+		obj.SetNamespace(application.Namespace)
+	}
+
+	return &statusWatcher{
+		source:     source,
+		automation: automation,
+		since:      since,
+		conditions: map[string]metav1.Condition{},
+		revisions:  map[string]string{},
+		inventory:  map[string]bool{},
+	}
+}
+
+// handle records an object sent by an informer and returns the lines describing what changed
+func (w *statusWatcher) handle(obj interface{}) []string {
+	switch o := obj.(type) {
+	case *corev1.Event:
+		if line, ok := w.eventLine(o); ok {
+			return []string{line}
+		}
+
+		return nil
+	case client.Object:
+		if !isSameObject(o, w.source) && !isSameObject(o, w.automation) {
+			return nil
+		}
+
+		return w.objectLines(o)
+	}
+
+	return nil
+}
+
+func (w *statusWatcher) objectLines(obj client.Object) []string {
+	lines := []string{}
+	name := objectName(obj)
+
+	if withConditions, ok := obj.(meta.ObjectWithStatusConditions); ok {
+		ready := apimeta.FindStatusCondition(*withConditions.GetStatusConditions(), meta.ReadyCondition)
+		if ready != nil {
+			last, seen := w.conditions[name]
+			if !seen || last.Status != ready.Status || last.Reason != ready.Reason || last.Message != ready.Message {
+				lines = append(lines, fmt.Sprintf("%s Ready=%s %s: %s", name, ready.Status, ready.Reason, ready.Message))
+				w.conditions[name] = *ready
+			}
+		}
+
+		if isSameObject(obj, w.automation) {
+			w.lastAutomation = obj
+		}
+	}
+
+	if revision := revisionOf(obj); revision != "" && w.revisions[name] != revision {
+		lines = append(lines, fmt.Sprintf("%s revision %s", name, revision))
+		w.revisions[name] = revision
+	}
+
+	switch o := obj.(type) {
+	case *kustomizev2.Kustomization:
+		if o.Status.Inventory != nil {
+			w.inventory = map[string]bool{}
+
+			for _, entry := range o.Status.Inventory.Entries {
+				w.inventory[inventoryKey(entry.ID)] = true
+			}
+		}
+	case *helmv2.HelmRelease:
+		if o.Status.LastReleaseRevision != 0 && o.Status.LastReleaseRevision != w.releaseRevision && w.helmInventory != nil {
+			if inventory := w.helmInventory(o); inventory != nil {
+				w.inventory = inventory
+				w.releaseRevision = o.Status.LastReleaseRevision
+			}
+		}
+	}
+
+	w.updateReadiness()
+
+	return lines
+}
+
+// updateReadiness weighs the last state of the automation. It failed when it is not ready after trying to apply
+// its current spec and, for kustomizations, the latest revision of the source; a kustomization failing on an older
+// revision may still apply the next one.
+func (w *statusWatcher) updateReadiness() {
+	if w.lastAutomation == nil {
+		return
+	}
+
+	withConditions, ok := w.lastAutomation.(meta.ObjectWithStatusConditions)
+	if !ok {
+		return
+	}
+
+	ready := apimeta.FindStatusCondition(*withConditions.GetStatusConditions(), meta.ReadyCondition)
+	current := observedGeneration(w.lastAutomation) >= w.lastAutomation.GetGeneration()
+
+	w.ready = ready != nil && ready.Status == metav1.ConditionTrue && current
+	w.failed = ready != nil && ready.Status == metav1.ConditionFalse && current && w.attemptedLatestRevision()
+	w.notReadyReason = ""
+
+	if ready != nil && ready.Status != metav1.ConditionTrue {
+		w.notReadyReason = fmt.Sprintf("%s %s: %s", objectName(w.lastAutomation), ready.Reason, ready.Message)
+	}
+}
+
+func (w *statusWatcher) attemptedLatestRevision() bool {
+	k, ok := w.lastAutomation.(*kustomizev2.Kustomization)
+	if !ok {
+		return true
+	}
+
+	revision := w.revisions[objectName(w.source)]
+
+	return revision != "" && k.Status.LastAttemptedRevision == revision
+}
+
+func (w *statusWatcher) eventLine(ev *corev1.Event) (string, bool) {
+	if eventTime(ev).Before(w.since) {
+		return "", false
+	}
+
+	involved := ev.InvolvedObject
+	key := strings.Join([]string{involved.Namespace, involved.Name, involved.Kind}, "/")
+
+	isFluxObject := func(obj client.Object) bool {
+		return obj.GetNamespace() == involved.Namespace && obj.GetName() == involved.Name && kindOf(obj) == involved.Kind
+	}
+
+	if !isFluxObject(w.source) && !isFluxObject(w.automation) && !w.inventory[key] {
+		return "", false
+	}
+
+	return fmt.Sprintf("%s/%s event %s %s: %s", involved.Kind, involved.Name, ev.Type, ev.Reason, ev.Message), true
+}
+
+func isSameObject(a, b client.Object) bool {
+	return kindOf(a) == kindOf(b) && a.GetName() == b.GetName() && a.GetNamespace() == b.GetNamespace()
+}
+
+func objectName(obj client.Object) string {
+	return kindOf(obj) + "/" + obj.GetName()
+}
+
+func kindOf(obj client.Object) string {
+	switch obj.(type) {
+	case *sourcev1.GitRepository:
+		return sourcev1.GitRepositoryKind
+	case *sourcev1.HelmRepository:
+		return sourcev1.HelmRepositoryKind
+	case *sourcev1.Bucket:
+		return sourcev1.BucketKind
+	case *kustomizev2.Kustomization:
+		return kustomizev2.KustomizationKind
+	case *helmv2.HelmRelease:
+		return helmv2.HelmReleaseKind
+	}
+
+	return obj.GetObjectKind().GroupVersionKind().Kind
+}
+
+func revisionOf(obj client.Object) string {
+	switch o := obj.(type) {
+	case *sourcev1.GitRepository:
+		if artifact := o.GetArtifact(); artifact != nil {
+			return artifact.Revision
+		}
+	case *sourcev1.HelmRepository:
+		if artifact := o.GetArtifact(); artifact != nil {
+			return artifact.Revision
+		}
+	case *sourcev1.Bucket:
+		if artifact := o.GetArtifact(); artifact != nil {
+			return artifact.Revision
+		}
+	case *kustomizev2.Kustomization:
+		return o.Status.LastAppliedRevision
+	case *helmv2.HelmRelease:
+		return o.Status.LastAppliedRevision
+	}
+
+	return ""
+}
+
+func observedGeneration(obj client.Object) int64 {
+	switch o := obj.(type) {
+	case *kustomizev2.Kustomization:
+		return o.Status.ObservedGeneration
+	case *helmv2.HelmRelease:
+		return o.Status.ObservedGeneration
+	}
+
+	return 0
+}
+
+// helmReleaseInventory lists the objects of the last release of a HelmRelease as '<namespace>/<name>/<kind>' keys.
+// HelmReleases don't keep an inventory in their status like kustomizations, so the release is read from the
+// secret helm stores it in.
+func (a *AppSvc) helmReleaseInventory(ctx context.Context, helmRelease *helmv2.HelmRelease) (map[string]bool, error) {
+	rls, err := helm.GetLastRelease(ctx, a.Kube, helmRelease)
+	if err != nil || rls == nil {
+		return nil, err
+	}
+
+	inventory := map[string]bool{}
+
+	for _, manifest := range releaseutil.SplitManifests(rls.Manifest) {
+		var obj metav1.PartialObjectMetadata
+		if err := yaml.Unmarshal([]byte(manifest), &obj); err != nil || obj.Kind == "" {
+			continue
+		}
+
+		namespace := obj.Namespace
+		if namespace == "" {
+			namespace = rls.Namespace
+		}
+
+		inventory[strings.Join([]string{namespace, obj.Name, obj.Kind}, "/")] = true
+	}
+
+	return inventory, nil
+}
+
+// inventoryKey turns a kustomization inventory ID, '<namespace>_<name>_<group>_<kind>', into the
+// '<namespace>/<name>/<kind>' key of the involved object of an event
+func inventoryKey(id string) string {
+	parts := strings.Split(id, "_")
+	if len(parts) != 4 {
+		return id
+	}
+
+	return strings.Join([]string{parts[0], parts[1], parts[3]}, "/")
+}
+
+func eventTime(ev *corev1.Event) time.Time {
+	switch {
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	}
+
+	return ev.CreationTimestamp.Time
+}
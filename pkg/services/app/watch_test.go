@@ -0,0 +1,254 @@
+package app
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+)
+
+var _ = Describe("Watch", func() {
+	var (
+		informers   *informertest.FakeInformers
+		watchParams WatchParams
+		done        chan error
+	)
+
+	informerFor := func(obj runtime.Object) *controllertest.FakeInformer {
+		informer, err := informers.FakeInformerFor(obj)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		return informer
+	}
+
+	printed := func() []string {
+		lines := []string{}
+
+		for i := 0; i < log.PrintlnCallCount(); i++ {
+			format, args := log.PrintlnArgsForCall(i)
+			lines = append(lines, fmt.Sprintf(format, args...))
+		}
+
+		return lines
+	}
+
+	kustomization := func(status metav1.ConditionStatus, reason, revision string) *kustomizev2.Kustomization {
+		k := &kustomizev2.Kustomization{}
+		k.Name = "podinfo"
+		k.Namespace = wego.DefaultNamespace
+		k.Generation = 1
+		k.Status.ObservedGeneration = 1
+		k.Status.LastAppliedRevision = revision
+		k.Status.Conditions = []metav1.Condition{{Type: meta.ReadyCondition, Status: status, Reason: reason, Message: "reconciling"}}
+		k.Status.Inventory = &kustomizev2.ResourceInventory{
+			Entries: []kustomizev2.ResourceRef{{ID: "default_podinfo_apps_Deployment", Version: "v1"}},
+		}
+
+		return k
+	}
+
+	event := func(kind, name string, at time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name + "-event", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: name, Namespace: "default"},
+			Type:           corev1.EventTypeNormal,
+			Reason:         "ScalingReplicaSet",
+			Message:        "Scaled up replica set podinfo to 1",
+			LastTimestamp:  metav1.NewTime(at),
+		}
+	}
+
+	BeforeEach(func() {
+		informers = &informertest.FakeInformers{Scheme: kube.CreateScheme()}
+		watchParams = WatchParams{Name: "podinfo", Namespace: wego.DefaultNamespace, Timeout: time.Minute}
+		done = make(chan error, 1)
+
+		application := &wego.Application{}
+		application.Name = "podinfo"
+		application.Namespace = wego.DefaultNamespace
+		application.Spec = wego.ApplicationSpec{SourceType: wego.SourceTypeGit, DeploymentType: wego.DeploymentTypeKustomize}
+		kubeClient.GetApplicationReturns(application, nil)
+	})
+
+	watch := func() {
+		go func() {
+			defer GinkgoRecover()
+			done <- appSrv.Watch(context.Background(), informers, watchParams)
+		}()
+
+		Eventually(log.WaitingfCallCount).Should(Equal(1))
+	}
+
+	It("reports the reconciliation until the application is ready", func() {
+		watch()
+
+		source := &sourcev1.GitRepository{}
+		source.Name = "podinfo"
+		source.Namespace = wego.DefaultNamespace
+		source.Status.Artifact = &sourcev1.Artifact{Revision: "main/abc123"}
+		source.Status.Conditions = []metav1.Condition{{Type: meta.ReadyCondition, Status: metav1.ConditionTrue, Reason: "GitOperationSucceed", Message: "fetched"}}
+		informerFor(source).Add(source)
+
+		progressing := kustomization(metav1.ConditionUnknown, "Progressing", "")
+		kustomizations := informerFor(progressing)
+		kustomizations.Add(progressing)
+
+		events := informerFor(&corev1.Event{})
+		events.Add(event("Deployment", "podinfo", time.Now()))
+		events.Add(event("Deployment", "other", time.Now()))
+		events.Add(event("Deployment", "podinfo", time.Now().Add(-time.Hour)))
+
+		kustomizations.Update(progressing, kustomization(metav1.ConditionTrue, meta.ReconciliationSucceededReason, "main/abc123"))
+
+		Eventually(done).Should(Receive(BeNil()))
+		Expect(printed()).To(Equal([]string{
+			"GitRepository/podinfo Ready=True GitOperationSucceed: fetched",
+			"GitRepository/podinfo revision main/abc123",
+			"Kustomization/podinfo Ready=Unknown Progressing: reconciling",
+			"Deployment/podinfo event Normal ScalingReplicaSet: Scaled up replica set podinfo to 1",
+			"Kustomization/podinfo Ready=True ReconciliationSucceeded: reconciling",
+			"Kustomization/podinfo revision main/abc123",
+		}))
+
+		Expect(log.SuccessfCallCount()).To(Equal(1))
+	})
+
+	It("ignores the objects of other applications", func() {
+		watchParams.Timeout = 200 * time.Millisecond
+		watch()
+
+		other := kustomization(metav1.ConditionTrue, meta.ReconciliationSucceededReason, "main/abc123")
+		other.Name = "other"
+		informerFor(other).Add(other)
+
+		Eventually(done).Should(Receive(Equal(ErrWatchTimeout)))
+		Expect(printed()).To(BeEmpty())
+	})
+
+	It("fails with the reason the application is not ready when the timeout expires", func() {
+		watchParams.Timeout = 200 * time.Millisecond
+		watch()
+
+		failed := kustomization(metav1.ConditionFalse, "HealthCheckFailed", "main/abc123")
+		failed.Status.Conditions[0].Message = "timeout waiting for: [Deployment/default/podinfo status: 'InProgress']"
+		informerFor(failed).Add(failed)
+
+		var err error
+		Eventually(done).Should(Receive(&err))
+		Expect(errors.Is(err, ErrWatchTimeout)).To(BeTrue())
+		Expect(err).To(MatchError("timed out waiting for the application to be ready: Kustomization/podinfo HealthCheckFailed: timeout waiting for: [Deployment/default/podinfo status: 'InProgress']"))
+	})
+
+	It("fails as soon as the automation fails to apply the latest revision of the source", func() {
+		watch()
+
+		source := &sourcev1.GitRepository{}
+		source.Name = "podinfo"
+		source.Namespace = wego.DefaultNamespace
+		source.Status.Artifact = &sourcev1.Artifact{Revision: "main/def456"}
+		informerFor(source).Add(source)
+
+		failedBefore := kustomization(metav1.ConditionFalse, "BuildFailed", "main/abc123")
+		failedBefore.Status.LastAttemptedRevision = "main/abc123"
+		kustomizations := informerFor(failedBefore)
+		kustomizations.Add(failedBefore)
+
+		Consistently(done, 100*time.Millisecond).ShouldNot(Receive())
+
+		failed := kustomization(metav1.ConditionFalse, "BuildFailed", "main/abc123")
+		failed.Status.LastAttemptedRevision = "main/def456"
+		failed.Status.Conditions[0].Message = "kustomize build failed"
+		kustomizations.Update(failedBefore, failed)
+
+		var err error
+		Eventually(done).Should(Receive(&err))
+		Expect(errors.Is(err, ErrNotReady)).To(BeTrue())
+		Expect(err).To(MatchError("the application failed to become ready: Kustomization/podinfo BuildFailed: kustomize build failed"))
+	})
+
+	It("reports the events of the objects of a helm release", func() {
+		application := &wego.Application{}
+		application.Name = "podinfo"
+		application.Namespace = wego.DefaultNamespace
+		application.Spec = wego.ApplicationSpec{SourceType: wego.SourceTypeHelm, DeploymentType: wego.DeploymentTypeHelm}
+		kubeClient.GetApplicationReturns(application, nil)
+		kubeClient.GetSecretReturns(helmReleaseSecret(&release.Release{
+			Name:      "podinfo",
+			Namespace: "default",
+			Manifest:  "---\n# Source: podinfo/templates/deployment.yaml\napiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: podinfo\n",
+		}), nil)
+
+		watch()
+
+		helmRelease := &helmv2.HelmRelease{}
+		helmRelease.Name = "podinfo"
+		helmRelease.Namespace = wego.DefaultNamespace
+		helmRelease.Status.LastReleaseRevision = 1
+		helmRelease.Status.Conditions = []metav1.Condition{{Type: meta.ReadyCondition, Status: metav1.ConditionUnknown, Reason: "Progressing", Message: "installing"}}
+		helmReleases := informerFor(helmRelease)
+		helmReleases.Add(helmRelease)
+
+		events := informerFor(&corev1.Event{})
+		events.Add(event("Deployment", "podinfo", time.Now()))
+		events.Add(event("Deployment", "other", time.Now()))
+
+		installed := helmRelease.DeepCopy()
+		installed.Status.Conditions[0] = metav1.Condition{Type: meta.ReadyCondition, Status: metav1.ConditionTrue, Reason: "InstallSucceeded", Message: "installed"}
+		helmReleases.Update(helmRelease, installed)
+
+		Eventually(done).Should(Receive(BeNil()))
+		Expect(printed()).To(Equal([]string{
+			"HelmRelease/podinfo Ready=Unknown Progressing: installing",
+			"Deployment/podinfo event Normal ScalingReplicaSet: Scaled up replica set podinfo to 1",
+			"HelmRelease/podinfo Ready=True InstallSucceeded: installed",
+		}))
+
+		Expect(kubeClient.GetSecretCallCount()).To(Equal(1))
+		_, name := kubeClient.GetSecretArgsForCall(0)
+		Expect(name).To(Equal(types.NamespacedName{Name: "sh.helm.release.v1.podinfo.v1", Namespace: wego.DefaultNamespace}))
+	})
+
+	It("fails when the application does not exist", func() {
+		kubeClient.GetApplicationReturns(nil, errors.New("not found"))
+
+		err := appSrv.Watch(context.Background(), informers, watchParams)
+		Expect(err).To(MatchError("failed getting application: not found"))
+	})
+})
+
+// helmReleaseSecret stores a release in a secret the way the secret storage driver of helm does
+func helmReleaseSecret(rls *release.Release) *corev1.Secret {
+	data, err := json.Marshal(rls)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	var compressed bytes.Buffer
+
+	w := gzip.NewWriter(&compressed)
+	_, err = w.Write(data)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(w.Close()).To(Succeed())
+
+	return &corev1.Secret{
+		Data: map[string][]byte{"release": []byte(base64.StdEncoding.EncodeToString(compressed.Bytes()))},
+	}
+}
@@ -5,8 +5,8 @@ import (
 	"errors"
 
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
-	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/models"
+	"github.com/weaveworks/weave-gitops/pkg/services/automation"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -73,43 +73,7 @@ func (f fetcher) List(ctx context.Context, namespace string) ([]models.Applicati
 }
 
 func translateApp(app wego.Application) (models.Application, error) {
-	var (
-		appRepoUrl    gitproviders.RepoURL
-		configRepoUrl gitproviders.RepoURL
-		err           error
-		helmSourceURL string
-	)
-
-	if wego.DeploymentType(app.Spec.SourceType) == wego.DeploymentType(wego.SourceTypeGit) {
-		appRepoUrl, err = gitproviders.NewRepoURL(app.Spec.URL)
-		if err != nil {
-			return models.Application{}, err
-		}
-	}
-
-	if wego.DeploymentType(app.Spec.SourceType) == wego.DeploymentType(wego.SourceTypeHelm) {
-		helmSourceURL = app.Spec.URL
-	}
-
-	if models.IsExternalConfigRepo(app.Spec.ConfigRepo) {
-		configRepoUrl, err = gitproviders.NewRepoURL(app.Spec.ConfigRepo)
-		if err != nil {
-			return models.Application{}, err
-		}
-	}
-
-	return models.Application{
-		Name:                app.Name,
-		Namespace:           app.Namespace,
-		HelmSourceURL:       helmSourceURL,
-		GitSourceURL:        appRepoUrl,
-		ConfigRepo:          configRepoUrl,
-		Branch:              app.Spec.Branch,
-		Path:                app.Spec.Path,
-		HelmTargetNamespace: app.Spec.HelmTargetNamespace,
-		SourceType:          models.SourceType(app.Spec.SourceType),
-		AutomationType:      models.AutomationType(app.Spec.DeploymentType),
-	}, nil
+	return automation.WegoAppToApp(app)
 }
 
 // FetcherFactory implementations should create applicationv2.Fetcher objects
@@ -45,6 +45,13 @@ type ProviderTokenValidator interface {
 	ValidateToken(ctx context.Context, token string) error
 }
 
+// HostTokenValidator validates the tokens of the git providers hosted on servers of their own,
+// against the API of the server with the given hostname
+//counterfeiter:generate . HostTokenValidator
+type HostTokenValidator interface {
+	ValidateToken(ctx context.Context, hostname, token string) error
+}
+
 type SecretName struct {
 	Name      models.GeneratedSecretName
 	Namespace string
@@ -67,7 +74,7 @@ func (sn SecretName) NamespacedName() types.NamespacedName {
 }
 
 type AuthService interface {
-	CreateGitClient(ctx context.Context, repoUrl gitproviders.RepoURL, namespace string, dryRun bool) (git.Git, error)
+	CreateGitClient(ctx context.Context, repoUrl gitproviders.RepoURL, namespace string, dryRun bool, opts ...git.Option) (git.Git, error)
 	GetGitProvider() gitproviders.GitProvider
 	SetupDeployKey(ctx context.Context, namespace string, repo gitproviders.RepoURL) (*ssh.PublicKeys, error)
 }
@@ -98,10 +105,11 @@ func (a *authSvc) GetGitProvider() gitproviders.GitProvider {
 
 // CreateGitClient creates a git.Git client instrumented with existing or generated deploy keys.
 // This ensures that git operations are done with stored deploy keys instead of a user's local ssh-agent or equivalent.
-func (a *authSvc) CreateGitClient(ctx context.Context, repoUrl gitproviders.RepoURL, namespace string, dryRun bool) (git.Git, error) {
+// The options, such as the signer of the commits, are passed on to the client.
+func (a *authSvc) CreateGitClient(ctx context.Context, repoUrl gitproviders.RepoURL, namespace string, dryRun bool, opts ...git.Option) (git.Git, error) {
 	if dryRun {
 		d, _ := makePublicKey([]byte(""))
-		return git.New(d, wrapper.NewGoGit()), nil
+		return git.New(d, wrapper.NewGoGit(), opts...), nil
 	}
 
 	pubKey, keyErr := a.SetupDeployKey(ctx, namespace, repoUrl)
@@ -112,11 +120,11 @@ func (a *authSvc) CreateGitClient(ctx context.Context, repoUrl gitproviders.Repo
 	if pubKey == nil {
 		// Don't return git.New(pubkey, wrapper.NewGoGit()), nil here. It will fail
 		// "nil" of type *ssh.PublicKeys does not behave correctly
-		return git.New(nil, wrapper.NewGoGit()), nil
+		return git.New(nil, wrapper.NewGoGit(), opts...), nil
 	}
 
 	// Set the git client to use the existing deploy key.
-	return git.New(pubKey, wrapper.NewGoGit()), nil
+	return git.New(pubKey, wrapper.NewGoGit(), opts...), nil
 }
 
 // SetupDeployKey creates a git.Git client instrumented with existing or generated deploy keys.
@@ -140,7 +148,10 @@ func (a *authSvc) SetupDeployKey(ctx context.Context, namespace string, repo git
 			// Users might end up here if we uploaded the deploy key, but it failed to save on the cluster,
 			// or if a cluster was destroyed during development work.
 			// Create and upload a new deploy key.
-			a.logger.Warningf("A deploy key named %s was found on the git provider, but not in the cluster.", secretName.Name)
+			if _, ok := a.gitProvider.(gitproviders.UserKeyProvider); !ok {
+				a.logger.Warningf("A deploy key named %s was found on the git provider, but not in the cluster.", secretName.Name)
+			}
+
 			return a.provisionDeployKey(ctx, secretName, repo)
 		} else if err != nil {
 			return nil, fmt.Errorf("error retrieving deploy key: %w", err)
@@ -161,6 +172,10 @@ func (a *authSvc) SetupDeployKey(ctx context.Context, namespace string, repo git
 }
 
 func (a *authSvc) provisionDeployKey(ctx context.Context, name SecretName, repo gitproviders.RepoURL) (*ssh.PublicKeys, error) {
+	if userKey, ok := a.gitProvider.(gitproviders.UserKeyProvider); ok {
+		return a.provisionUserKey(ctx, name, repo, userKey.PrivateKeyFile())
+	}
+
 	deployKey, secret, err := a.generateDeployKey(name, repo)
 	if err != nil {
 		return nil, fmt.Errorf("error generating deploy key: %w", err)
@@ -181,6 +196,32 @@ func (a *authSvc) provisionDeployKey(ctx context.Context, name SecretName, repo
 	return deployKey, nil
 }
 
+// Stores the private key of the user in the cluster, for the git providers deploy keys can't be uploaded to.
+func (a *authSvc) provisionUserKey(ctx context.Context, name SecretName, repo gitproviders.RepoURL, privateKeyFile string) (*ssh.PublicKeys, error) {
+	if privateKeyFile == "" {
+		return nil, fmt.Errorf("a private key with access to %s is required, set it with --git-private-key-file", repo)
+	}
+
+	secretData, err := a.fluxClient.CreateSecretGitWithKey(name.Name.String(), repo, name.Namespace, privateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not create git secret: %w", err)
+	}
+
+	var secret corev1.Secret
+
+	if err := yaml.Unmarshal(secretData, &secret); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal created secret: %w", err)
+	}
+
+	if err := a.storeDeployKey(ctx, &secret); err != nil {
+		return nil, fmt.Errorf("error storing deploy key: %w", err)
+	}
+
+	a.logger.Println("Private key %s stored as the deploy key", privateKeyFile)
+
+	return makePublicKey(extractPrivateKey(&secret))
+}
+
 // Generates an ssh keypair for upload to the Git Provider and for use in a git.Git client.
 func (a *authSvc) generateDeployKey(secretName SecretName, repo gitproviders.RepoURL) (*ssh.PublicKeys, *corev1.Secret, error) {
 	secret, err := a.createKeyPairSecret(secretName, repo)
@@ -2,6 +2,10 @@ package auth
 
 import (
 	"context"
+	cryptorand "crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 
 	"github.com/weaveworks/weave-gitops/pkg/models"
 
@@ -9,13 +13,16 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/flux/fluxfakes"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders/gitprovidersfakes"
 	"github.com/weaveworks/weave-gitops/pkg/logger/loggerfakes"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"sigs.k8s.io/yaml"
 )
 
 type actualFluxRunner struct {
@@ -109,5 +116,69 @@ var _ = Describe("auth", func() {
 			Expect(gp.UploadDeployKeyCallCount()).To(Equal(1))
 		})
 
+		Describe("a git provider deploy keys can't be uploaded to", func() {
+			var fakeFlux *fluxfakes.FakeFlux
+
+			BeforeEach(func() {
+				gp.DeployKeyExistsReturns(true, nil)
+				fakeFlux = &fluxfakes.FakeFlux{}
+
+				privateKey, err := rsa.GenerateKey(cryptorand.Reader, 2048)
+				Expect(err).NotTo(HaveOccurred())
+
+				identity := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+				secret := &corev1.Secret{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+					ObjectMeta: metav1.ObjectMeta{Name: secretName.String(), Namespace: namespace.Name},
+					StringData: map[string]string{"identity": string(identity)},
+				}
+				secretData, err := yaml.Marshal(secret)
+				Expect(err).NotTo(HaveOccurred())
+				fakeFlux.CreateSecretGitWithKeyReturns(secretData, nil)
+			})
+
+			It("stores the private key of the user", func() {
+				as = &authSvc{
+					logger:      &loggerfakes.FakeLogger{},
+					fluxClient:  fakeFlux,
+					k8sClient:   k8sClient,
+					gitProvider: userKeyProvider{FakeGitProvider: &gp, privateKeyFile: "/home/user/.ssh/id_rsa"},
+				}
+
+				_, err := as.CreateGitClient(ctx, configRepoUrl, namespace.Name, false)
+				Expect(err).NotTo(HaveOccurred())
+
+				name, _, ns, keyFile := fakeFlux.CreateSecretGitWithKeyArgsForCall(0)
+				Expect(name).To(Equal(secretName.String()))
+				Expect(ns).To(Equal(namespace.Name))
+				Expect(keyFile).To(Equal("/home/user/.ssh/id_rsa"))
+
+				sn := SecretName{Name: secretName, Namespace: namespace.Name}
+				Expect(k8sClient.Get(ctx, sn.NamespacedName(), &corev1.Secret{})).To(Succeed())
+				Expect(gp.UploadDeployKeyCallCount()).To(Equal(0))
+			})
+
+			It("requires a private key from the user", func() {
+				as = &authSvc{
+					logger:      &loggerfakes.FakeLogger{},
+					fluxClient:  fakeFlux,
+					k8sClient:   k8sClient,
+					gitProvider: userKeyProvider{FakeGitProvider: &gp},
+				}
+
+				_, err := as.CreateGitClient(ctx, configRepoUrl, namespace.Name, false)
+				Expect(err).To(MatchError(ContainSubstring("set it with --git-private-key-file")))
+				Expect(fakeFlux.CreateSecretGitWithKeyCallCount()).To(Equal(0))
+			})
+		})
 	})
 })
+
+type userKeyProvider struct {
+	*gitprovidersfakes.FakeGitProvider
+	privateKeyFile string
+}
+
+func (p userKeyProvider) PrivateKeyFile() string {
+	return p.privateKeyFile
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+type bitbucketServerAuth struct {
+	http *http.Client
+}
+
+// NewBitbucketServerAuthClient returns a HostTokenValidator for the personal access tokens of Bitbucket Server.
+// Bitbucket Server has no OAuth flow for the CLI, the tokens are created by the users.
+func NewBitbucketServerAuthClient(client *http.Client) HostTokenValidator {
+	return bitbucketServerAuth{http: client}
+}
+
+func (b bitbucketServerAuth) ValidateToken(ctx context.Context, hostname, token string) error {
+	if hostname == "" {
+		return errors.New("the host of Bitbucket Server is unknown")
+	}
+
+	// The repositories are often cloned with SSH on a port of their own, the API is on the HTTPS port
+	if host, _, err := net.SplitHostPort(hostname); err == nil {
+		hostname = host
+	}
+
+	// The repositories the token can read are listed with any permission, unlike the profile of the user
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://%s/rest/api/1.0/profile/recent/repos?limit=1", hostname), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	_, err = doRequest(req, b.http)
+
+	return err
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	fakehttp "github.com/weaveworks/weave-gitops/pkg/vendorfakes/http"
+)
+
+var _ = Describe("Bitbucket Server ValidateToken", func() {
+	var rt *fakehttp.FakeRoundTripper
+
+	BeforeEach(func() {
+		rt = &fakehttp.FakeRoundTripper{}
+	})
+
+	It("returns unauthenticated on an invalid token", func() {
+		rt.RoundTripReturns(&http.Response{StatusCode: http.StatusUnauthorized, Body: http.NoBody}, nil)
+
+		Expect(NewBitbucketServerAuthClient(&http.Client{Transport: rt}).ValidateToken(context.Background(), "bitbucket.example.com", "sometoken")).To(HaveOccurred())
+	})
+
+	It("does not return an error when a token is valid", func() {
+		rt.RoundTripReturns(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil)
+
+		Expect(NewBitbucketServerAuthClient(&http.Client{Transport: rt}).ValidateToken(context.Background(), "bitbucket.example.com", "sometoken")).To(Succeed())
+
+		req := rt.RoundTripArgsForCall(0)
+		Expect(req.URL.String()).To(Equal("https://bitbucket.example.com/rest/api/1.0/profile/recent/repos?limit=1"))
+		Expect(req.Header.Get("Authorization")).To(Equal("Bearer sometoken"))
+	})
+
+	It("calls the API on the HTTPS port of a host cloned with SSH on another port", func() {
+		rt.RoundTripReturns(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil)
+
+		Expect(NewBitbucketServerAuthClient(&http.Client{Transport: rt}).ValidateToken(context.Background(), "bitbucket.example.com:7999", "sometoken")).To(Succeed())
+		Expect(rt.RoundTripArgsForCall(0).URL.Host).To(Equal("bitbucket.example.com"))
+	})
+
+	It("requires the host of Bitbucket Server", func() {
+		err := NewBitbucketServerAuthClient(&http.Client{Transport: rt}).ValidateToken(context.Background(), "", "sometoken")
+		Expect(err).To(MatchError("the host of Bitbucket Server is unknown"))
+		Expect(rt.RoundTripCallCount()).To(Equal(0))
+	})
+})
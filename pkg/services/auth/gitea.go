@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+const giteaHost = "gitea.com"
+
+type giteaAuth struct {
+	http *http.Client
+}
+
+// NewGiteaAuthClient returns a HostTokenValidator for the access tokens of Gitea
+func NewGiteaAuthClient(client *http.Client) HostTokenValidator {
+	return giteaAuth{http: client}
+}
+
+// ValidateToken validates a token against gitea.com when no hostname is given
+func (g giteaAuth) ValidateToken(ctx context.Context, hostname, token string) error {
+	if hostname == "" {
+		hostname = giteaHost
+	}
+
+	// The repositories are often cloned with SSH on a port of their own, the API is on the HTTPS port
+	if host, _, err := net.SplitHostPort(hostname); err == nil {
+		hostname = host
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://%s/api/v1/user", hostname), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("token %s", token))
+
+	_, err = doRequest(req, g.http)
+
+	return err
+}
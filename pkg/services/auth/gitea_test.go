@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	fakehttp "github.com/weaveworks/weave-gitops/pkg/vendorfakes/http"
+)
+
+var _ = Describe("Gitea ValidateToken", func() {
+	var rt *fakehttp.FakeRoundTripper
+
+	BeforeEach(func() {
+		rt = &fakehttp.FakeRoundTripper{}
+	})
+
+	It("returns unauthenticated on an invalid token", func() {
+		rt.RoundTripReturns(&http.Response{StatusCode: http.StatusUnauthorized, Body: http.NoBody}, nil)
+
+		Expect(NewGiteaAuthClient(&http.Client{Transport: rt}).ValidateToken(context.Background(), "", "sometoken")).To(HaveOccurred())
+	})
+
+	It("validates the token against gitea.com by default", func() {
+		rt.RoundTripReturns(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil)
+
+		Expect(NewGiteaAuthClient(&http.Client{Transport: rt}).ValidateToken(context.Background(), "", "sometoken")).To(Succeed())
+
+		req := rt.RoundTripArgsForCall(0)
+		Expect(req.URL.String()).To(Equal("https://gitea.com/api/v1/user"))
+		Expect(req.Header.Get("Authorization")).To(Equal("token sometoken"))
+	})
+
+	It("validates the token against the given host", func() {
+		rt.RoundTripReturns(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil)
+
+		Expect(NewGiteaAuthClient(&http.Client{Transport: rt}).ValidateToken(context.Background(), "gitea.example.com:2222", "sometoken")).To(Succeed())
+		Expect(rt.RoundTripArgsForCall(0).URL.Host).To(Equal("gitea.example.com"))
+	})
+})
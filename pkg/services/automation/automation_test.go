@@ -4,14 +4,24 @@ import (
 	"context"
 	"crypto/md5"
 	"fmt"
+	"strings"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/fluxcd/pkg/runtime/dependency"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/flux"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/models"
+	"github.com/weaveworks/weave-gitops/pkg/osys"
+	"github.com/weaveworks/weave-gitops/pkg/runner/runnerfakes"
 	"sigs.k8s.io/yaml"
-	"strings"
 )
 
 var (
@@ -20,6 +30,16 @@ var (
 	emptyRepoURL = gitproviders.RepoURL{}
 )
 
+// newFluxClient returns a flux client generating the manifests in Go, which fails the test if it runs flux
+func newFluxClient() flux.Flux {
+	return flux.New(osys.New(), &runnerfakes.FakeRunner{
+		RunStub: func(string, ...string) ([]byte, error) {
+			Fail("the flux binary should not be called")
+			return nil, nil
+		},
+	})
+}
+
 func createRepoURL(url string) gitproviders.RepoURL {
 	repoURL, err := gitproviders.NewRepoURL(url)
 	Expect(err).NotTo(HaveOccurred())
@@ -57,7 +77,7 @@ var _ = Describe("Generate manifests", func() {
 		Describe("generates source manifest", func() {
 			It("creates GitRepository when source type is git", func() {
 				app.SourceType = models.SourceTypeGit
-				results, err := automationGen.GenerateApplicationAutomation(ctx, app, "test-cluster")
+				results, err := automationGen.GenerateApplicationAutomation(ctx, app)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				Expect(fluxClient.CreateSourceGitCallCount()).To(Equal(1))
@@ -93,7 +113,7 @@ var _ = Describe("Generate manifests", func() {
 				app.Path = "loki"
 				app.SourceType = models.SourceTypeHelm
 
-				results, err := automationGen.GenerateApplicationAutomation(ctx, app, "test-cluster")
+				results, err := automationGen.GenerateApplicationAutomation(ctx, app)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				Expect(fluxClient.CreateSourceHelmCallCount()).To(Equal(1))
@@ -122,7 +142,7 @@ var _ = Describe("Generate manifests", func() {
 
 		Describe("generates application goat", func() {
 			It("creates a kustomization if deployment type kustomize", func() {
-				_, err := automationGen.GenerateApplicationAutomation(ctx, app, "test-cluster")
+				_, err := automationGen.GenerateApplicationAutomation(ctx, app)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				Expect(fluxClient.CreateKustomizationCallCount()).To(Equal(1))
@@ -140,7 +160,7 @@ var _ = Describe("Generate manifests", func() {
 				app.Name = "bar"
 				app.Path = "./charts/my-chart"
 
-				_, err := automationGen.GenerateApplicationAutomation(ctx, app, "test-cluster")
+				_, err := automationGen.GenerateApplicationAutomation(ctx, app)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				Expect(fluxClient.CreateHelmReleaseGitRepositoryCallCount()).To(Equal(1))
@@ -158,7 +178,7 @@ var _ = Describe("Generate manifests", func() {
 				app.AutomationType = models.AutomationTypeHelm
 				app.HelmTargetNamespace = "sock-shop"
 
-				_, err := automationGen.GenerateApplicationAutomation(ctx, app, "test-cluster")
+				_, err := automationGen.GenerateApplicationAutomation(ctx, app)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				Expect(fluxClient.CreateHelmReleaseGitRepositoryCallCount()).To(Equal(1))
@@ -180,7 +200,7 @@ var _ = Describe("Generate manifests", func() {
 
 			Describe("generates source manifest", func() {
 				It("creates GitRepository when source type is git", func() {
-					results, err := automationGen.GenerateApplicationAutomation(ctx, app, "test-cluster")
+					results, err := automationGen.GenerateApplicationAutomation(ctx, app)
 					Expect(err).ShouldNot(HaveOccurred())
 
 					Expect(fluxClient.CreateSourceGitCallCount()).To(Equal(1))
@@ -215,7 +235,7 @@ var _ = Describe("Generate manifests", func() {
 					app.Path = "loki"
 					app.SourceType = models.SourceTypeHelm
 
-					results, err := automationGen.GenerateApplicationAutomation(ctx, app, "test-cluster")
+					results, err := automationGen.GenerateApplicationAutomation(ctx, app)
 					Expect(err).ShouldNot(HaveOccurred())
 
 					Expect(fluxClient.CreateSourceHelmCallCount()).To(Equal(1))
@@ -282,7 +302,7 @@ var _ = Describe("Generate manifests", func() {
 
 			Describe("generates application goat", func() {
 				It("creates a kustomization if deployment type kustomize", func() {
-					_, err := automationGen.GenerateApplicationAutomation(ctx, app, "test-cluster")
+					_, err := automationGen.GenerateApplicationAutomation(ctx, app)
 					Expect(err).ShouldNot(HaveOccurred())
 
 					Expect(fluxClient.CreateKustomizationCallCount()).To(Equal(1))
@@ -302,7 +322,7 @@ var _ = Describe("Generate manifests", func() {
 					app.Name = "loki"
 					app.ConfigRepo = createRepoURL("ssh://github.com/owner/repo")
 
-					_, err := automationGen.GenerateApplicationAutomation(ctx, app, "test-cluster")
+					_, err := automationGen.GenerateApplicationAutomation(ctx, app)
 					Expect(err).ShouldNot(HaveOccurred())
 
 					Expect(fluxClient.CreateHelmReleaseHelmRepositoryCallCount()).To(Equal(1))
@@ -323,7 +343,7 @@ var _ = Describe("Generate manifests", func() {
 					app.Path = "./charts/my-chart"
 					app.AutomationType = models.AutomationTypeHelm
 
-					_, err := automationGen.GenerateApplicationAutomation(ctx, app, "test-cluster")
+					_, err := automationGen.GenerateApplicationAutomation(ctx, app)
 					Expect(err).ShouldNot(HaveOccurred())
 
 					Expect(fluxClient.CreateHelmReleaseGitRepositoryCallCount()).To(Equal(1))
@@ -345,7 +365,7 @@ var _ = Describe("Generate manifests", func() {
 					app.AutomationType = models.AutomationTypeHelm
 					app.ConfigRepo = createRepoURL("ssh://git@github.com/owner/config-repo.git")
 
-					_, err := automationGen.GenerateApplicationAutomation(ctx, app, "test-cluster")
+					_, err := automationGen.GenerateApplicationAutomation(ctx, app)
 					Expect(err).ShouldNot(HaveOccurred())
 
 					Expect(fluxClient.CreateHelmReleaseHelmRepositoryCallCount()).To(Equal(1))
@@ -409,3 +429,306 @@ func getHash(inputs ...string) string {
 	final := []byte(strings.Join(inputs, ""))
 	return fmt.Sprintf("%x", md5.Sum(final))
 }
+
+var _ = Describe("Reconciliation settings", func() {
+	var app models.Application
+
+	BeforeEach(func() {
+		app = models.Application{
+			AutomationType: models.AutomationTypeKustomize,
+			Branch:         "main",
+			GitSourceURL:   createRepoURL("ssh://git@github.com/foo/bar.git"),
+			Name:           "bar",
+			Namespace:      wego.DefaultNamespace,
+			Path:           "./kustomize",
+			SourceType:     models.SourceTypeGit,
+		}
+
+		automationGen = NewAutomationGenerator(gitProviders, newFluxClient(), log)
+		ctx = context.Background()
+	})
+
+	It("sets the source interval", func() {
+		app.SourceInterval = 10 * time.Minute
+
+		results, err := automationGen.GenerateApplicationAutomation(ctx, app)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var gitRepository sourcev1.GitRepository
+		Expect(yaml.Unmarshal(results.AppSource.Content, &gitRepository)).To(Succeed())
+		Expect(gitRepository.Spec.Interval.Duration).To(Equal(10 * time.Minute))
+		Expect(gitRepository.Spec.Ignore).NotTo(BeNil())
+	})
+
+	It("sets the kustomization options", func() {
+		app.Interval = 5 * time.Minute
+		app.Timeout = 2 * time.Minute
+		app.DisablePrune = true
+		app.Wait = true
+		app.HealthChecks = []models.HealthCheck{{Kind: "Deployment", Name: "podinfo", Namespace: "default"}}
+
+		results, err := automationGen.GenerateApplicationAutomation(ctx, app)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var kustomization kustomizev2.Kustomization
+		Expect(yaml.Unmarshal(results.AppAutomation.Content, &kustomization)).To(Succeed())
+		Expect(kustomization.Spec.Interval.Duration).To(Equal(5 * time.Minute))
+		Expect(kustomization.Spec.Timeout.Duration).To(Equal(2 * time.Minute))
+		Expect(kustomization.Spec.Prune).To(BeFalse())
+		Expect(kustomization.Spec.Wait).To(BeTrue())
+		Expect(kustomization.Spec.HealthChecks).To(Equal([]meta.NamespacedObjectKindReference{
+			{Kind: "Deployment", Name: "podinfo", Namespace: "default"},
+		}))
+	})
+
+	It("sets the helm release interval and timeout", func() {
+		app.AutomationType = models.AutomationTypeHelm
+		app.Interval = time.Hour
+		app.Timeout = 10 * time.Minute
+
+		results, err := automationGen.GenerateApplicationAutomation(ctx, app)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var helmRelease helmv2.HelmRelease
+		Expect(yaml.Unmarshal(results.AppAutomation.Content, &helmRelease)).To(Succeed())
+		Expect(helmRelease.Spec.Interval.Duration).To(Equal(time.Hour))
+		Expect(helmRelease.Spec.Timeout.Duration).To(Equal(10 * time.Minute))
+	})
+
+	It("sets the helm release values", func() {
+		app.AutomationType = models.AutomationTypeHelm
+		app.HelmValues = map[string]interface{}{"replicaCount": 2}
+		app.HelmValuesFrom = []models.ValuesReference{{Kind: "ConfigMap", Name: "bar-values"}}
+
+		results, err := automationGen.GenerateApplicationAutomation(ctx, app)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var helmRelease helmv2.HelmRelease
+		Expect(yaml.Unmarshal(results.AppAutomation.Content, &helmRelease)).To(Succeed())
+		Expect(string(helmRelease.Spec.Values.Raw)).To(Equal(`{"replicaCount":2}`))
+		Expect(helmRelease.Spec.ValuesFrom).To(Equal([]helmv2.ValuesReference{{Kind: "ConfigMap", Name: "bar-values"}}))
+
+		result, err := WegoAppToApp(AppToWegoApp(app))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.HelmValues).To(Equal(map[string]interface{}{"replicaCount": float64(2)}))
+		Expect(result.HelmValuesFrom).To(Equal(app.HelmValuesFrom))
+	})
+
+	It("sets the kustomization post-build substitution", func() {
+		app.Substitute = map[string]string{"cluster_env": "prod"}
+		app.SubstituteFrom = []models.SubstituteReference{{Kind: "ConfigMap", Name: "cluster-vars"}}
+
+		results, err := automationGen.GenerateApplicationAutomation(ctx, app)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var kustomization kustomizev2.Kustomization
+		Expect(yaml.Unmarshal(results.AppAutomation.Content, &kustomization)).To(Succeed())
+		Expect(kustomization.Spec.PostBuild).To(Equal(&kustomizev2.PostBuild{
+			Substitute:     map[string]string{"cluster_env": "prod"},
+			SubstituteFrom: []kustomizev2.SubstituteReference{{Kind: "ConfigMap", Name: "cluster-vars"}},
+		}))
+
+		result, err := WegoAppToApp(AppToWegoApp(app))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Substitute).To(Equal(app.Substitute))
+		Expect(result.SubstituteFrom).To(Equal(app.SubstituteFrom))
+	})
+
+	It("sets the dependencies of the automation", func() {
+		app.DependsOn = []string{"cert-manager"}
+
+		results, err := automationGen.GenerateApplicationAutomation(ctx, app)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var kustomization kustomizev2.Kustomization
+		Expect(yaml.Unmarshal(results.AppAutomation.Content, &kustomization)).To(Succeed())
+		Expect(kustomization.Spec.DependsOn).To(Equal([]dependency.CrossNamespaceDependencyReference{{Name: "cert-manager"}}))
+
+		app.AutomationType = models.AutomationTypeHelm
+
+		results, err = automationGen.GenerateApplicationAutomation(ctx, app)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var helmRelease helmv2.HelmRelease
+		Expect(yaml.Unmarshal(results.AppAutomation.Content, &helmRelease)).To(Succeed())
+		Expect(helmRelease.Spec.DependsOn).To(Equal([]dependency.CrossNamespaceDependencyReference{{Name: "cert-manager"}}))
+
+		result, err := WegoAppToApp(AppToWegoApp(app))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.DependsOn).To(Equal(app.DependsOn))
+	})
+
+	It("sets the kustomization decryption", func() {
+		app.Decryption = models.Decryption{Provider: "sops", SecretRef: "sops-age"}
+
+		results, err := automationGen.GenerateApplicationAutomation(ctx, app)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var kustomization kustomizev2.Kustomization
+		Expect(yaml.Unmarshal(results.AppAutomation.Content, &kustomization)).To(Succeed())
+		Expect(kustomization.Spec.Decryption).To(Equal(&kustomizev2.Decryption{
+			Provider:  "sops",
+			SecretRef: &meta.LocalObjectReference{Name: "sops-age"},
+		}))
+
+		wegoApp := AppToWegoApp(app)
+		Expect(wegoApp.Spec.Decryption).To(Equal(&wego.Decryption{Provider: "sops", SecretRef: "sops-age"}))
+
+		result, err := WegoAppToApp(wegoApp)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Decryption).To(Equal(app.Decryption))
+	})
+
+	It("leaves the generated manifests untouched without settings", func() {
+		results, err := automationGen.GenerateApplicationAutomation(ctx, app)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		expected, err := newFluxClient().CreateKustomization(app.Name, app.Name, app.Path, app.Namespace)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(results.AppAutomation.Content)).To(Equal(string(expected)))
+	})
+
+	It("round trips the settings through the app yaml", func() {
+		app.SourceInterval = time.Minute
+		app.DisablePrune = true
+		app.HealthChecks = []models.HealthCheck{{Kind: "Deployment", Name: "podinfo", Namespace: "default"}}
+
+		wegoApp := AppToWegoApp(app)
+		Expect(*wegoApp.Spec.Prune).To(BeFalse())
+
+		result, err := WegoAppToApp(wegoApp)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.SourceInterval).To(Equal(time.Minute))
+		Expect(result.DisablePrune).To(BeTrue())
+		Expect(result.HealthChecks).To(Equal(app.HealthChecks))
+	})
+})
+
+var _ = Describe("Bucket and OCI sources", func() {
+	BeforeEach(func() {
+		automationGen = NewAutomationGenerator(gitProviders, newFluxClient(), log)
+		ctx = context.Background()
+	})
+
+	Context("bucket source", func() {
+		var app models.Application
+
+		BeforeEach(func() {
+			app = models.Application{
+				AutomationType: models.AutomationTypeKustomize,
+				Name:           "bar",
+				Namespace:      wego.DefaultNamespace,
+				Path:           "./deploy",
+				SourceType:     models.SourceTypeBucket,
+				Bucket: models.BucketSource{
+					Endpoint:  "minio.example.com:9000",
+					Name:      "manifests",
+					Region:    "us-east-1",
+					SecretRef: "minio-credentials",
+				},
+			}
+		})
+
+		It("creates a Bucket and a kustomization using it", func() {
+			results, err := automationGen.GenerateApplicationAutomation(ctx, app)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(gitProviders.GetRepoVisibilityCallCount()).To(Equal(0))
+
+			Expect(string(results.AppSource.Content)).To(Equal(`---
+apiVersion: source.toolkit.fluxcd.io/v1beta1
+kind: Bucket
+metadata:
+  name: bar
+  namespace: wego-system
+spec:
+  bucketName: manifests
+  endpoint: minio.example.com:9000
+  interval: 30s
+  provider: generic
+  region: us-east-1
+  secretRef:
+    name: minio-credentials
+`))
+
+			var kustomization kustomizev2.Kustomization
+			Expect(yaml.Unmarshal(results.AppAutomation.Content, &kustomization)).To(Succeed())
+			Expect(kustomization.Spec.SourceRef).To(Equal(kustomizev2.CrossNamespaceSourceReference{Kind: sourcev1.BucketKind, Name: "bar"}))
+		})
+
+		It("sets the source interval", func() {
+			app.SourceInterval = 5 * time.Minute
+
+			results, err := automationGen.GenerateApplicationAutomation(ctx, app)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var bucket sourcev1.Bucket
+			Expect(yaml.Unmarshal(results.AppSource.Content, &bucket)).To(Succeed())
+			Expect(bucket.Spec.Interval.Duration).To(Equal(5 * time.Minute))
+			Expect(bucket.Spec.BucketName).To(Equal("manifests"))
+		})
+
+		It("round trips the bucket through the app yaml", func() {
+			wegoApp := AppToWegoApp(app)
+			Expect(wegoApp.Spec.URL).To(Equal("minio.example.com:9000"))
+			Expect(wegoApp.Spec.Bucket.Name).To(Equal("manifests"))
+
+			result, err := WegoAppToApp(wegoApp)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Bucket).To(Equal(app.Bucket))
+			Expect(SourceKind(result)).To(Equal(ResourceKindBucket))
+		})
+
+		It("hashes the bucket and path", func() {
+			other := app
+			other.Bucket.Name = "other"
+
+			Expect(GetAppHash(app)).NotTo(Equal(GetAppHash(other)))
+		})
+	})
+
+	Context("oci source", func() {
+		var app models.Application
+
+		BeforeEach(func() {
+			app = models.Application{
+				AutomationType: models.AutomationTypeHelm,
+				HelmSourceURL:  "oci://ghcr.io/stefanprodan/charts",
+				Name:           "podinfo",
+				Namespace:      wego.DefaultNamespace,
+				Path:           "podinfo",
+				SourceType:     models.SourceTypeOCI,
+				SourceInterval: 10 * time.Minute,
+			}
+		})
+
+		It("creates an OCI HelmRepository and a helm release using it", func() {
+			results, err := automationGen.GenerateApplicationAutomation(ctx, app)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(string(results.AppSource.Content)).To(Equal(`---
+apiVersion: source.toolkit.fluxcd.io/v1beta2
+kind: HelmRepository
+metadata:
+  name: podinfo
+  namespace: wego-system
+spec:
+  interval: 10m0s
+  type: oci
+  url: oci://ghcr.io/stefanprodan/charts
+`))
+
+			var helmRelease helmv2.HelmRelease
+			Expect(yaml.Unmarshal(results.AppAutomation.Content, &helmRelease)).To(Succeed())
+			Expect(helmRelease.Spec.Chart.Spec.Chart).To(Equal("podinfo"))
+			Expect(helmRelease.Spec.Chart.Spec.SourceRef.Kind).To(Equal(sourcev1.HelmRepositoryKind))
+		})
+
+		It("round trips the registry url through the app yaml", func() {
+			result, err := WegoAppToApp(AppToWegoApp(app))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.SourceType).To(Equal(models.SourceTypeOCI))
+			Expect(result.HelmSourceURL).To(Equal(app.HelmSourceURL))
+			Expect(SourceKind(result)).To(Equal(ResourceKindHelmRepository))
+		})
+	})
+})
@@ -4,12 +4,18 @@ import (
 	"bytes"
 	"context"
 	"crypto/md5"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/fluxcd/pkg/runtime/dependency"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
 	"github.com/fluxcd/source-controller/pkg/sourceignore"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
@@ -19,6 +25,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/models"
 
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/kustomize/api/types"
@@ -27,6 +34,11 @@ import (
 
 const (
 	WeGOAppIdentifierLabelKey = "wego.weave.works/app-identifier"
+	// bucketSourceInterval matches the interval of the git and helm sources generated by flux
+	bucketSourceInterval = 30 * time.Second
+	// OCIHelmRepositoryVersion is the version of the HelmRepositories of OCI registries. The type field marking
+	// them was added in v1beta2, the v1beta1 CRD prunes it.
+	OCIHelmRepositoryVersion = "v1beta2"
 )
 
 type ConfigMode string
@@ -46,12 +58,13 @@ const (
 	ResourceKindSecret         ResourceKind = "Secret"
 	ResourceKindGitRepository  ResourceKind = "GitRepository"
 	ResourceKindHelmRepository ResourceKind = "HelmRepository"
+	ResourceKindBucket         ResourceKind = "Bucket"
 	ResourceKindKustomization  ResourceKind = "Kustomization"
 	ResourceKindHelmRelease    ResourceKind = "HelmRelease"
 )
 
 type AutomationGenerator interface {
-	GenerateApplicationAutomation(ctx context.Context, app models.Application, clusterName string) (ApplicationAutomation, error)
+	GenerateApplicationAutomation(ctx context.Context, app models.Application) (ApplicationAutomation, error)
 	GetSecretRefForPrivateGitSources(ctx context.Context, url gitproviders.RepoURL) (models.GeneratedSecretName, error)
 }
 
@@ -79,7 +92,7 @@ func NewAutomationGenerator(gp gitproviders.GitProvider, flux flux.Flux, logger
 }
 
 func (a *AutomationGen) getAppSecretRef(ctx context.Context, app models.Application) (models.GeneratedSecretName, error) {
-	if app.SourceType != models.SourceTypeHelm {
+	if app.SourceType == models.SourceTypeGit {
 		return a.GetSecretRefForPrivateGitSources(ctx, app.GitSourceURL)
 	}
 
@@ -118,8 +131,10 @@ func (a *AutomationGen) generateAppSource(ctx context.Context, app models.Applic
 		if err == nil {
 			source, err = AddWegoIgnore(source)
 		}
-	case models.SourceTypeHelm:
+	case models.SourceTypeHelm, models.SourceTypeOCI:
 		source, err = a.Flux.CreateSourceHelm(app.Name, app.HelmSourceURL, app.Namespace)
+	case models.SourceTypeBucket:
+		source, err = generateBucketSource(app)
 	default:
 		return models.Manifest{}, fmt.Errorf("unknown source type: %v", app.SourceType)
 	}
@@ -128,9 +143,238 @@ func (a *AutomationGen) generateAppSource(ctx context.Context, app models.Applic
 		return models.Manifest{}, err
 	}
 
+	source, err = setSourceInterval(source, app)
+	if err != nil {
+		return models.Manifest{}, fmt.Errorf("failed to set source interval: %w", err)
+	}
+
+	if app.SourceType == models.SourceTypeOCI {
+		source, err = setOCIRepositoryType(source)
+		if err != nil {
+			return models.Manifest{}, fmt.Errorf("failed to set helm repository type: %w", err)
+		}
+	}
+
 	return models.Manifest{Path: AppAutomationSourcePath(app), Content: source}, nil
 }
 
+// setSourceInterval overrides the interval of a generated source when the application sets one
+func setSourceInterval(sourceManifest []byte, app models.Application) ([]byte, error) {
+	if app.SourceInterval == 0 {
+		return sourceManifest, nil
+	}
+
+	interval := metav1.Duration{Duration: app.SourceInterval}
+
+	switch app.SourceType {
+	case models.SourceTypeGit:
+		var gitRepository sourcev1.GitRepository
+		if err := yaml.Unmarshal(sourceManifest, &gitRepository); err != nil {
+			return nil, err
+		}
+
+		gitRepository.Spec.Interval = interval
+
+		return marshalFluxObject(gitRepository)
+	case models.SourceTypeHelm, models.SourceTypeOCI:
+		var helmRepository sourcev1.HelmRepository
+		if err := yaml.Unmarshal(sourceManifest, &helmRepository); err != nil {
+			return nil, err
+		}
+
+		helmRepository.Spec.Interval = interval
+
+		return marshalFluxObject(helmRepository)
+	case models.SourceTypeBucket:
+		var bucket sourcev1.Bucket
+		if err := yaml.Unmarshal(sourceManifest, &bucket); err != nil {
+			return nil, err
+		}
+
+		bucket.Spec.Interval = interval
+
+		return marshalFluxObject(bucket)
+	}
+
+	return sourceManifest, nil
+}
+
+// generateBucketSource generates the Bucket source of an application, as `flux create source bucket --export` would
+func generateBucketSource(app models.Application) ([]byte, error) {
+	provider := app.Bucket.Provider
+	if provider == "" {
+		provider = sourcev1.GenericBucketProvider
+	}
+
+	bucket := sourcev1.Bucket{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: sourcev1.GroupVersion.String(),
+			Kind:       sourcev1.BucketKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      app.Name,
+			Namespace: app.Namespace,
+		},
+		Spec: sourcev1.BucketSpec{
+			Provider:   provider,
+			BucketName: app.Bucket.Name,
+			Endpoint:   app.Bucket.Endpoint,
+			Insecure:   app.Bucket.Insecure,
+			Region:     app.Bucket.Region,
+			Interval:   metav1.Duration{Duration: bucketSourceInterval},
+		},
+	}
+
+	if app.Bucket.SecretRef != "" {
+		bucket.Spec.SecretRef = &meta.LocalObjectReference{Name: app.Bucket.SecretRef}
+	}
+
+	return marshalFluxObject(bucket)
+}
+
+// setOCIRepositoryType marks a generated HelmRepository as an OCI registry. The v1beta1 HelmRepository
+// type vendored here predates the type field, so it is set on the unstructured manifest along with the
+// version having it.
+func setOCIRepositoryType(sourceManifest []byte) ([]byte, error) {
+	var helmRepository map[string]interface{}
+	if err := yaml.Unmarshal(sourceManifest, &helmRepository); err != nil {
+		return nil, err
+	}
+
+	spec, ok := helmRepository["spec"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("helm repository %v has no spec", helmRepository["metadata"])
+	}
+
+	helmRepository["apiVersion"] = schema.GroupVersion{Group: sourcev1.GroupVersion.Group, Version: OCIHelmRepositoryVersion}.String()
+	spec["type"] = "oci"
+
+	return marshalFluxObject(helmRepository)
+}
+
+// setAutomationOptions applies the reconciliation settings of an application to its generated Kustomization or HelmRelease
+func setAutomationOptions(automationManifest []byte, app models.Application) ([]byte, error) {
+	if !hasAutomationOptions(app) {
+		return automationManifest, nil
+	}
+
+	switch app.AutomationType {
+	case models.AutomationTypeKustomize:
+		var kustomization kustomizev2.Kustomization
+		if err := yaml.Unmarshal(automationManifest, &kustomization); err != nil {
+			return nil, err
+		}
+
+		if app.Interval != 0 {
+			kustomization.Spec.Interval = metav1.Duration{Duration: app.Interval}
+		}
+
+		if app.Timeout != 0 {
+			kustomization.Spec.Timeout = &metav1.Duration{Duration: app.Timeout}
+		}
+
+		if app.DisablePrune {
+			kustomization.Spec.Prune = false
+		}
+
+		kustomization.Spec.Wait = app.Wait
+
+		for _, hc := range app.HealthChecks {
+			kustomization.Spec.HealthChecks = append(kustomization.Spec.HealthChecks, meta.NamespacedObjectKindReference{
+				APIVersion: hc.APIVersion,
+				Kind:       hc.Kind,
+				Name:       hc.Name,
+				Namespace:  hc.Namespace,
+			})
+		}
+
+		if len(app.Substitute) > 0 || len(app.SubstituteFrom) > 0 {
+			kustomization.Spec.PostBuild = &kustomizev2.PostBuild{Substitute: app.Substitute}
+
+			for _, ref := range app.SubstituteFrom {
+				kustomization.Spec.PostBuild.SubstituteFrom = append(kustomization.Spec.PostBuild.SubstituteFrom, kustomizev2.SubstituteReference{
+					Kind: ref.Kind,
+					Name: ref.Name,
+				})
+			}
+		}
+
+		kustomization.Spec.DependsOn = dependsOn(app)
+
+		if app.Decryption.Provider != "" {
+			kustomization.Spec.Decryption = &kustomizev2.Decryption{Provider: app.Decryption.Provider}
+
+			if app.Decryption.SecretRef != "" {
+				kustomization.Spec.Decryption.SecretRef = &meta.LocalObjectReference{Name: app.Decryption.SecretRef}
+			}
+		}
+
+		return marshalFluxObject(kustomization)
+	case models.AutomationTypeHelm:
+		var helmRelease helmv2.HelmRelease
+		if err := yaml.Unmarshal(automationManifest, &helmRelease); err != nil {
+			return nil, err
+		}
+
+		if app.Interval != 0 {
+			helmRelease.Spec.Interval = metav1.Duration{Duration: app.Interval}
+		}
+
+		if app.Timeout != 0 {
+			helmRelease.Spec.Timeout = &metav1.Duration{Duration: app.Timeout}
+		}
+
+		if len(app.HelmValues) > 0 {
+			values, err := json.Marshal(app.HelmValues)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal helm values: %w", err)
+			}
+
+			helmRelease.Spec.Values = &apiextensionsv1.JSON{Raw: values}
+		}
+
+		for _, ref := range app.HelmValuesFrom {
+			helmRelease.Spec.ValuesFrom = append(helmRelease.Spec.ValuesFrom, helmv2.ValuesReference{
+				Kind: ref.Kind,
+				Name: ref.Name,
+			})
+		}
+
+		helmRelease.Spec.DependsOn = dependsOn(app)
+
+		return marshalFluxObject(helmRelease)
+	}
+
+	return automationManifest, nil
+}
+
+func hasAutomationOptions(app models.Application) bool {
+	return app.Interval != 0 || app.Timeout != 0 || app.DisablePrune || app.Wait || len(app.HealthChecks) > 0 ||
+		len(app.HelmValues) > 0 || len(app.HelmValuesFrom) > 0 || len(app.Substitute) > 0 || len(app.SubstituteFrom) > 0 ||
+		len(app.DependsOn) > 0 || app.Decryption.Provider != ""
+}
+
+// dependsOn references the automation of the apps an application depends on, which is named after the app.
+// The references have no namespace as dependencies are always in the namespace of the application.
+func dependsOn(app models.Application) []dependency.CrossNamespaceDependencyReference {
+	var result []dependency.CrossNamespaceDependencyReference
+
+	for _, name := range app.DependsOn {
+		result = append(result, dependency.CrossNamespaceDependencyReference{Name: name})
+	}
+
+	return result
+}
+
+func marshalFluxObject(obj interface{}) ([]byte, error) {
+	b, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return sanitizeK8sYaml(b), nil
+}
+
 func GetOrCreateKustomize(filename, name, namespace string) (types.Kustomization, error) {
 	var k types.Kustomization
 
@@ -187,7 +431,7 @@ func AddWegoIgnore(sourceManifest []byte) ([]byte, error) {
 	return updatedManifest, nil
 }
 
-func (a *AutomationGen) GenerateApplicationAutomation(ctx context.Context, app models.Application, clusterName string) (ApplicationAutomation, error) {
+func (a *AutomationGen) GenerateApplicationAutomation(ctx context.Context, app models.Application) (ApplicationAutomation, error) {
 	a.Logger.Generatef("Generating application spec manifest")
 
 	appYamlManifest, err := generateAppYaml(app)
@@ -197,7 +441,7 @@ func (a *AutomationGen) GenerateApplicationAutomation(ctx context.Context, app m
 
 	a.Logger.Generatef("Generating GitOps automation manifest")
 
-	appDeployManifest, err := a.generateAppAutomation(ctx, app, clusterName)
+	appDeployManifest, err := a.generateAppAutomation(ctx, app)
 	if err != nil {
 		return ApplicationAutomation{}, err
 	}
@@ -228,7 +472,7 @@ func (aa ApplicationAutomation) Manifests() []models.Manifest {
 	return append([]models.Manifest{aa.AppYaml}, aa.AppAutomation, aa.AppSource, aa.AppKustomize)
 }
 
-func (a *AutomationGen) generateAppAutomation(ctx context.Context, app models.Application, clusterName string) (models.Manifest, error) {
+func (a *AutomationGen) generateAppAutomation(ctx context.Context, app models.Application) (models.Manifest, error) {
 	var (
 		b   []byte
 		err error
@@ -236,10 +480,15 @@ func (a *AutomationGen) generateAppAutomation(ctx context.Context, app models.Ap
 
 	switch app.AutomationType {
 	case models.AutomationTypeKustomize:
-		b, err = a.Flux.CreateKustomization(app.Name, app.Name, app.Path, app.Namespace)
+		source := app.Name
+		if app.SourceType == models.SourceTypeBucket {
+			source = sourcev1.BucketKind + "/" + app.Name
+		}
+
+		b, err = a.Flux.CreateKustomization(app.Name, source, app.Path, app.Namespace)
 	case models.AutomationTypeHelm:
 		switch app.SourceType {
-		case models.SourceTypeHelm:
+		case models.SourceTypeHelm, models.SourceTypeOCI:
 			b, err = a.Flux.CreateHelmReleaseHelmRepository(app.Name, app.Path, app.Namespace, app.HelmTargetNamespace)
 		case models.SourceTypeGit:
 			b, err = a.Flux.CreateHelmReleaseGitRepository(app.Name, app.Name, app.Path, app.Namespace, app.HelmTargetNamespace)
@@ -250,7 +499,16 @@ func (a *AutomationGen) generateAppAutomation(ctx context.Context, app models.Ap
 		return models.Manifest{}, fmt.Errorf("invalid automation type: %v", app.AutomationType)
 	}
 
-	return models.Manifest{Path: AppAutomationDeployPath(app), Content: sanitizeWegoDirectory(b)}, err
+	if err != nil {
+		return models.Manifest{}, err
+	}
+
+	b, err = setAutomationOptions(b, app)
+	if err != nil {
+		return models.Manifest{}, fmt.Errorf("failed to set automation options: %w", err)
+	}
+
+	return models.Manifest{Path: AppAutomationDeployPath(app), Content: sanitizeWegoDirectory(b)}, nil
 }
 
 func generateAppYaml(app models.Application) (models.Manifest, error) {
@@ -268,20 +526,58 @@ func generateAppYaml(app models.Application) (models.Manifest, error) {
 	return models.Manifest{Path: AppYamlPath(app), Content: sanitizeK8sYaml(b)}, nil
 }
 
+// SetAppIdentifier labels a generated app yaml manifest with appIdentifier. Updated apps keep
+// the identifier they were added with, even if the fields GetAppHash uses have changed.
+func SetAppIdentifier(appYaml models.Manifest, appIdentifier string) (models.Manifest, error) {
+	var wegoapp wego.Application
+	if err := yaml.Unmarshal(appYaml.Content, &wegoapp); err != nil {
+		return models.Manifest{}, fmt.Errorf("could not unmarshal app yaml: %w", err)
+	}
+
+	if wegoapp.ObjectMeta.Labels == nil {
+		wegoapp.ObjectMeta.Labels = map[string]string{}
+	}
+
+	wegoapp.ObjectMeta.Labels[WeGOAppIdentifierLabelKey] = appIdentifier
+
+	b, err := yaml.Marshal(&wegoapp)
+	if err != nil {
+		return models.Manifest{}, fmt.Errorf("could not marshal yaml: %w", err)
+	}
+
+	return models.Manifest{Path: appYaml.Path, Content: sanitizeK8sYaml(b)}, nil
+}
+
 func WegoAppToApp(app wego.Application) (models.Application, error) {
 	var (
 		helmRepoUrl   string
 		appRepoUrl    gitproviders.RepoURL
 		configRepoUrl gitproviders.RepoURL
+		helmValues    map[string]interface{}
+		bucket        models.BucketSource
 		err           error
 	)
 
-	if wego.SourceType(app.Spec.SourceType) == wego.SourceType(wego.SourceTypeGit) {
+	switch app.Spec.SourceType {
+	case wego.SourceTypeGit:
 		appRepoUrl, err = gitproviders.NewRepoURL(app.Spec.URL)
 		if err != nil {
 			return models.Application{}, err
 		}
-	} else {
+	case wego.SourceTypeBucket:
+		if app.Spec.Bucket == nil {
+			return models.Application{}, fmt.Errorf("app %s has a bucket source without bucket settings", app.Name)
+		}
+
+		bucket = models.BucketSource{
+			Endpoint:  app.Spec.URL,
+			Name:      app.Spec.Bucket.Name,
+			Provider:  app.Spec.Bucket.Provider,
+			Region:    app.Spec.Bucket.Region,
+			Insecure:  app.Spec.Bucket.Insecure,
+			SecretRef: app.Spec.Bucket.SecretRef,
+		}
+	default:
 		helmRepoUrl = app.Spec.URL
 	}
 
@@ -292,6 +588,12 @@ func WegoAppToApp(app wego.Application) (models.Application, error) {
 		}
 	}
 
+	if app.Spec.HelmValues != nil {
+		if err := json.Unmarshal(app.Spec.HelmValues.Raw, &helmValues); err != nil {
+			return models.Application{}, fmt.Errorf("failed to read helm values of app %s: %w", app.Name, err)
+		}
+	}
+
 	return models.Application{
 		Name:                app.Name,
 		Namespace:           app.Namespace,
@@ -303,14 +605,32 @@ func WegoAppToApp(app wego.Application) (models.Application, error) {
 		HelmTargetNamespace: app.Spec.HelmTargetNamespace,
 		SourceType:          models.SourceType(app.Spec.SourceType),
 		AutomationType:      models.AutomationType(app.Spec.DeploymentType),
+		SourceInterval:      fromDuration(app.Spec.SourceInterval),
+		Interval:            fromDuration(app.Spec.Interval),
+		Timeout:             fromDuration(app.Spec.Timeout),
+		DisablePrune:        app.Spec.Prune != nil && !*app.Spec.Prune,
+		Wait:                app.Spec.Wait,
+		HealthChecks:        healthChecksFromSpec(app.Spec.HealthChecks),
+		HelmValues:          helmValues,
+		HelmValuesFrom:      valuesFromSpec(app.Spec.HelmValuesFrom),
+		Bucket:              bucket,
+		Substitute:          app.Spec.Substitute,
+		SubstituteFrom:      substituteFromSpec(app.Spec.SubstituteFrom),
+		DependsOn:           app.Spec.DependsOn,
+		Decryption:          decryptionFromSpec(app.Spec.Decryption),
 	}, nil
 }
 
 func AppToWegoApp(app models.Application) wego.Application {
-	sourceUrl := app.GitSourceURL.String()
+	var sourceUrl string
 
-	if app.SourceType == models.SourceTypeHelm {
+	switch {
+	case app.IsHelmRepository():
 		sourceUrl = app.HelmSourceURL
+	case app.SourceType == models.SourceTypeBucket:
+		sourceUrl = app.Bucket.Endpoint
+	default:
+		sourceUrl = app.GitSourceURL.String()
 	}
 
 	gvk := wego.GroupVersion.WithKind(wego.ApplicationKind)
@@ -331,12 +651,144 @@ func AppToWegoApp(app models.Application) wego.Application {
 			DeploymentType:      wego.DeploymentType(string(app.AutomationType)),
 			SourceType:          wego.SourceType(string(app.SourceType)),
 			HelmTargetNamespace: app.HelmTargetNamespace,
+			SourceInterval:      toDuration(app.SourceInterval),
+			Interval:            toDuration(app.Interval),
+			Timeout:             toDuration(app.Timeout),
+			Wait:                app.Wait,
+			HealthChecks:        healthChecksToSpec(app.HealthChecks),
+			HelmValuesFrom:      valuesFromToSpec(app.HelmValuesFrom),
+			Substitute:          app.Substitute,
+			SubstituteFrom:      substituteFromToSpec(app.SubstituteFrom),
+			DependsOn:           app.DependsOn,
 		},
 	}
 
+	if len(app.HelmValues) > 0 {
+		// the values come from yaml or json, so they always marshal
+		values, _ := json.Marshal(app.HelmValues)
+		wegoApp.Spec.HelmValues = &apiextensionsv1.JSON{Raw: values}
+	}
+
+	if app.Decryption.Provider != "" {
+		wegoApp.Spec.Decryption = &wego.Decryption{
+			Provider:  app.Decryption.Provider,
+			SecretRef: app.Decryption.SecretRef,
+		}
+	}
+
+	if app.SourceType == models.SourceTypeBucket {
+		wegoApp.Spec.Bucket = &wego.BucketSource{
+			Name:      app.Bucket.Name,
+			Provider:  app.Bucket.Provider,
+			Region:    app.Bucket.Region,
+			Insecure:  app.Bucket.Insecure,
+			SecretRef: app.Bucket.SecretRef,
+		}
+	}
+
+	// Prune is left unset unless disabled, so existing app.yaml files are unchanged
+	if app.DisablePrune {
+		prune := false
+		wegoApp.Spec.Prune = &prune
+	}
+
 	return wegoApp
 }
 
+func toDuration(d time.Duration) *metav1.Duration {
+	if d == 0 {
+		return nil
+	}
+
+	return &metav1.Duration{Duration: d}
+}
+
+func fromDuration(d *metav1.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
+
+	return d.Duration
+}
+
+func healthChecksToSpec(healthChecks []models.HealthCheck) []wego.HealthCheck {
+	var result []wego.HealthCheck
+
+	for _, hc := range healthChecks {
+		result = append(result, wego.HealthCheck{
+			APIVersion: hc.APIVersion,
+			Kind:       hc.Kind,
+			Name:       hc.Name,
+			Namespace:  hc.Namespace,
+		})
+	}
+
+	return result
+}
+
+func valuesFromToSpec(refs []models.ValuesReference) []wego.ValuesReference {
+	var result []wego.ValuesReference
+
+	for _, ref := range refs {
+		result = append(result, wego.ValuesReference{Kind: ref.Kind, Name: ref.Name})
+	}
+
+	return result
+}
+
+func valuesFromSpec(refs []wego.ValuesReference) []models.ValuesReference {
+	var result []models.ValuesReference
+
+	for _, ref := range refs {
+		result = append(result, models.ValuesReference{Kind: ref.Kind, Name: ref.Name})
+	}
+
+	return result
+}
+
+func decryptionFromSpec(decryption *wego.Decryption) models.Decryption {
+	if decryption == nil {
+		return models.Decryption{}
+	}
+
+	return models.Decryption{Provider: decryption.Provider, SecretRef: decryption.SecretRef}
+}
+
+func substituteFromToSpec(refs []models.SubstituteReference) []wego.SubstituteReference {
+	var result []wego.SubstituteReference
+
+	for _, ref := range refs {
+		result = append(result, wego.SubstituteReference{Kind: ref.Kind, Name: ref.Name})
+	}
+
+	return result
+}
+
+func substituteFromSpec(refs []wego.SubstituteReference) []models.SubstituteReference {
+	var result []models.SubstituteReference
+
+	for _, ref := range refs {
+		result = append(result, models.SubstituteReference{Kind: ref.Kind, Name: ref.Name})
+	}
+
+	return result
+}
+
+func healthChecksFromSpec(healthChecks []wego.HealthCheck) []models.HealthCheck {
+	var result []models.HealthCheck
+
+	for _, hc := range healthChecks {
+		result = append(result, models.HealthCheck{
+			APIVersion: hc.APIVersion,
+			Kind:       hc.Kind,
+			Name:       hc.Name,
+			Namespace:  hc.Namespace,
+		})
+	}
+
+	return result
+}
+
 // Operations to extract useful information from an Application
 
 const automationRoot = ".weave-gitops"
@@ -370,13 +822,14 @@ func AppDeployName(a models.Application) string {
 }
 
 func SourceKind(a models.Application) ResourceKind {
-	result := ResourceKindGitRepository
-
-	if a.SourceType == models.SourceTypeHelm {
-		result = ResourceKindHelmRepository
+	switch {
+	case a.IsHelmRepository():
+		return ResourceKindHelmRepository
+	case a.SourceType == models.SourceTypeBucket:
+		return ResourceKindBucket
+	default:
+		return ResourceKindGitRepository
 	}
-
-	return result
 }
 
 func DeployKind(a models.Application) ResourceKind {
@@ -395,8 +848,12 @@ func GetAppHash(a models.Application) string {
 		return fmt.Sprintf("%x", md5.Sum(final))
 	}
 
+	if a.SourceType == models.SourceTypeBucket {
+		return "wego-" + getHash(a.Bucket.Endpoint, a.Bucket.Name, a.Path)
+	}
+
 	if a.AutomationType == models.AutomationTypeHelm {
-		if a.SourceType == models.SourceTypeHelm {
+		if a.IsHelmRepository() {
 			return "wego-" + getHash(a.HelmSourceURL, a.Name, a.Branch)
 		} else {
 			return "wego-" + getHash(a.GitSourceURL.String(), a.Name, a.Branch)
@@ -420,6 +877,8 @@ func (rk ResourceKind) ToGVR() (schema.GroupVersionResource, error) {
 		return kube.GVRGitRepository, nil
 	case ResourceKindHelmRepository:
 		return kube.GVRHelmRepository, nil
+	case ResourceKindBucket:
+		return kube.GVRBucket, nil
 	case ResourceKindHelmRelease:
 		return kube.GVRHelmRelease, nil
 	case ResourceKindKustomization:
@@ -0,0 +1,362 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	extensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// The parts of the system a component belongs to
+const (
+	PartOfFlux        = "flux"
+	PartOfWeaveGitOps = "weave-gitops"
+)
+
+// The Flux controllers Weave GitOps doesn't work without. The other Flux controllers are reported when they are installed.
+var requiredFluxControllers = []string{"source-controller", "kustomize-controller", "helm-controller"}
+
+// The deployments of Weave GitOps, found in the namespace it is installed in.
+var wegoComponents = []string{"wego-app"}
+
+// The custom resources Weave GitOps reads and writes, at the versions it uses.
+var requiredCRDs = []schema.GroupVersionResource{
+	sourcev1.GroupVersion.WithResource("gitrepositories"),
+	sourcev1.GroupVersion.WithResource("helmrepositories"),
+	sourcev1.GroupVersion.WithResource("buckets"),
+	sourcev1.GroupVersion.WithResource("helmcharts"),
+	kustomizev2.GroupVersion.WithResource("kustomizations"),
+	helmv2.GroupVersion.WithResource("helmreleases"),
+	wego.GroupVersion.WithResource("apps"),
+}
+
+// ComponentHealth is the health of the Deployment of a Flux controller or of a Weave GitOps component
+type ComponentHealth struct {
+	Name          string
+	Namespace     string
+	PartOf        string
+	Version       string
+	Replicas      int32
+	ReadyReplicas int32
+	// Restarts is the number of restarts of the containers of the pods of the deployment
+	Restarts int32
+	Ready    bool
+	// Message tells why the component is not ready
+	Message string
+}
+
+// CRDHealth is whether a CustomResourceDefinition serves the version Weave GitOps uses
+type CRDHealth struct {
+	Name           string
+	Version        string
+	ServedVersions []string
+	Ready          bool
+	// Message tells why the CRD is not ready
+	Message string
+}
+
+// HealthReport is the health of the Flux controllers, of the Weave GitOps components, of the CRDs they use and of the other CRDs installed with Flux
+type HealthReport struct {
+	Components []ComponentHealth
+	CRDs       []CRDHealth
+}
+
+// Healthy returns whether all the components are ready and all the CRDs serve the versions Weave GitOps uses
+func (r HealthReport) Healthy() bool {
+	for _, c := range r.Components {
+		if !c.Ready {
+			return false
+		}
+	}
+
+	for _, c := range r.CRDs {
+		if !c.Ready {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Health reports the health of the Flux controllers, found in the namespace labelled as part of Flux,
+// of the Weave GitOps components installed in a namespace, and of the CRDs they use or installed with Flux.
+func Health(ctx context.Context, kubeClient kube.Kube, namespace string) (HealthReport, error) {
+	report := HealthReport{}
+
+	fluxNamespace, err := kubeClient.FetchNamespaceWithLabel(ctx, flux.PartOfLabelKey, flux.PartOfLabelValue)
+
+	switch {
+	case errors.Is(err, kube.ErrNamespaceNotFound):
+		for _, name := range requiredFluxControllers {
+			report.Components = append(report.Components, ComponentHealth{Name: name, PartOf: PartOfFlux, Message: "Flux is not installed"})
+		}
+	case err != nil:
+		return report, fmt.Errorf("failed getting flux namespace: %w", err)
+	default:
+		components, err := componentsHealth(ctx, kubeClient, fluxNamespace.Name, PartOfFlux, requiredFluxControllers, func(d appsv1.Deployment) bool {
+			return d.Labels[flux.PartOfLabelKey] == flux.PartOfLabelValue
+		})
+		if err != nil {
+			return report, err
+		}
+
+		report.Components = append(report.Components, components...)
+	}
+
+	components, err := componentsHealth(ctx, kubeClient, namespace, PartOfWeaveGitOps, wegoComponents, func(d appsv1.Deployment) bool {
+		return contains(wegoComponents, d.Name)
+	})
+	if err != nil {
+		return report, err
+	}
+
+	report.Components = append(report.Components, components...)
+
+	crds, err := crdsHealth(ctx, kubeClient)
+	if err != nil {
+		return report, err
+	}
+
+	report.CRDs = crds
+
+	return report, nil
+}
+
+// componentsHealth reports the health of the matching deployments of a namespace, and of the required ones that are missing
+func componentsHealth(ctx context.Context, kubeClient kube.Kube, namespace, partOf string, required []string, matches func(appsv1.Deployment) bool) ([]ComponentHealth, error) {
+	deployments, err := kubeClient.GetDeployments(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting %s deployments: %w", partOf, err)
+	}
+
+	components := []ComponentHealth{}
+	found := map[string]bool{}
+
+	for _, d := range deployments {
+		if !matches(d) {
+			continue
+		}
+
+		component, err := deploymentHealth(ctx, kubeClient, d, partOf)
+		if err != nil {
+			return nil, err
+		}
+
+		components = append(components, component)
+		found[d.Name] = true
+	}
+
+	for _, name := range required {
+		if !found[name] {
+			components = append(components, ComponentHealth{Name: name, Namespace: namespace, PartOf: partOf, Message: "deployment not found"})
+		}
+	}
+
+	sort.Slice(components, func(i, j int) bool {
+		return components[i].Name < components[j].Name
+	})
+
+	return components, nil
+}
+
+func deploymentHealth(ctx context.Context, kubeClient kube.Kube, d appsv1.Deployment, partOf string) (ComponentHealth, error) {
+	replicas := int32(1)
+
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+
+	component := ComponentHealth{
+		Name:          d.Name,
+		Namespace:     d.Namespace,
+		PartOf:        partOf,
+		Version:       deploymentVersion(d),
+		Replicas:      replicas,
+		ReadyReplicas: d.Status.ReadyReplicas,
+	}
+
+	pods, err := kubeClient.GetPods(ctx, d.Namespace, d.Spec.Selector)
+	if err != nil {
+		return component, fmt.Errorf("failed getting pods of %s: %w", d.Name, err)
+	}
+
+	waiting := ""
+
+	for _, pod := range pods {
+		for _, status := range pod.Status.ContainerStatuses {
+			component.Restarts += status.RestartCount
+
+			if status.State.Waiting != nil && status.State.Waiting.Reason != "" && waiting == "" {
+				waiting = fmt.Sprintf("container %s of pod %s is waiting: %s", status.Name, pod.Name, status.State.Waiting.Reason)
+			}
+		}
+	}
+
+	available := findDeploymentCondition(d, appsv1.DeploymentAvailable)
+
+	switch {
+	case d.Status.ObservedGeneration < d.Generation:
+		component.Message = "the latest changes to the deployment are not rolled out yet"
+	case available != nil && available.Status == corev1.ConditionFalse:
+		component.Message = available.Message
+	case d.Status.ReadyReplicas < replicas:
+		component.Message = fmt.Sprintf("%d/%d replicas ready", d.Status.ReadyReplicas, replicas)
+	default:
+		component.Ready = true
+	}
+
+	if !component.Ready && waiting != "" {
+		component.Message = strings.TrimPrefix(component.Message+", "+waiting, ", ")
+	}
+
+	return component, nil
+}
+
+// deploymentVersion returns the version the deployment is labelled with, or the tag of the image of its first container
+func deploymentVersion(d appsv1.Deployment) string {
+	if version := d.Labels[flux.VersionLabelKey]; version != "" {
+		return version
+	}
+
+	if len(d.Spec.Template.Spec.Containers) == 0 {
+		return ""
+	}
+
+	image := d.Spec.Template.Spec.Containers[0].Image
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[i+1:]
+	}
+
+	return ""
+}
+
+func findDeploymentCondition(d appsv1.Deployment, conditionType appsv1.DeploymentConditionType) *appsv1.DeploymentCondition {
+	for i := range d.Status.Conditions {
+		if d.Status.Conditions[i].Type == conditionType {
+			return &d.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+func crdsHealth(ctx context.Context, kubeClient kube.Kube) ([]CRDHealth, error) {
+	crds, err := kubeClient.GetCRDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting crds: %w", err)
+	}
+
+	byName := map[string]extensionsv1.CustomResourceDefinition{}
+
+	for _, crd := range crds {
+		byName[crd.Name] = crd
+	}
+
+	result := []CRDHealth{}
+
+	for _, gvr := range requiredCRDs {
+		health := CRDHealth{
+			Name:           gvr.GroupResource().String(),
+			Version:        gvr.Version,
+			ServedVersions: []string{},
+		}
+
+		crd, ok := byName[health.Name]
+		if !ok {
+			health.Message = "crd not found"
+			result = append(result, health)
+
+			continue
+		}
+
+		health.ServedVersions = servedVersions(crd)
+
+		switch {
+		case !isEstablished(crd):
+			health.Message = "crd not established"
+		case !contains(health.ServedVersions, gvr.Version):
+			health.Message = fmt.Sprintf("version %s not served, served versions: %s", gvr.Version, strings.Join(health.ServedVersions, ", "))
+		default:
+			health.Ready = true
+		}
+
+		result = append(result, health)
+	}
+
+	// the other CRDs installed with Flux are served at any version
+	for _, crd := range crds {
+		if crd.Labels[flux.PartOfLabelKey] != flux.PartOfLabelValue || containsCRD(result, crd.Name) {
+			continue
+		}
+
+		health := CRDHealth{Name: crd.Name, ServedVersions: servedVersions(crd)}
+
+		switch {
+		case !isEstablished(crd):
+			health.Message = "crd not established"
+		case len(health.ServedVersions) == 0:
+			health.Message = "no version served"
+		default:
+			health.Version = health.ServedVersions[len(health.ServedVersions)-1]
+			health.Ready = true
+		}
+
+		result = append(result, health)
+	}
+
+	return result, nil
+}
+
+func servedVersions(crd extensionsv1.CustomResourceDefinition) []string {
+	versions := []string{}
+
+	for _, version := range crd.Spec.Versions {
+		if version.Served {
+			versions = append(versions, version.Name)
+		}
+	}
+
+	return versions
+}
+
+func containsCRD(crds []CRDHealth, name string) bool {
+	for _, crd := range crds {
+		if crd.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isEstablished(crd extensionsv1.CustomResourceDefinition) bool {
+	for _, c := range crd.Status.Conditions {
+		if c.Type == extensionsv1.Established {
+			return c.Status == extensionsv1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,205 @@
+package check
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/kube/kubefakes"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	extensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Health", func() {
+	var (
+		fakeKubeClient *kubefakes.FakeKube
+		deployments    map[string][]appsv1.Deployment
+		pods           []corev1.Pod
+		crds           []extensionsv1.CustomResourceDefinition
+	)
+
+	deployment := func(name, namespace string, labels map[string]string, readyReplicas int32) appsv1.Deployment {
+		return appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels, Generation: 1},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}},
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "manager", Image: "ghcr.io/weaveworks/" + name + ":v0.6.2"}}},
+				},
+			},
+			Status: appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, ReadyReplicas: readyReplicas},
+		}
+	}
+
+	fluxController := func(name string, readyReplicas int32) appsv1.Deployment {
+		return deployment(name, "flux-system", map[string]string{
+			flux.PartOfLabelKey:  flux.PartOfLabelValue,
+			flux.VersionLabelKey: "v0.24.0",
+		}, readyReplicas)
+	}
+
+	crd := func(name string, versions ...string) extensionsv1.CustomResourceDefinition {
+		c := extensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: extensionsv1.CustomResourceDefinitionStatus{
+				Conditions: []extensionsv1.CustomResourceDefinitionCondition{{Type: extensionsv1.Established, Status: extensionsv1.ConditionTrue}},
+			},
+		}
+
+		for _, version := range versions {
+			c.Spec.Versions = append(c.Spec.Versions, extensionsv1.CustomResourceDefinitionVersion{Name: version, Served: true})
+		}
+
+		return c
+	}
+
+	BeforeEach(func() {
+		fakeKubeClient = &kubefakes.FakeKube{}
+
+		fakeKubeClient.FetchNamespaceWithLabelReturns(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}, nil)
+
+		deployments = map[string][]appsv1.Deployment{
+			"flux-system": {
+				fluxController("source-controller", 1),
+				fluxController("kustomize-controller", 1),
+				fluxController("helm-controller", 1),
+				fluxController("notification-controller", 1),
+				deployment("unrelated", "flux-system", nil, 0),
+			},
+			"wego-system": {
+				deployment("wego-app", "wego-system", nil, 1),
+			},
+		}
+
+		fakeKubeClient.GetDeploymentsStub = func(_ context.Context, namespace string) ([]appsv1.Deployment, error) {
+			return deployments[namespace], nil
+		}
+
+		pods = []corev1.Pod{{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod-abc"},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{Name: "manager", RestartCount: 2}},
+			},
+		}}
+
+		fakeKubeClient.GetPodsStub = func(context.Context, string, *metav1.LabelSelector) ([]corev1.Pod, error) {
+			return pods, nil
+		}
+
+		crds = []extensionsv1.CustomResourceDefinition{
+			crd("gitrepositories.source.toolkit.fluxcd.io", "v1beta1"),
+			crd("helmrepositories.source.toolkit.fluxcd.io", "v1beta1"),
+			crd("buckets.source.toolkit.fluxcd.io", "v1beta1"),
+			crd("helmcharts.source.toolkit.fluxcd.io", "v1beta1"),
+			crd("kustomizations.kustomize.toolkit.fluxcd.io", "v1beta1", "v1beta2"),
+			crd("helmreleases.helm.toolkit.fluxcd.io", "v2beta1"),
+			crd("apps.wego.weave.works", "v1alpha1"),
+		}
+
+		fakeKubeClient.GetCRDsStub = func(context.Context) ([]extensionsv1.CustomResourceDefinition, error) {
+			return crds, nil
+		}
+	})
+
+	It("reports the flux controllers, the weave gitops components and the crds", func() {
+		report, err := Health(context.Background(), fakeKubeClient, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(report.Healthy()).To(BeTrue())
+
+		Expect(report.Components).To(HaveLen(5))
+		Expect(report.Components[0]).To(Equal(ComponentHealth{
+			Name:          "helm-controller",
+			Namespace:     "flux-system",
+			PartOf:        PartOfFlux,
+			Version:       "v0.24.0",
+			Replicas:      1,
+			ReadyReplicas: 1,
+			Restarts:      2,
+			Ready:         true,
+		}))
+		Expect(report.Components[4].Name).To(Equal("wego-app"))
+		Expect(report.Components[4].PartOf).To(Equal(PartOfWeaveGitOps))
+		Expect(report.Components[4].Version).To(Equal("v0.6.2"))
+
+		Expect(report.CRDs).To(HaveLen(7))
+		Expect(report.CRDs[4]).To(Equal(CRDHealth{
+			Name:           "kustomizations.kustomize.toolkit.fluxcd.io",
+			Version:        "v1beta2",
+			ServedVersions: []string{"v1beta1", "v1beta2"},
+			Ready:          true,
+		}))
+	})
+
+	It("reports why a controller is not ready", func() {
+		deployments["flux-system"][2] = fluxController("helm-controller", 0)
+		pods[0].Status.ContainerStatuses[0].State.Waiting = &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}
+
+		report, err := Health(context.Background(), fakeKubeClient, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(report.Healthy()).To(BeFalse())
+		Expect(report.Components[0].Ready).To(BeFalse())
+		Expect(report.Components[0].Message).To(Equal("0/1 replicas ready, container manager of pod pod-abc is waiting: CrashLoopBackOff"))
+	})
+
+	It("reports the missing components", func() {
+		fakeKubeClient.FetchNamespaceWithLabelReturns(nil, kube.ErrNamespaceNotFound)
+		deployments["wego-system"] = nil
+
+		report, err := Health(context.Background(), fakeKubeClient, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(report.Healthy()).To(BeFalse())
+
+		messages := map[string]string{}
+
+		for _, c := range report.Components {
+			messages[c.Name] = c.Message
+		}
+
+		Expect(messages).To(Equal(map[string]string{
+			"source-controller":    "Flux is not installed",
+			"kustomize-controller": "Flux is not installed",
+			"helm-controller":      "Flux is not installed",
+			"wego-app":             "deployment not found",
+		}))
+	})
+
+	It("reports the crds not serving the version weave gitops uses", func() {
+		crds[4] = crd("kustomizations.kustomize.toolkit.fluxcd.io", "v1beta1")
+		crds = crds[:6]
+
+		report, err := Health(context.Background(), fakeKubeClient, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(report.Healthy()).To(BeFalse())
+		Expect(report.CRDs[4].Message).To(Equal("version v1beta2 not served, served versions: v1beta1"))
+		Expect(report.CRDs[6].Message).To(Equal("crd not found"))
+	})
+
+	It("reports the other crds installed with flux", func() {
+		alerts := crd("alerts.notification.toolkit.fluxcd.io")
+		alerts.Labels = map[string]string{flux.PartOfLabelKey: flux.PartOfLabelValue}
+		crds = append(crds, alerts, crd("certificates.cert-manager.io", "v1"))
+
+		report, err := Health(context.Background(), fakeKubeClient, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(report.Healthy()).To(BeFalse())
+		Expect(report.CRDs).To(HaveLen(8))
+		Expect(report.CRDs[7]).To(Equal(CRDHealth{
+			Name:           "alerts.notification.toolkit.fluxcd.io",
+			ServedVersions: []string{},
+			Message:        "no version served",
+		}))
+	})
+
+	It("fails when the deployments can't be listed", func() {
+		fakeKubeClient.GetDeploymentsStub = nil
+		fakeKubeClient.GetDeploymentsReturns(nil, errors.New("forbidden"))
+
+		_, err := Health(context.Background(), fakeKubeClient, "wego-system")
+		Expect(err).To(MatchError("failed getting flux deployments: forbidden"))
+	})
+})
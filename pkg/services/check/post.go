@@ -0,0 +1,334 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ErrNotHealthy is returned when one of the post-install checks failed
+var ErrNotHealthy = errors.New("the system is not healthy")
+
+// Status is the outcome of a check
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+
+// The files gitops install writes to the system directory of a cluster, which the cluster kustomizations are built from
+var clusterManifests = []string{models.SystemKustomizationPath, models.SystemKustResourcePath, models.UserKustResourcePath}
+
+// Result is the outcome of a post-install check. Warnings are checks that could not be run or
+// things that may resolve on their own, failures need to be fixed.
+type Result struct {
+	Check   string `json:"check"`
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+}
+
+// Results are the outcomes of the post-install checks, in the order they were run
+type Results []Result
+
+// Failed returns whether one of the checks failed
+func (r Results) Failed() bool {
+	for _, result := range r {
+		if result.Status == StatusFail {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns a line per check, prefixed with the symbol of its status
+func (r Results) String() string {
+	lines := []string{}
+
+	for _, result := range r {
+		lines = append(lines, fmt.Sprintf("%s %s: %s", result.Status.Symbol(), result.Check, result.Message))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Symbol returns the symbol the status is printed with
+func (s Status) Symbol() string {
+	switch s {
+	case StatusPass:
+		return "✔"
+	case StatusWarn:
+		return "!"
+	}
+
+	return "✗"
+}
+
+// Post runs the post-install checks of Weave GitOps installed in a namespace: the namespace and the wego config exist and match
+// the cluster, the config repository is reconciled and holds the cluster manifests, and the components and CRDs are healthy.
+// The config repository is read with a git provider from gpClient; its check is skipped with a warning when gpClient is nil.
+// The error is only set when the checks could not be run, failures are reported in the results.
+func Post(ctx context.Context, kubeClient kube.Kube, gpClient gitproviders.Client, namespace string) (Results, error) {
+	results := Results{}
+
+	present, err := kubeClient.NamespacePresent(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed checking namespace %s: %w", namespace, err)
+	}
+
+	if present {
+		results = append(results, Result{Check: "namespace", Status: StatusPass, Message: fmt.Sprintf("namespace %s exists", namespace)})
+	} else {
+		results = append(results, Result{Check: "namespace", Status: StatusFail, Message: fmt.Sprintf("namespace %s not found", namespace)})
+	}
+
+	configResult, configRepo, err := checkWegoConfig(ctx, kubeClient, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	results = append(results, configResult)
+
+	if configRepo == nil {
+		results = append(results,
+			skipped("config repository", "no valid config repository in the wego config"),
+			skipped("deploy key", "no valid config repository in the wego config"),
+			skipped("cluster manifests", "no valid config repository in the wego config"),
+		)
+	} else {
+		repoResults, err := checkConfigRepo(ctx, kubeClient, gpClient, namespace, *configRepo)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, repoResults...)
+	}
+
+	report, err := Health(ctx, kubeClient, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range report.Components {
+		name := fmt.Sprintf("%s %s", c.PartOf, c.Name)
+
+		if c.Ready {
+			results = append(results, Result{Check: name, Status: StatusPass, Message: fmt.Sprintf("%s: %d/%d replicas ready, %d restarts", c.Version, c.ReadyReplicas, c.Replicas, c.Restarts)})
+		} else {
+			results = append(results, Result{Check: name, Status: StatusFail, Message: c.Message})
+		}
+	}
+
+	for _, c := range report.CRDs {
+		name := fmt.Sprintf("crd %s", c.Name)
+
+		if c.Ready {
+			results = append(results, Result{Check: name, Status: StatusPass, Message: fmt.Sprintf("%s served", c.Version)})
+		} else {
+			results = append(results, Result{Check: name, Status: StatusFail, Message: c.Message})
+		}
+	}
+
+	return results, nil
+}
+
+// checkWegoConfig checks the wego config matches the namespace it is in and the namespace of Flux,
+// returning the config repository when it is a valid url
+func checkWegoConfig(ctx context.Context, kubeClient kube.Kube, namespace string) (Result, *gitproviders.RepoURL, error) {
+	result := Result{Check: "wego config", Status: StatusFail}
+
+	config, err := kubeClient.GetWegoConfig(ctx, namespace)
+	if err != nil {
+		if errors.Is(err, kube.ErrWegoConfigNotFound) {
+			result.Message = fmt.Sprintf("config map %s not found in namespace %s", kube.WegoConfigMapName, namespace)
+			return result, nil, nil
+		}
+
+		return result, nil, fmt.Errorf("failed getting wego config: %w", err)
+	}
+
+	fluxNamespace, err := kubeClient.FetchNamespaceWithLabel(ctx, flux.PartOfLabelKey, flux.PartOfLabelValue)
+	if err != nil && !errors.Is(err, kube.ErrNamespaceNotFound) {
+		return result, nil, fmt.Errorf("failed getting flux namespace: %w", err)
+	}
+
+	problems := []string{}
+
+	if config.WegoNamespace != namespace {
+		problems = append(problems, fmt.Sprintf("wego namespace is %q instead of %q", config.WegoNamespace, namespace))
+	}
+
+	if fluxNamespace != nil && config.FluxNamespace != fluxNamespace.Name {
+		problems = append(problems, fmt.Sprintf("flux namespace is %q instead of %q", config.FluxNamespace, fluxNamespace.Name))
+	}
+
+	var configRepo *gitproviders.RepoURL
+
+	if config.ConfigRepo == "" {
+		problems = append(problems, "no config repository")
+	} else if url, err := gitproviders.NewRepoURL(config.ConfigRepo); err != nil {
+		problems = append(problems, fmt.Sprintf("invalid config repository %q: %s", config.ConfigRepo, err))
+	} else {
+		configRepo = &url
+	}
+
+	if len(problems) > 0 {
+		result.Message = strings.Join(problems, ", ")
+		return result, configRepo, nil
+	}
+
+	result.Status = StatusPass
+	result.Message = fmt.Sprintf("config repository %s", config.ConfigRepo)
+
+	return result, configRepo, nil
+}
+
+// checkConfigRepo checks the GitRepository of the config repository is ready, the deploy key it uses exists
+// and the manifests of the cluster kustomizations are in the repository
+func checkConfigRepo(ctx context.Context, kubeClient kube.Kube, gpClient gitproviders.Client, namespace string, configRepo gitproviders.RepoURL) (Results, error) {
+	results := Results{}
+
+	source := &sourcev1.GitRepository{}
+	name := types.NamespacedName{Name: models.CreateClusterSourceName(configRepo), Namespace: namespace}
+
+	if err := kubeClient.GetResource(ctx, name, source); err != nil {
+		return nil, fmt.Errorf("failed getting git repository %s: %w", name, err)
+	}
+
+	// GetResource leaves the object empty when it is not found
+	if source.Name == "" {
+		return append(results,
+			Result{Check: "config repository", Status: StatusFail, Message: fmt.Sprintf("git repository %s not found", name)},
+			skipped("deploy key", "the git repository of the config repository was not found"),
+			checkClusterManifests(ctx, kubeClient, gpClient, configRepo, ""),
+		), nil
+	}
+
+	results = append(results, checkSourceReady(source))
+
+	if source.Spec.SecretRef == nil {
+		results = append(results, Result{Check: "deploy key", Status: StatusPass, Message: "the config repository is read without a deploy key"})
+	} else {
+		secretName := types.NamespacedName{Name: source.Spec.SecretRef.Name, Namespace: namespace}
+
+		secret, err := kubeClient.GetSecret(ctx, secretName)
+		if err != nil {
+			return nil, fmt.Errorf("failed getting deploy key secret %s: %w", secretName, err)
+		}
+
+		if secret == nil {
+			results = append(results, Result{Check: "deploy key", Status: StatusFail, Message: fmt.Sprintf("secret %s not found", secretName)})
+		} else {
+			results = append(results, Result{Check: "deploy key", Status: StatusPass, Message: fmt.Sprintf("secret %s exists", secretName)})
+		}
+	}
+
+	branch := ""
+
+	if source.Spec.Reference != nil {
+		branch = source.Spec.Reference.Branch
+	}
+
+	return append(results, checkClusterManifests(ctx, kubeClient, gpClient, configRepo, branch)), nil
+}
+
+func checkSourceReady(source *sourcev1.GitRepository) Result {
+	result := Result{Check: "config repository"}
+
+	ready := apimeta.FindStatusCondition(source.Status.Conditions, meta.ReadyCondition)
+
+	switch {
+	case ready == nil:
+		result.Status = StatusWarn
+		result.Message = fmt.Sprintf("git repository %s has not been reconciled yet", source.Name)
+	case ready.Status == metav1.ConditionTrue:
+		result.Status = StatusPass
+		result.Message = fmt.Sprintf("git repository %s is ready: %s", source.Name, ready.Message)
+	case ready.Status == metav1.ConditionUnknown:
+		result.Status = StatusWarn
+		result.Message = fmt.Sprintf("git repository %s is reconciling: %s", source.Name, ready.Message)
+	default:
+		result.Status = StatusFail
+		result.Message = fmt.Sprintf("git repository %s is not ready: %s", source.Name, ready.Message)
+	}
+
+	return result
+}
+
+// checkClusterManifests checks the system directory of the cluster holds the manifests of its kustomizations.
+// The default branch of the repository is read when branch is empty.
+func checkClusterManifests(ctx context.Context, kubeClient kube.Kube, gpClient gitproviders.Client, configRepo gitproviders.RepoURL, branch string) Result {
+	if gpClient == nil {
+		return skipped("cluster manifests", "no git provider to read the config repository with")
+	}
+
+	clusterName, err := kubeClient.GetClusterName(ctx)
+	if err != nil {
+		return skipped("cluster manifests", fmt.Sprintf("failed getting cluster name: %s", err))
+	}
+
+	gitProvider, err := gpClient.GetProvider(configRepo, gitproviders.GetAccountType)
+	if err != nil {
+		return skipped("cluster manifests", fmt.Sprintf("failed getting git provider: %s", err))
+	}
+
+	if branch == "" {
+		if branch, err = gitProvider.GetDefaultBranch(ctx, configRepo); err != nil {
+			return skipped("cluster manifests", fmt.Sprintf("failed getting default branch: %s", err))
+		}
+	}
+
+	dir := git.GetSystemPath(clusterName)
+	result := Result{Check: "cluster manifests", Status: StatusFail}
+
+	files, err := gitProvider.GetRepoDirFiles(ctx, configRepo, dir, branch)
+	if err != nil {
+		result.Message = fmt.Sprintf("failed reading %s on branch %s: %s", dir, branch, err)
+		return result
+	}
+
+	found := map[string]bool{}
+
+	for _, f := range files {
+		if f.Path != nil {
+			found[filepath.Base(*f.Path)] = true
+		}
+	}
+
+	missing := []string{}
+
+	for _, manifest := range clusterManifests {
+		if !found[manifest] {
+			missing = append(missing, manifest)
+		}
+	}
+
+	if len(missing) > 0 {
+		result.Message = fmt.Sprintf("missing from %s on branch %s: %s", dir, branch, strings.Join(missing, ", "))
+		return result
+	}
+
+	result.Status = StatusPass
+	result.Message = fmt.Sprintf("%s on branch %s", dir, branch)
+
+	return result
+}
+
+func skipped(check, reason string) Result {
+	return Result{Check: check, Status: StatusWarn, Message: "skipped, " + reason}
+}
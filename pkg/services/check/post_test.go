@@ -0,0 +1,255 @@
+package check
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders/gitprovidersfakes"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/kube/kubefakes"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	extensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Post", func() {
+	var (
+		fakeKubeClient  *kubefakes.FakeKube
+		fakeGitProvider *gitprovidersfakes.FakeGitProvider
+		gpClient        *gitprovidersfakes.FakeClient
+		source          *sourcev1.GitRepository
+	)
+
+	file := func(path string) *gitprovider.CommitFile {
+		return &gitprovider.CommitFile{Path: &path}
+	}
+
+	resultOf := func(results Results, check string) Result {
+		for _, r := range results {
+			if r.Check == check {
+				return r
+			}
+		}
+
+		Fail("no result for " + check)
+
+		return Result{}
+	}
+
+	BeforeEach(func() {
+		fakeKubeClient = &kubefakes.FakeKube{}
+
+		fakeKubeClient.NamespacePresentReturns(true, nil)
+		fakeKubeClient.FetchNamespaceWithLabelReturns(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}, nil)
+		fakeKubeClient.GetWegoConfigReturns(&kube.WegoConfig{
+			FluxNamespace: "flux-system",
+			WegoNamespace: "wego-system",
+			ConfigRepo:    "ssh://git@github.com/owner/config-repo.git",
+		}, nil)
+		fakeKubeClient.GetClusterNameReturns("my-cluster", nil)
+		fakeKubeClient.GetSecretReturns(&corev1.Secret{}, nil)
+
+		source = &sourcev1.GitRepository{
+			ObjectMeta: metav1.ObjectMeta{Name: "wego-auto-github-config-repo", Namespace: "wego-system"},
+			Spec: sourcev1.GitRepositorySpec{
+				Reference: &sourcev1.GitRepositoryRef{Branch: "main"},
+				SecretRef: &meta.LocalObjectReference{Name: "wego-github-config-repo"},
+			},
+			Status: sourcev1.GitRepositoryStatus{
+				Conditions: []metav1.Condition{{Type: meta.ReadyCondition, Status: metav1.ConditionTrue, Message: "fetched revision main/abc"}},
+			},
+		}
+
+		fakeKubeClient.GetResourceStub = func(_ context.Context, name types.NamespacedName, resource kube.Resource) error {
+			if name.Name == source.Name && name.Namespace == source.Namespace {
+				source.DeepCopyInto(resource.(*sourcev1.GitRepository))
+			}
+
+			return nil
+		}
+
+		fakeKubeClient.GetDeploymentsStub = func(_ context.Context, namespace string) ([]appsv1.Deployment, error) {
+			names := requiredFluxControllers
+			labels := map[string]string{flux.PartOfLabelKey: flux.PartOfLabelValue}
+
+			if namespace == "wego-system" {
+				names = wegoComponents
+				labels = nil
+			}
+
+			deployments := []appsv1.Deployment{}
+
+			for _, name := range names {
+				deployments = append(deployments, appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+					Status:     appsv1.DeploymentStatus{Replicas: 1, ReadyReplicas: 1},
+				})
+			}
+
+			return deployments, nil
+		}
+
+		crds := []extensionsv1.CustomResourceDefinition{}
+
+		for _, gvr := range requiredCRDs {
+			crds = append(crds, extensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: gvr.GroupResource().String()},
+				Spec: extensionsv1.CustomResourceDefinitionSpec{
+					Versions: []extensionsv1.CustomResourceDefinitionVersion{{Name: gvr.Version, Served: true}},
+				},
+				Status: extensionsv1.CustomResourceDefinitionStatus{
+					Conditions: []extensionsv1.CustomResourceDefinitionCondition{{Type: extensionsv1.Established, Status: extensionsv1.ConditionTrue}},
+				},
+			})
+		}
+
+		fakeKubeClient.GetCRDsReturns(crds, nil)
+
+		fakeGitProvider = &gitprovidersfakes.FakeGitProvider{}
+		fakeGitProvider.GetRepoDirFilesReturns([]*gitprovider.CommitFile{
+			file(".weave-gitops/clusters/my-cluster/system/kustomization.yaml"),
+			file(".weave-gitops/clusters/my-cluster/system/flux-system-kustomization-resource.yaml"),
+			file(".weave-gitops/clusters/my-cluster/system/flux-user-kustomization-resource.yaml"),
+			file(".weave-gitops/clusters/my-cluster/system/wego-app.yaml"),
+		}, nil)
+
+		gpClient = &gitprovidersfakes.FakeClient{}
+		gpClient.GetProviderReturns(fakeGitProvider, nil)
+	})
+
+	It("passes when weave gitops is installed", func() {
+		results, err := Post(context.Background(), fakeKubeClient, gpClient, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(results.Failed()).To(BeFalse())
+
+		for _, r := range results {
+			Expect(r.Status).To(Equal(StatusPass), r.Check)
+		}
+
+		Expect(results[:5]).To(Equal(Results{
+			{Check: "namespace", Status: StatusPass, Message: "namespace wego-system exists"},
+			{Check: "wego config", Status: StatusPass, Message: "config repository ssh://git@github.com/owner/config-repo.git"},
+			{Check: "config repository", Status: StatusPass, Message: "git repository wego-auto-github-config-repo is ready: fetched revision main/abc"},
+			{Check: "deploy key", Status: StatusPass, Message: "secret wego-system/wego-github-config-repo exists"},
+			{Check: "cluster manifests", Status: StatusPass, Message: ".weave-gitops/clusters/my-cluster/system on branch main"},
+		}))
+
+		_, _, dir, branch := fakeGitProvider.GetRepoDirFilesArgsForCall(0)
+		Expect(dir).To(Equal(".weave-gitops/clusters/my-cluster/system"))
+		Expect(branch).To(Equal("main"))
+
+		Expect(results.String()).To(ContainSubstring("✔ flux helm-controller"))
+		Expect(results.String()).To(ContainSubstring("✔ crd apps.wego.weave.works: v1alpha1 served"))
+	})
+
+	It("fails when the wego config doesn't match the cluster", func() {
+		fakeKubeClient.GetWegoConfigReturns(&kube.WegoConfig{
+			FluxNamespace: "flux",
+			WegoNamespace: "wego-system",
+		}, nil)
+
+		results, err := Post(context.Background(), fakeKubeClient, gpClient, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(results.Failed()).To(BeTrue())
+		Expect(resultOf(results, "wego config")).To(Equal(Result{
+			Check:   "wego config",
+			Status:  StatusFail,
+			Message: `flux namespace is "flux" instead of "flux-system", no config repository`,
+		}))
+		Expect(resultOf(results, "cluster manifests").Status).To(Equal(StatusWarn))
+		Expect(gpClient.GetProviderCallCount()).To(Equal(0))
+	})
+
+	It("fails when the wego config is missing", func() {
+		fakeKubeClient.NamespacePresentReturns(false, nil)
+		fakeKubeClient.GetWegoConfigReturns(&kube.WegoConfig{}, kube.ErrWegoConfigNotFound)
+
+		results, err := Post(context.Background(), fakeKubeClient, gpClient, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(resultOf(results, "namespace").Status).To(Equal(StatusFail))
+		Expect(resultOf(results, "wego config").Message).To(Equal("config map weave-gitops-config not found in namespace wego-system"))
+	})
+
+	It("reports the state of the git repository of the config repository", func() {
+		source.Status.Conditions[0].Status = metav1.ConditionUnknown
+		source.Status.Conditions[0].Message = "reconciliation in progress"
+
+		results, err := Post(context.Background(), fakeKubeClient, gpClient, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(results.Failed()).To(BeFalse())
+		Expect(resultOf(results, "config repository").Status).To(Equal(StatusWarn))
+
+		source.Status.Conditions[0].Status = metav1.ConditionFalse
+		source.Status.Conditions[0].Message = "authentication required"
+
+		results, err = Post(context.Background(), fakeKubeClient, gpClient, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(resultOf(results, "config repository")).To(Equal(Result{
+			Check:   "config repository",
+			Status:  StatusFail,
+			Message: "git repository wego-auto-github-config-repo is not ready: authentication required",
+		}))
+	})
+
+	It("fails when the deploy key is missing", func() {
+		fakeKubeClient.GetSecretReturns(nil, nil)
+
+		results, err := Post(context.Background(), fakeKubeClient, gpClient, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(resultOf(results, "deploy key").Message).To(Equal("secret wego-system/wego-github-config-repo not found"))
+	})
+
+	It("fails when the git repository is missing", func() {
+		source.Name = "other"
+		fakeGitProvider.GetDefaultBranchReturns("master", nil)
+
+		results, err := Post(context.Background(), fakeKubeClient, gpClient, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(resultOf(results, "config repository").Message).To(Equal("git repository wego-system/wego-auto-github-config-repo not found"))
+		Expect(resultOf(results, "deploy key").Status).To(Equal(StatusWarn))
+		Expect(resultOf(results, "cluster manifests").Message).To(Equal(".weave-gitops/clusters/my-cluster/system on branch master"))
+	})
+
+	It("fails when cluster manifests are missing from the config repository", func() {
+		fakeGitProvider.GetRepoDirFilesReturns([]*gitprovider.CommitFile{
+			file(".weave-gitops/clusters/my-cluster/system/kustomization.yaml"),
+		}, nil)
+
+		results, err := Post(context.Background(), fakeKubeClient, gpClient, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(resultOf(results, "cluster manifests").Message).To(Equal(
+			"missing from .weave-gitops/clusters/my-cluster/system on branch main: flux-system-kustomization-resource.yaml, flux-user-kustomization-resource.yaml"))
+	})
+
+	It("skips reading the config repository without a git provider", func() {
+		results, err := Post(context.Background(), fakeKubeClient, nil, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(results.Failed()).To(BeFalse())
+		Expect(resultOf(results, "cluster manifests").Status).To(Equal(StatusWarn))
+
+		gpClient.GetProviderReturns(nil, errors.New("no token"))
+
+		results, err = Post(context.Background(), fakeKubeClient, gpClient, "wego-system")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(resultOf(results, "cluster manifests")).To(Equal(Result{
+			Check:   "cluster manifests",
+			Status:  StatusWarn,
+			Message: "skipped, failed getting git provider: no token",
+		}))
+	})
+
+	It("fails when the cluster can't be read", func() {
+		fakeKubeClient.NamespacePresentReturns(false, errors.New("forbidden"))
+
+		_, err := Post(context.Background(), fakeKubeClient, gpClient, "wego-system")
+		Expect(err).To(MatchError("failed checking namespace wego-system: forbidden"))
+	})
+})
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -30,17 +31,22 @@ type GitConfigParams struct {
 	ConfigRepo       string
 	Namespace        string
 	IsHelmRepository bool
-	DryRun           bool
+	// IsBucket is set for apps deployed from a bucket, like helm repositories they don't need a deploy key
+	IsBucket bool
+	DryRun   bool
+	// Signer signs the commits of the git client, the signing key secret of the wego config is used when it's not set
+	Signer git.Signer
+	// Author is the author of the commits of the git client, the commit author of the wego config is used when it's not set
+	Author git.Author
 }
 
 func NewGitConfigParamsFromApp(app *wego.Application, dryRun bool) GitConfigParams {
-	isHelmRepository := app.Spec.SourceType == wego.SourceTypeHelm
-
 	return GitConfigParams{
 		URL:              app.Spec.URL,
 		ConfigRepo:       app.Spec.ConfigRepo,
 		Namespace:        app.Namespace,
-		IsHelmRepository: isHelmRepository,
+		IsHelmRepository: app.IsHelmRepository(),
+		IsBucket:         app.IsBucket(),
 		DryRun:           dryRun,
 	}
 }
@@ -72,8 +78,8 @@ func (f *defaultFactory) GetGitClients(ctx context.Context, kubeClient kube.Kube
 		return nil, nil, fmt.Errorf("error getting auth service: %w", err)
 	}
 
-	// Do not add deploy key for helm repo, empty url or if its gonna be added below
-	if !params.IsHelmRepository && params.URL != "" && params.URL != params.ConfigRepo {
+	// Do not add deploy key for helm repo, bucket, empty url or if its gonna be added below
+	if !params.IsHelmRepository && !params.IsBucket && params.URL != "" && params.URL != params.ConfigRepo {
 		normalizedUrl, err := gitproviders.NewRepoURL(params.URL)
 		if err != nil {
 			return nil, nil, fmt.Errorf("error normalizing url: %w", err)
@@ -95,12 +101,70 @@ func (f *defaultFactory) GetGitClients(ctx context.Context, kubeClient kube.Kube
 		}
 	}
 
-	client, err := authSvc.CreateGitClient(ctx, configNormalizedUrl, params.Namespace, params.DryRun)
+	author, signer, err := committer(ctx, kubeClient, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts := []git.Option{git.WithAuthor(author)}
+	if signer != nil {
+		opts = append(opts, git.WithSigner(signer))
+	}
+
+	client, err := authSvc.CreateGitClient(ctx, configNormalizedUrl, params.Namespace, params.DryRun, opts...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return client, authSvc.GetGitProvider(), nil
+	gitProvider := authSvc.GetGitProvider()
+
+	// the providers without an API make the commits of the pull requests like the git client does
+	if c, ok := gitProvider.(gitproviders.CommitterProvider); ok {
+		gitProvider = c.WithCommitter(author, signer)
+	}
+
+	return client, gitProvider, nil
+}
+
+// committer returns the author and the signer of the commits of the git clients. They are the ones of the params,
+// or the ones of the wego config. Nothing is committed in a dry run, its commits aren't signed.
+func committer(ctx context.Context, kubeClient kube.Kube, params GitConfigParams) (git.Author, git.Signer, error) {
+	signer, author := params.Signer, params.Author
+	resolveSigner := signer == nil && !params.DryRun
+
+	if resolveSigner || author == (git.Author{}) {
+		config, err := getWegoConfig(ctx, kubeClient, params.Namespace)
+		if err != nil {
+			return git.Author{}, nil, err
+		}
+
+		if resolveSigner {
+			signer, err = getConfiguredSigner(ctx, kubeClient, config, params.Namespace)
+			if err != nil {
+				return git.Author{}, nil, fmt.Errorf("error getting commit signer: %w", err)
+			}
+		}
+
+		if author == (git.Author{}) {
+			author = git.Author{Name: config.CommitAuthorName, Email: config.CommitAuthorEmail}
+		}
+	}
+
+	return author, signer, nil
+}
+
+// getWegoConfig returns the wego config of a namespace, or an empty config when gitops isn't installed in it
+func getWegoConfig(ctx context.Context, kubeClient kube.Kube, namespace string) (*kube.WegoConfig, error) {
+	config, err := kubeClient.GetWegoConfig(ctx, namespace)
+	if err != nil && !errors.Is(err, kube.ErrWegoConfigNotFound) {
+		return nil, fmt.Errorf("error getting wego config: %w", err)
+	}
+
+	if config == nil {
+		config = &kube.WegoConfig{}
+	}
+
+	return config, nil
 }
 
 func (f *defaultFactory) getAuthService(kubeClient kube.Kube, normalizedUrl gitproviders.RepoURL, gpClient gitproviders.Client, dryRun bool) (auth.AuthService, error) {
@@ -2,13 +2,24 @@ package services
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/pkg/flux/fluxfakes"
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/git/gitfakes"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders/gitprovidersfakes"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/kube/kubefakes"
 	"github.com/weaveworks/weave-gitops/pkg/logger/loggerfakes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 var _ = Describe("Services factory", func() {
@@ -48,5 +59,98 @@ var _ = Describe("Services factory", func() {
 			Expect(gitProvider).To(BeNil())
 			Expect(err.Error()).To(MatchRegexp("error normalizing config url*."))
 		})
+
+		Describe("commit signing", func() {
+			params := GitConfigParams{
+				ConfigRepo: "ssh://git@github.com/test-user/test-repo.git",
+				Namespace:  "wego-system",
+			}
+
+			BeforeEach(func() {
+				fakeKube.GetWegoConfigReturns(&kube.WegoConfig{
+					WegoNamespace:    "wego-system",
+					SigningKeySecret: "wego-signing-key",
+				}, nil)
+			})
+
+			It("signs with the key of the signing key secret of the wego config", func() {
+				_, key, err := ed25519.GenerateKey(rand.Reader)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				der, err := x509.MarshalPKCS8PrivateKey(key)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				fakeKube.GetSecretReturns(&corev1.Secret{Data: map[string][]byte{
+					SigningKeySecretKey: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}),
+				}}, nil)
+
+				_, signer, err := committer(ctx, fakeKube, params)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(signer).NotTo(BeNil())
+
+				_, name := fakeKube.GetSecretArgsForCall(0)
+				Expect(name).To(Equal(types.NamespacedName{Name: "wego-signing-key", Namespace: "wego-system"}))
+
+				_, namespace := fakeKube.GetWegoConfigArgsForCall(0)
+				Expect(namespace).To(Equal("wego-system"))
+			})
+
+			It("fails when the signing key secret doesn't exist", func() {
+				_, _, err := committer(ctx, fakeKube, params)
+				Expect(err).To(MatchError("error getting commit signer: signing key secret wego-system/wego-signing-key not found"))
+			})
+
+			It("fails when the signing key secret has no key", func() {
+				fakeKube.GetSecretReturns(&corev1.Secret{Data: map[string][]byte{}}, nil)
+
+				_, _, err := committer(ctx, fakeKube, params)
+				Expect(err).To(MatchError(ContainSubstring(`has no "signing.key" key`)))
+			})
+
+			It("prefers the signer and the author of the parameters", func() {
+				signingParams := params
+				signingParams.Signer = &gitfakes.FakeSigner{}
+				signingParams.Author = git.Author{Name: "bot", Email: "bot@example.com"}
+
+				author, signer, err := committer(ctx, fakeKube, signingParams)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(author).To(Equal(signingParams.Author))
+				Expect(signer).To(Equal(signingParams.Signer))
+				Expect(fakeKube.GetWegoConfigCallCount()).To(Equal(0))
+			})
+
+			It("doesn't read the signing key in a dry run", func() {
+				dryRunParams := params
+				dryRunParams.DryRun = true
+
+				gitClient, _, err := factory.GetGitClients(ctx, fakeKube, fakeClient, dryRunParams)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(gitClient).NotTo(BeNil())
+				Expect(fakeKube.GetSecretCallCount()).To(Equal(0))
+			})
+
+			It("fails when the wego config can't be read", func() {
+				fakeKube.GetWegoConfigReturns(nil, errors.New("forbidden"))
+
+				_, _, err := committer(ctx, fakeKube, params)
+				Expect(err).To(MatchError("error getting wego config: forbidden"))
+			})
+		})
+	})
+
+	Describe("git config params of an app", func() {
+		It("tells helm repositories and buckets apart", func() {
+			app := &wego.Application{Spec: wego.ApplicationSpec{SourceType: wego.SourceTypeBucket}}
+
+			params := NewGitConfigParamsFromApp(app, false)
+			Expect(params.IsBucket).To(BeTrue())
+			Expect(params.IsHelmRepository).To(BeFalse())
+
+			app.Spec.SourceType = wego.SourceTypeOCI
+
+			params = NewGitConfigParamsFromApp(app, false)
+			Expect(params.IsBucket).To(BeFalse())
+			Expect(params.IsHelmRepository).To(BeTrue())
+		})
 	})
 })
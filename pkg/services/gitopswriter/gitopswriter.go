@@ -2,8 +2,12 @@ package gitopswriter
 
 import (
 	"context"
+	"crypto/md5"
+	"errors"
 	"fmt"
+	"io/fs"
 	"path/filepath"
+	"strings"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/weaveworks/weave-gitops/pkg/git"
@@ -19,6 +23,7 @@ import (
 
 const (
 	AddCommitMessage     = "Add application manifests"
+	UpdateCommitMessage  = "Update application manifests"
 	RemoveCommitMessage  = "Remove application manifests"
 	ClusterCommitMessage = "Associate cluster"
 )
@@ -26,8 +31,9 @@ const (
 var _ GitOpsDirectoryWriter = &gitOpsDirectoryWriterSvc{}
 
 type GitOpsDirectoryWriter interface {
-	AddApplication(ctx context.Context, app models.Application, clusterName string, autoMerge bool) error
-	RemoveApplication(ctx context.Context, app models.Application, clusterName string, autoMerge bool) error
+	AddApplication(ctx context.Context, app models.Application, clusterNames []string, autoMerge bool) error
+	UpdateApplication(ctx context.Context, app models.Application, appIdentifier string, clusterNames []string, autoMerge bool) error
+	RemoveApplication(ctx context.Context, app models.Application, clusterNames []string, autoMerge bool) error
 }
 
 type gitOpsDirectoryWriterSvc struct {
@@ -35,19 +41,31 @@ type gitOpsDirectoryWriterSvc struct {
 	RepoWriter gitrepo.RepoWriter
 	Osys       osys.Osys
 	Logger     logger.Logger
+	Templates  models.CommitTemplates
+	PROptions  gitproviders.PullRequestOptions
 }
 
-func NewGitOpsDirectoryWriter(automationSvc automation.AutomationGenerator, repoWriter gitrepo.RepoWriter, osys osys.Osys, logger logger.Logger) GitOpsDirectoryWriter {
+// NewGitOpsDirectoryWriter returns a GitOpsDirectoryWriter writing the commit messages and pull requests of the templates,
+// its pull requests having the reviewers, labels, assignees and draft status of the options
+func NewGitOpsDirectoryWriter(automationSvc automation.AutomationGenerator, repoWriter gitrepo.RepoWriter, osys osys.Osys, logger logger.Logger, templates models.CommitTemplates, prOptions gitproviders.PullRequestOptions) GitOpsDirectoryWriter {
 	return &gitOpsDirectoryWriterSvc{
 		Automation: automationSvc,
 		RepoWriter: repoWriter,
 		Osys:       osys,
 		Logger:     logger,
+		Templates:  templates,
+		PROptions:  prOptions,
 	}
 }
 
-func (dw *gitOpsDirectoryWriterSvc) AddApplication(ctx context.Context, app models.Application, clusterName string, autoMerge bool) error {
-	auto, err := dw.Automation.GenerateApplicationAutomation(ctx, app, clusterName)
+// AddApplication writes the automation of an application to the config repo and references it
+// from the user kustomization of each of the given clusters
+func (dw *gitOpsDirectoryWriterSvc) AddApplication(ctx context.Context, app models.Application, clusterNames []string, autoMerge bool) error {
+	if len(clusterNames) == 0 {
+		return errors.New("no cluster to add the application to")
+	}
+
+	auto, err := dw.Automation.GenerateApplicationAutomation(ctx, app)
 	if err != nil {
 		return fmt.Errorf("could not generate GitOps Automation manifests for application %s: %w", app.Name, err)
 	}
@@ -66,22 +84,109 @@ func (dw *gitOpsDirectoryWriterSvc) AddApplication(ctx context.Context, app mode
 
 	defer remover()
 
-	resourceEntry, err := appKustomizeReference(getUserKustomizationRepoPath(clusterName), appPath(app.Name))
+	for _, clusterName := range clusterNames {
+		resourceEntry, err := appKustomizeReference(getUserKustomizationRepoPath(clusterName), appPath(app.Name))
+		if err != nil {
+			return err
+		}
+
+		kManifest, err := addKustomizeResources(app, repoDir, clusterName, resourceEntry)
+		if err != nil {
+			return err
+		}
+
+		manifests = append(manifests, kManifest)
+
+		dw.Logger.Actionf("Adding application %q to cluster %q and repository", app.Name, clusterName)
+	}
+
+	prInfo, err := dw.Templates.Apply(gitproviders.PullRequestInfo{
+		Title:              fmt.Sprintf("Gitops add %s", app.Name),
+		Description:        fmt.Sprintf("Added yamls for %s", app.Name),
+		CommitMessage:      AddCommitMessage,
+		TargetBranch:       defaultBranch,
+		NewBranch:          automation.GetAppHash(app),
+		PullRequestOptions: dw.PROptions,
+	}, models.CommitData{Action: models.ActionAddApplication, App: &app, Clusters: clusterNames})
 	if err != nil {
 		return err
 	}
 
-	kManifest, err := addKustomizeResources(app, repoDir, clusterName, resourceEntry)
+	if autoMerge {
+		if err := dw.RepoWriter.WriteAndMerge(ctx, repoDir, prInfo.CommitMessage, manifests); err != nil {
+			return fmt.Errorf("failed writing automation to disk: %w", err)
+		}
+
+		return nil
+	}
+
+	files := []gitprovider.CommitFile{}
+
+	for _, manifest := range manifests {
+		manifestPath := manifest.Path
+		content := string(manifest.Content)
+
+		files = append(files, gitprovider.CommitFile{Path: &manifestPath, Content: &content})
+	}
+
+	prInfo.Files = files
+
+	if err := dw.RepoWriter.CreatePullRequest(ctx, prInfo); err != nil {
+		return fmt.Errorf("failed creating pull request: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateApplication regenerates the automation of an application from its updated spec for the given clusters.
+// The app is labelled with appIdentifier, the identifier of the deployed app, so it is still found by it.
+func (dw *gitOpsDirectoryWriterSvc) UpdateApplication(ctx context.Context, app models.Application, appIdentifier string, clusterNames []string, autoMerge bool) error {
+	if len(clusterNames) == 0 {
+		return errors.New("no cluster to update the application in")
+	}
+
+	auto, err := dw.Automation.GenerateApplicationAutomation(ctx, app)
 	if err != nil {
-		return err
+		return fmt.Errorf("could not generate GitOps Automation manifests for application %s: %w", app.Name, err)
+	}
+
+	auto.AppYaml, err = automation.SetAppIdentifier(auto.AppYaml, appIdentifier)
+	if err != nil {
+		return fmt.Errorf("could not label application %s: %w", app.Name, err)
+	}
+
+	manifests := auto.Manifests()
+
+	defaultBranch, err := dw.RepoWriter.GetDefaultBranch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve default branch for repository: %w", err)
 	}
 
-	manifests = append(manifests, kManifest)
+	for _, clusterName := range clusterNames {
+		dw.Logger.Actionf("Updating application %q in cluster %q and repository", app.Name, clusterName)
+	}
 
-	dw.Logger.Actionf("Adding application %q to cluster %q and repository", app.Name, clusterName)
+	prInfo, err := dw.Templates.Apply(gitproviders.PullRequestInfo{
+		Title:              fmt.Sprintf("Gitops update %s", app.Name),
+		Description:        fmt.Sprintf("Updated yamls for %s", app.Name),
+		CommitMessage:      UpdateCommitMessage,
+		TargetBranch:       defaultBranch,
+		NewBranch:          updateBranchName(appIdentifier, manifests),
+		PullRequestOptions: dw.PROptions,
+	}, models.CommitData{Action: models.ActionUpdateApplication, App: &app, Clusters: clusterNames})
+	if err != nil {
+		return err
+	}
 
 	if autoMerge {
-		if err := dw.RepoWriter.WriteAndMerge(ctx, repoDir, AddCommitMessage, manifests); err != nil {
+		remover, repoDir, err := dw.RepoWriter.CloneRepo(ctx, defaultBranch)
+		if err != nil {
+			return fmt.Errorf("failed to clone repo: %w", err)
+		}
+
+		defer remover()
+
+		if err := dw.RepoWriter.WriteAndMerge(ctx, repoDir, prInfo.CommitMessage, manifests); err != nil {
 			return fmt.Errorf("failed writing automation to disk: %w", err)
 		}
 
@@ -97,14 +202,7 @@ func (dw *gitOpsDirectoryWriterSvc) AddApplication(ctx context.Context, app mode
 		files = append(files, gitprovider.CommitFile{Path: &manifestPath, Content: &content})
 	}
 
-	prInfo := gitproviders.PullRequestInfo{
-		Title:         fmt.Sprintf("Gitops add %s", app.Name),
-		Description:   fmt.Sprintf("Added yamls for %s", app.Name),
-		CommitMessage: AddCommitMessage,
-		TargetBranch:  defaultBranch,
-		NewBranch:     automation.GetAppHash(app),
-		Files:         files,
-	}
+	prInfo.Files = files
 
 	if err := dw.RepoWriter.CreatePullRequest(ctx, prInfo); err != nil {
 		return fmt.Errorf("failed creating pull request: %w", err)
@@ -113,13 +211,43 @@ func (dw *gitOpsDirectoryWriterSvc) AddApplication(ctx context.Context, app mode
 	return nil
 }
 
-func (dw *gitOpsDirectoryWriterSvc) RemoveApplication(ctx context.Context, app models.Application, clusterName string, autoMerge bool) error {
+// updateBranchName derives the branch name of an update from its content, so that
+// different updates to the same app don't collide
+func updateBranchName(appIdentifier string, manifests []models.Manifest) string {
+	h := md5.New()
+
+	for _, m := range manifests {
+		h.Write(m.Content)
+	}
+
+	return fmt.Sprintf("%s-update-%x", appIdentifier, h.Sum(nil)[:4])
+}
+
+// RemoveApplication removes the reference to an application from the user kustomization of each of
+// the given clusters. The automation of the application is removed from the config repo as well once
+// no other cluster references it.
+func (dw *gitOpsDirectoryWriterSvc) RemoveApplication(ctx context.Context, app models.Application, clusterNames []string, autoMerge bool) error {
+	if len(clusterNames) == 0 {
+		return errors.New("no cluster to remove the application from")
+	}
+
 	defaultBranch, err := dw.RepoWriter.GetDefaultBranch(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to retrieve default branch for repository: %w", err)
 	}
 
-	newBranchName := automation.GetAppHash(app)
+	prInfo, err := dw.Templates.Apply(gitproviders.PullRequestInfo{
+		Title:                     fmt.Sprintf("Gitops remove %s", app.Name),
+		Description:               fmt.Sprintf("Removed yamls for %s", app.Name),
+		CommitMessage:             RemoveCommitMessage,
+		TargetBranch:              defaultBranch,
+		NewBranch:                 automation.GetAppHash(app),
+		SkipAddingFilesOnCreation: true,
+		PullRequestOptions:        dw.PROptions,
+	}, models.CommitData{Action: models.ActionRemoveApplication, App: &app, Clusters: clusterNames})
+	if err != nil {
+		return err
+	}
 
 	remover, repoDir, err := dw.RepoWriter.CloneRepo(ctx, defaultBranch)
 	if err != nil {
@@ -128,61 +256,64 @@ func (dw *gitOpsDirectoryWriterSvc) RemoveApplication(ctx context.Context, app m
 
 	defer remover()
 
-	dw.Logger.Actionf("Removing application %q from cluster %q and repository", app.Name, clusterName)
-
-	appSubDir := automation.AppYamlDir(app)
-	appDir := filepath.Join(repoDir, appSubDir)
-
-	resourcePaths, err := dw.Osys.ReadDir(appDir)
+	remainingClusters, err := clustersReferencingApp(repoDir, app, clusterNames)
 	if err != nil {
-		return fmt.Errorf("failed to read resource files: %w", err)
+		return err
 	}
 
 	if !autoMerge {
-		err = dw.RepoWriter.CheckoutBranch(newBranchName)
+		err = dw.RepoWriter.CheckoutBranch(prInfo.NewBranch)
 		if err != nil {
 			return fmt.Errorf("failed to checkout branch in configuration repo: %w", err)
 		}
 	}
 
-	for _, resourcePath := range resourcePaths {
-		pathStr := filepath.Join(appSubDir, resourcePath.Name())
-		if err := dw.RepoWriter.Remove(ctx, pathStr); err != nil {
-			return fmt.Errorf("failed to remove app resource from repository: %w", err)
+	if len(remainingClusters) == 0 {
+		dw.Logger.Actionf("Removing application %q from repository", app.Name)
+
+		appSubDir := automation.AppYamlDir(app)
+		appDir := filepath.Join(repoDir, appSubDir)
+
+		resourcePaths, err := dw.Osys.ReadDir(appDir)
+		if err != nil {
+			return fmt.Errorf("failed to read resource files: %w", err)
 		}
-	}
 
-	// Remove reference in kustomization file
-	resourceEntry, err := appKustomizeReference(getUserKustomizationRepoPath(clusterName), appPath(app.Name))
-	if err != nil {
-		return err
+		for _, resourcePath := range resourcePaths {
+			pathStr := filepath.Join(appSubDir, resourcePath.Name())
+			if err := dw.RepoWriter.Remove(ctx, pathStr); err != nil {
+				return fmt.Errorf("failed to remove app resource from repository: %w", err)
+			}
+		}
+	} else {
+		dw.Logger.Actionf("Keeping application %q in repository, it is still used by clusters %s", app.Name, strings.Join(remainingClusters, ", "))
 	}
 
-	kManifest, err := removeKustomizeResources(app, repoDir, clusterName, resourceEntry)
+	for _, clusterName := range clusterNames {
+		dw.Logger.Actionf("Removing application %q from cluster %q", app.Name, clusterName)
 
-	if err != nil {
-		return fmt.Errorf("failed to remove app reference from user kustomize file: %w", err)
-	}
+		// Remove reference in kustomization file
+		resourceEntry, err := appKustomizeReference(getUserKustomizationRepoPath(clusterName), appPath(app.Name))
+		if err != nil {
+			return err
+		}
 
-	if err = dw.RepoWriter.Write(ctx, kManifest.Path, kManifest.Content); err != nil {
-		return fmt.Errorf("failed to write updated kustomize file: %w", err)
+		kManifest, err := removeKustomizeResources(app, repoDir, clusterName, resourceEntry)
+		if err != nil {
+			return fmt.Errorf("failed to remove app reference from user kustomize file: %w", err)
+		}
+
+		if err = dw.RepoWriter.Write(ctx, kManifest.Path, kManifest.Content); err != nil {
+			return fmt.Errorf("failed to write updated kustomize file: %w", err)
+		}
 	}
 
-	err = dw.RepoWriter.CommitAndPush(ctx, RemoveCommitMessage)
+	err = dw.RepoWriter.CommitAndPush(ctx, prInfo.CommitMessage)
 	if err != nil {
 		return fmt.Errorf("failed to commit and push changes %w", err)
 	}
 
 	if !autoMerge {
-		prInfo := gitproviders.PullRequestInfo{
-			Title:                     fmt.Sprintf("Gitops remove %s", app.Name),
-			Description:               fmt.Sprintf("Removed yamls for %s", app.Name),
-			CommitMessage:             RemoveCommitMessage,
-			TargetBranch:              defaultBranch,
-			NewBranch:                 newBranchName,
-			SkipAddingFilesOnCreation: true,
-		}
-
 		if err := dw.RepoWriter.CreatePullRequest(ctx, prInfo); err != nil {
 			return fmt.Errorf("failed creating pull request: %w", err)
 		}
@@ -192,7 +323,7 @@ func (dw *gitOpsDirectoryWriterSvc) RemoveApplication(ctx context.Context, app m
 }
 
 type RepoWriter interface {
-	Write(ctx context.Context, repoURL gitproviders.RepoURL, branch string, manifests []gitprovider.CommitFile) error
+	Write(ctx context.Context, repoURL gitproviders.RepoURL, branch, commitMessage string, manifests []gitprovider.CommitFile) error
 }
 
 type repoWriter struct {
@@ -209,7 +340,7 @@ func NewRepoWriter(log logger.Logger, gitClient git.Git, gitProvider gitprovider
 	}
 }
 
-func (rw *repoWriter) Write(ctx context.Context, repoURL gitproviders.RepoURL, branch string, manifests []gitprovider.CommitFile) error {
+func (rw *repoWriter) Write(ctx context.Context, repoURL gitproviders.RepoURL, branch, commitMessage string, manifests []gitprovider.CommitFile) error {
 	// TODO: auto-merge will not work for most users
 	remover, _, err := gitrepo.CloneRepo(ctx, rw.gitClient, repoURL, branch)
 	if err != nil {
@@ -224,7 +355,7 @@ func (rw *repoWriter) Write(ctx context.Context, repoURL gitproviders.RepoURL, b
 		}
 	}
 
-	err = gitrepo.CommitAndPush(ctx, rw.gitClient, ClusterCommitMessage, rw.log, func(fname string) bool {
+	err = gitrepo.CommitAndPush(ctx, rw.gitClient, commitMessage, rw.log, func(fname string) bool {
 		return true
 	})
 	if err != nil {
@@ -296,6 +427,62 @@ func removeKustomizeResources(app models.Application, repoDir, clusterName strin
 	}, nil
 }
 
+// clustersReferencingApp returns the clusters, other than the excluded ones, whose user kustomization
+// in the cloned config repo references the app
+func clustersReferencingApp(repoDir string, app models.Application, excluded []string) ([]string, error) {
+	clustersDir := filepath.Join(repoDir, git.WegoRoot, git.WegoClusterDir)
+	clusters := []string{}
+
+	err := filepath.WalkDir(clustersDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		}
+
+		if d.IsDir() || d.Name() != "kustomization.yaml" || filepath.Base(filepath.Dir(path)) != "user" {
+			return nil
+		}
+
+		clusterName, err := filepath.Rel(clustersDir, filepath.Dir(filepath.Dir(path)))
+		if err != nil {
+			return err
+		}
+
+		for _, e := range excluded {
+			if e == clusterName {
+				return nil
+			}
+		}
+
+		resourceEntry, err := appKustomizeReference(getUserKustomizationRepoPath(clusterName), appPath(app.Name))
+		if err != nil {
+			return err
+		}
+
+		k, err := automation.GetOrCreateKustomize(path, clusterName, app.Namespace)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range k.Resources {
+			if r == resourceEntry {
+				clusters = append(clusters, clusterName)
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the clusters using application %s: %w", app.Name, err)
+	}
+
+	return clusters, nil
+}
+
 func getUserDirRepoPath(clusterName string) string {
 	return filepath.Join(git.WegoRoot, git.WegoClusterDir, clusterName, "user")
 }
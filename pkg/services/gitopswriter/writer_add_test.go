@@ -33,7 +33,7 @@ func createDirWriter() GitOpsDirectoryWriter {
 	repoWriter := gitrepo.NewRepoWriter(app.ConfigRepo, gitProviders, gitClient, log)
 	automationGen := automation.NewAutomationGenerator(gitProviders, fluxClient, log)
 
-	return NewGitOpsDirectoryWriter(automationGen, repoWriter, osysClient, log)
+	return NewGitOpsDirectoryWriter(automationGen, repoWriter, osysClient, log, models.CommitTemplates{}, gitproviders.PullRequestOptions{})
 }
 
 var dummyGitSource = []byte(`---
@@ -110,7 +110,7 @@ var _ = Describe("Add", func() {
 
 		Describe("generates application goat", func() {
 			It("clones the repo to a temp dir", func() {
-				err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+				err := gitOpsDirWriter.AddApplication(ctx, app, []string{"test-cluster"}, true)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				Expect(gitClient.CloneCallCount()).To(Equal(1))
@@ -125,7 +125,7 @@ var _ = Describe("Add", func() {
 				fluxClient.CreateSourceGitReturns(dummyGitSource, nil)
 				fluxClient.CreateKustomizationReturns([]byte("kustomization"), nil)
 
-				err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+				err := gitOpsDirWriter.AddApplication(ctx, app, []string{"test-cluster"}, true)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				Expect(gitClient.WriteCallCount()).To(Equal(5))
@@ -152,14 +152,13 @@ var _ = Describe("Add", func() {
 			})
 
 			It("commits and pushes the files", func() {
-				err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+				err := gitOpsDirWriter.AddApplication(ctx, app, []string{"test-cluster"}, true)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				Expect(gitClient.CommitCallCount()).To(Equal(1))
 
 				msg, filters := gitClient.CommitArgsForCall(0)
 				Expect(msg).To(Equal(git.Commit{
-					Author:  git.Author{Name: "Weave Gitops", Email: "weave-gitops@weave.works"},
 					Message: AddCommitMessage,
 				}))
 
@@ -177,7 +176,7 @@ var _ = Describe("Add", func() {
 		})
 
 		It("clones the repo to a temp dir", func() {
-			err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+			err := gitOpsDirWriter.AddApplication(ctx, app, []string{"test-cluster"}, true)
 			Expect(err).ShouldNot(HaveOccurred())
 
 			Expect(gitClient.CloneCallCount()).To(Equal(1))
@@ -192,7 +191,7 @@ var _ = Describe("Add", func() {
 			fluxClient.CreateSourceGitReturns(dummyGitSource, nil)
 			fluxClient.CreateKustomizationReturns([]byte("kustomization"), nil)
 
-			err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+			err := gitOpsDirWriter.AddApplication(ctx, app, []string{"test-cluster"}, true)
 			Expect(err).ShouldNot(HaveOccurred())
 
 			Expect(gitClient.WriteCallCount()).To(Equal(5))
@@ -221,15 +220,34 @@ var _ = Describe("Add", func() {
 			Expect(content).To(Equal(dummyUserKustomization))
 		})
 
+		It("references the app from the user kustomization of each cluster", func() {
+			err := gitOpsDirWriter.AddApplication(ctx, app, []string{"cluster-a", "cluster-b"}, true)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(gitClient.WriteCallCount()).To(Equal(6))
+
+			path, content := gitClient.WriteArgsForCall(4)
+			Expect(path).To(Equal(".weave-gitops/clusters/cluster-a/user/kustomization.yaml"))
+			Expect(string(content)).To(ContainSubstring("- ../../../apps/bar\n"))
+
+			path, content = gitClient.WriteArgsForCall(5)
+			Expect(path).To(Equal(".weave-gitops/clusters/cluster-b/user/kustomization.yaml"))
+			Expect(string(content)).To(ContainSubstring("- ../../../apps/bar\n"))
+		})
+
+		It("requires a cluster", func() {
+			err := gitOpsDirWriter.AddApplication(ctx, app, nil, true)
+			Expect(err).To(MatchError("no cluster to add the application to"))
+		})
+
 		It("commits and pushes the files", func() {
-			err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+			err := gitOpsDirWriter.AddApplication(ctx, app, []string{"test-cluster"}, true)
 			Expect(err).ShouldNot(HaveOccurred())
 
 			Expect(gitClient.CommitCallCount()).To(Equal(1))
 
 			msg, filters := gitClient.CommitArgsForCall(0)
 			Expect(msg).To(Equal(git.Commit{
-				Author:  git.Author{Name: "Weave Gitops", Email: "weave-gitops@weave.works"},
 				Message: AddCommitMessage,
 			}))
 
@@ -258,7 +276,7 @@ var _ = Describe("Add", func() {
 			})
 
 			It("merges into the app default branch", func() {
-				err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+				err := gitOpsDirWriter.AddApplication(ctx, app, []string{"test-cluster"}, true)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				_, _, _, branch := gitClient.CloneArgsForCall(0)
@@ -273,7 +291,7 @@ var _ = Describe("Add", func() {
 			})
 
 			It("merges into the config default branch", func() {
-				err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+				err := gitOpsDirWriter.AddApplication(ctx, app, []string{"test-cluster"}, true)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				_, _, _, branch := gitClient.CloneArgsForCall(0)
@@ -305,7 +323,7 @@ var _ = Describe("Add", func() {
 			})
 
 			It("creates the pull request against the app default branch", func() {
-				err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", false)
+				err := gitOpsDirWriter.AddApplication(ctx, app, []string{"test-cluster"}, false)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				_, _, prInfo := gitProviders.CreatePullRequestArgsForCall(0)
@@ -320,12 +338,67 @@ var _ = Describe("Add", func() {
 			})
 
 			It("creates the pull request against the config default branch", func() {
-				err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", false)
+				err := gitOpsDirWriter.AddApplication(ctx, app, []string{"test-cluster"}, false)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				_, _, prInfo := gitProviders.CreatePullRequestArgsForCall(0)
 				Expect(prInfo.TargetBranch).To(Equal("default-config-branch"))
 			})
 		})
+
+		Context("with commit templates", func() {
+			BeforeEach(func() {
+				app.ConfigRepo = createRepoURL("https://github.com/foo/bar")
+				repoWriter := gitrepo.NewRepoWriter(app.ConfigRepo, gitProviders, gitClient, log)
+				automationGen := automation.NewAutomationGenerator(gitProviders, fluxClient, log)
+				gitOpsDirWriter = NewGitOpsDirectoryWriter(automationGen, repoWriter, osysClient, log, models.CommitTemplates{
+					CommitMessage:    "feat: {{ .Default }}",
+					PullRequestTitle: "feat: add {{ .App.Name }} to {{ join .Clusters \", \" }}",
+				}, gitproviders.PullRequestOptions{})
+			})
+
+			It("writes the pull request of the templates", func() {
+				err := gitOpsDirWriter.AddApplication(ctx, app, []string{"cluster-a", "cluster-b"}, false)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, _, prInfo := gitProviders.CreatePullRequestArgsForCall(0)
+				Expect(prInfo.CommitMessage).To(Equal("feat: " + AddCommitMessage))
+				Expect(prInfo.Title).To(Equal("feat: add bar to cluster-a, cluster-b"))
+				Expect(prInfo.Description).To(Equal("Added yamls for bar"))
+				Expect(prInfo.Files).NotTo(BeEmpty())
+			})
+
+			It("commits with the message of the template", func() {
+				err := gitOpsDirWriter.AddApplication(ctx, app, []string{"test-cluster"}, true)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				msg, _ := gitClient.CommitArgsForCall(0)
+				Expect(msg.Message).To(Equal("feat: " + AddCommitMessage))
+			})
+		})
+
+		Context("with pull request options", func() {
+			BeforeEach(func() {
+				app.ConfigRepo = createRepoURL("https://github.com/foo/bar")
+				repoWriter := gitrepo.NewRepoWriter(app.ConfigRepo, gitProviders, gitClient, log)
+				automationGen := automation.NewAutomationGenerator(gitProviders, fluxClient, log)
+				gitOpsDirWriter = NewGitOpsDirectoryWriter(automationGen, repoWriter, osysClient, log, models.CommitTemplates{}, gitproviders.PullRequestOptions{
+					Reviewers: []string{"alice"},
+					Labels:    []string{"gitops"},
+					Draft:     true,
+				})
+			})
+
+			It("creates the pull request with the options", func() {
+				err := gitOpsDirWriter.AddApplication(ctx, app, []string{"test-cluster"}, false)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, _, prInfo := gitProviders.CreatePullRequestArgsForCall(0)
+				Expect(prInfo.Reviewers).To(Equal([]string{"alice"}))
+				Expect(prInfo.Labels).To(Equal([]string{"gitops"}))
+				Expect(prInfo.Assignees).To(BeEmpty())
+				Expect(prInfo.Draft).To(BeTrue())
+			})
+		})
 	})
 })
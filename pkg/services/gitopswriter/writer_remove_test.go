@@ -87,7 +87,7 @@ func createRemoveDirWriter() GitOpsDirectoryWriter {
 	repoWriter := gitrepo.NewRepoWriter(app.ConfigRepo, gitProviders, gitClient, log)
 	automationSvc := automation.NewAutomationGenerator(gitProviders, realFlux, log)
 
-	return NewGitOpsDirectoryWriter(automationSvc, repoWriter, osysClient, log)
+	return NewGitOpsDirectoryWriter(automationSvc, repoWriter, osysClient, log, models.CommitTemplates{}, gitproviders.PullRequestOptions{})
 }
 
 // Run 'gitops add app' using cluster name of test-cluster and gathers the resources
@@ -97,7 +97,7 @@ func runAddAndCollectInfo() error {
 }
 
 func runAddAndCollectInfoWithClusterName(clusterName string) error {
-	if err := gitOpsDirWriter.AddApplication(context.Background(), app, clusterName, true); err != nil {
+	if err := gitOpsDirWriter.AddApplication(context.Background(), app, []string{clusterName}, true); err != nil {
 		return err
 	}
 
@@ -217,28 +217,28 @@ var _ = Describe("Remove", func() {
 				It("fails getting default branch", func() {
 					gitProviders.GetDefaultBranchReturns("", customError)
 
-					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, "test-cluster", false)
+					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"test-cluster"}, false)
 					Expect(err.Error()).To(ContainSubstring(customError.Error()))
 				})
 
 				It("fails cloning config repo", func() {
 					gitClient.CloneReturns(false, customError)
 
-					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, "test-cluster", false)
+					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"test-cluster"}, false)
 					Expect(err.Error()).To(ContainSubstring(customError.Error()))
 				})
 
 				It("fails reading directory", func() {
 					osysClient.ReadDirReturns(nil, customError)
 
-					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, "test-cluster", false)
+					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"test-cluster"}, false)
 					Expect(err.Error()).To(ContainSubstring(customError.Error()))
 				})
 
 				It("fails checking out branch", func() {
 					gitClient.CheckoutReturns(customError)
 
-					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, "test-cluster", false)
+					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"test-cluster"}, false)
 					Expect(err.Error()).To(ContainSubstring(customError.Error()))
 				})
 
@@ -246,7 +246,7 @@ var _ = Describe("Remove", func() {
 					gitClient.RemoveReturns(customError)
 
 					Expect(runAddAndCollectInfo()).To(Succeed())
-					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, "test-cluster", false)
+					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"test-cluster"}, false)
 					Expect(err.Error()).To(ContainSubstring(customError.Error()))
 				})
 
@@ -255,7 +255,7 @@ var _ = Describe("Remove", func() {
 
 					gitClient.WriteReturns(customError)
 
-					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, "test-cluster", false)
+					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"test-cluster"}, false)
 					Expect(err.Error()).To(ContainSubstring(customError.Error()))
 				})
 
@@ -264,7 +264,7 @@ var _ = Describe("Remove", func() {
 
 					gitClient.CommitReturns("", customError)
 
-					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, "test-cluster", false)
+					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"test-cluster"}, false)
 					Expect(err.Error()).To(ContainSubstring(customError.Error()))
 				})
 
@@ -273,7 +273,7 @@ var _ = Describe("Remove", func() {
 
 					gitProviders.CreatePullRequestReturns(nil, customError)
 
-					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, "test-cluster", false)
+					err := gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"test-cluster"}, false)
 					Expect(err.Error()).To(ContainSubstring(customError.Error()))
 				})
 			})
@@ -283,7 +283,7 @@ var _ = Describe("Remove", func() {
 				app.Path = "loki"
 
 				Expect(runAddAndCollectInfo()).To(Succeed())
-				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, "test-cluster", true)).To(Succeed())
+				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"test-cluster"}, true)).To(Succeed())
 				Expect(checkRemoveResults()).To(Succeed())
 			})
 
@@ -293,7 +293,7 @@ var _ = Describe("Remove", func() {
 				app.Path = "./"
 
 				Expect(runAddAndCollectInfo()).To(Succeed())
-				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, "test-cluster", true)).To(Succeed())
+				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"test-cluster"}, true)).To(Succeed())
 				Expect(checkRemoveResults()).To(Succeed())
 			})
 
@@ -308,7 +308,7 @@ var _ = Describe("Remove", func() {
 				})
 
 				Expect(runAddAndCollectInfo()).To(Succeed())
-				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, "test-cluster", false)).To(Succeed())
+				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"test-cluster"}, false)).To(Succeed())
 				Expect(checkRemoveResults()).To(Succeed())
 			})
 
@@ -334,13 +334,13 @@ var _ = Describe("Remove", func() {
 
 			It("removes cluster resources for non-helm app configRepo = ''", func() {
 				Expect(runAddAndCollectInfo()).To(Succeed())
-				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, "test-cluster", true)).To(Succeed())
+				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"test-cluster"}, true)).To(Succeed())
 				Expect(checkRemoveResults()).To(Succeed())
 			})
 
 			It("commits the manifests with remove message", func() {
 				Expect(runAddAndCollectInfo()).To(Succeed())
-				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, "test-cluster", true)).To(Succeed())
+				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"test-cluster"}, true)).To(Succeed())
 				Expect(checkRemoveResults()).To(Succeed())
 
 				commit, _ := gitClient.CommitArgsForCall(1)
@@ -352,9 +352,42 @@ var _ = Describe("Remove", func() {
 				app.ConfigRepo = createRepoURL("ssh://git@github.com/user/external.git")
 
 				Expect(runAddAndCollectInfo()).To(Succeed())
-				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, "test-cluster", true)).To(Succeed())
+				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"test-cluster"}, true)).To(Succeed())
 				Expect(checkRemoveResults()).To(Succeed())
 			})
+
+			It("keeps the app resources while other clusters still use the app", func() {
+				userKustomizations := map[string][]byte{}
+
+				gitClient.WriteStub = func(path string, manifest []byte) error {
+					storeGOATPath(path)
+					if strings.HasSuffix(path, "user/kustomization.yaml") {
+						userKustomizations[path] = manifest
+					}
+					return nil
+				}
+				gitClient.CloneStub = func(arg1 context.Context, dir string, arg3 string, arg4 string) (bool, error) {
+					for path, content := range userKustomizations {
+						p := filepath.Join(dir, path)
+						Expect(os.MkdirAll(filepath.Dir(p), 0700)).To(Succeed(), "failed to create git dir")
+						Expect(os.WriteFile(p, content, 0666)).To(Succeed())
+					}
+
+					return false, nil
+				}
+
+				Expect(gitOpsDirWriter.AddApplication(context.Background(), app, []string{"cluster-a", "cluster-b"}, true)).To(Succeed())
+
+				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"cluster-a"}, true)).To(Succeed())
+				Expect(gitClient.RemoveCallCount()).To(Equal(0))
+				Expect(string(userKustomizations[".weave-gitops/clusters/cluster-a/user/kustomization.yaml"])).NotTo(ContainSubstring("apps/wego-fork-test"))
+				Expect(string(userKustomizations[".weave-gitops/clusters/cluster-b/user/kustomization.yaml"])).To(ContainSubstring("apps/wego-fork-test"))
+
+				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{"cluster-b"}, true)).To(Succeed())
+				Expect(checkRemoveResults()).To(Succeed())
+				Expect(string(userKustomizations[".weave-gitops/clusters/cluster-b/user/kustomization.yaml"])).NotTo(ContainSubstring("apps/wego-fork-test"))
+			})
+
 			It("removes cluster resources for non-helm app with configRepo = <url> and eksctl cluster name", func() {
 
 				app.GitSourceURL = createRepoURL("ssh://git@github.com/user/wego-fork-test.git")
@@ -373,7 +406,7 @@ var _ = Describe("Remove", func() {
 
 					return false, nil
 				}
-				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, cname, true)).To(Succeed())
+				Expect(gitOpsDirWriter.RemoveApplication(context.Background(), app, []string{cname}, true)).To(Succeed())
 				Expect(checkClusterKustomizationForApp(app.Name)).ToNot(Succeed())
 			})
 		})
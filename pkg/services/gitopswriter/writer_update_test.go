@@ -0,0 +1,130 @@
+package gitopswriter
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+	"github.com/weaveworks/weave-gitops/pkg/services/automation"
+	"github.com/weaveworks/weave-gitops/pkg/services/gitrepo"
+	"github.com/weaveworks/weave-gitops/pkg/testutils"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Update", func() {
+	var updated models.Application
+
+	var _ = BeforeEach(func() {
+		app = models.Application{
+			Name:           "bar",
+			Namespace:      wego.DefaultNamespace,
+			GitSourceURL:   createRepoURL("ssh://git@github.com/foo/bar.git"),
+			ConfigRepo:     createRepoURL("ssh://git@github.com/foo/config.git"),
+			Branch:         "main",
+			Path:           "./kustomize",
+			AutomationType: models.AutomationTypeKustomize,
+			SourceType:     models.SourceTypeGit,
+		}
+
+		updated = app
+		updated.Branch = "dev"
+		updated.Path = "./deploy"
+
+		gitProviders.GetDefaultBranchReturns("main", nil)
+		gitOpsDirWriter = createDirWriter()
+
+		ctx = context.Background()
+	})
+
+	It("writes the regenerated app files", func() {
+		fluxClient.CreateSourceGitReturns(dummyGitSource, nil)
+		fluxClient.CreateKustomizationReturns([]byte("kustomization"), nil)
+
+		Expect(gitOpsDirWriter.UpdateApplication(ctx, updated, automation.GetAppHash(app), []string{"test-cluster"}, true)).To(Succeed())
+
+		Expect(gitClient.WriteCallCount()).To(Equal(4))
+
+		paths := []string{}
+		for i := 0; i < gitClient.WriteCallCount(); i++ {
+			path, _ := gitClient.WriteArgsForCall(i)
+			paths = append(paths, path)
+		}
+
+		Expect(paths).To(Equal([]string{
+			".weave-gitops/apps/bar/app.yaml",
+			".weave-gitops/apps/bar/bar-gitops-deploy.yaml",
+			".weave-gitops/apps/bar/bar-gitops-source.yaml",
+			".weave-gitops/apps/bar/kustomization.yaml",
+		}))
+
+		_, _, branch, _, _ := fluxClient.CreateSourceGitArgsForCall(0)
+		Expect(branch).To(Equal("dev"))
+
+		_, _, path, _ := fluxClient.CreateKustomizationArgsForCall(0)
+		Expect(path).To(Equal("./deploy"))
+	})
+
+	It("labels the app with the identifier of the deployed app", func() {
+		Expect(gitOpsDirWriter.UpdateApplication(ctx, updated, automation.GetAppHash(app), []string{"test-cluster"}, true)).To(Succeed())
+
+		_, content := gitClient.WriteArgsForCall(0)
+		Expect(strings.HasPrefix(string(content), "---\n")).To(BeTrue())
+
+		var wegoApp wego.Application
+		Expect(yaml.Unmarshal(content, &wegoApp)).To(Succeed())
+		Expect(wegoApp.Spec.Branch).To(Equal("dev"))
+		Expect(wegoApp.Labels[automation.WeGOAppIdentifierLabelKey]).To(Equal(automation.GetAppHash(app)))
+		Expect(automation.GetAppHash(app)).NotTo(Equal(automation.GetAppHash(updated)))
+	})
+
+	It("commits and pushes the files", func() {
+		Expect(gitOpsDirWriter.UpdateApplication(ctx, updated, automation.GetAppHash(app), []string{"test-cluster"}, true)).To(Succeed())
+
+		Expect(gitClient.CommitCallCount()).To(Equal(1))
+
+		msg, _ := gitClient.CommitArgsForCall(0)
+		Expect(msg).To(Equal(git.Commit{
+			Message: UpdateCommitMessage,
+		}))
+	})
+
+	It("creates a pull request without cloning the repo", func() {
+		gitProviders.CreatePullRequestReturns(testutils.DummyPullRequest{}, nil)
+
+		Expect(gitOpsDirWriter.UpdateApplication(ctx, updated, automation.GetAppHash(app), []string{"test-cluster"}, false)).To(Succeed())
+
+		Expect(gitClient.CloneCallCount()).To(Equal(0))
+		Expect(gitProviders.CreatePullRequestCallCount()).To(Equal(1))
+
+		_, _, prInfo := gitProviders.CreatePullRequestArgsForCall(0)
+		Expect(prInfo.Title).To(Equal("Gitops update bar"))
+		Expect(prInfo.CommitMessage).To(Equal(UpdateCommitMessage))
+		Expect(prInfo.TargetBranch).To(Equal("main"))
+		Expect(prInfo.NewBranch).To(HavePrefix(automation.GetAppHash(app) + "-update-"))
+		Expect(prInfo.Files).To(HaveLen(4))
+	})
+
+	It("updates the application in every given cluster", func() {
+		gitProviders.CreatePullRequestReturns(testutils.DummyPullRequest{}, nil)
+		repoWriter := gitrepo.NewRepoWriter(app.ConfigRepo, gitProviders, gitClient, log)
+		automationGen := automation.NewAutomationGenerator(gitProviders, fluxClient, log)
+		gitOpsDirWriter = NewGitOpsDirectoryWriter(automationGen, repoWriter, osysClient, log, models.CommitTemplates{
+			PullRequestTitle: "update {{ .App.Name }} in {{ join .Clusters \", \" }}",
+		}, gitproviders.PullRequestOptions{})
+
+		Expect(gitOpsDirWriter.UpdateApplication(ctx, updated, automation.GetAppHash(app), []string{"prod-eu", "prod-us"}, false)).To(Succeed())
+
+		_, _, prInfo := gitProviders.CreatePullRequestArgsForCall(0)
+		Expect(prInfo.Title).To(Equal("update bar in prod-eu, prod-us"))
+	})
+
+	It("fails without a cluster", func() {
+		err := gitOpsDirWriter.UpdateApplication(ctx, updated, automation.GetAppHash(app), nil, true)
+		Expect(err).To(MatchError("no cluster to update the application in"))
+	})
+})
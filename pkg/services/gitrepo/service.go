@@ -2,6 +2,7 @@ package gitrepo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -39,7 +40,9 @@ func NewRepoWriter(url gitproviders.RepoURL, gitProvider gitproviders.GitProvide
 
 func (rw *RepoWriterSvc) CreatePullRequest(ctx context.Context, info gitproviders.PullRequestInfo) error {
 	pr, err := rw.GitProvider.CreatePullRequest(ctx, rw.URL, info)
-	if err != nil {
+	if errors.Is(err, gitproviders.ErrPullRequestOptionsNotSet) {
+		rw.Logger.Warningf("%s", err)
+	} else if err != nil {
 		return fmt.Errorf("unable to create pull request: %w", err)
 	}
 
@@ -86,10 +89,8 @@ func (rw *RepoWriterSvc) CheckoutBranch(newBranch string) error {
 func CommitAndPush(ctx context.Context, client git.Git, commitMsg string, logger logger.Logger, filters ...func(string) bool) error {
 	logger.Actionf("Committing and pushing gitops updates for application")
 
-	_, err := client.Commit(git.Commit{
-		Author:  git.Author{Name: "Weave Gitops", Email: "weave-gitops@weave.works"},
-		Message: commitMsg,
-	}, filters...)
+	// the commit is made by the author the client is configured with
+	_, err := client.Commit(git.Commit{Message: commitMsg}, filters...)
 	if err != nil && err != git.ErrNoStagedFiles {
 		return fmt.Errorf("failed to update the repository: %w", err)
 	}
@@ -16,7 +16,22 @@ import (
 )
 
 type Installer interface {
-	Install(namespace string, configURL gitproviders.RepoURL, autoMerge bool) error
+	Install(namespace string, configURL gitproviders.RepoURL, autoMerge bool, opts Options) error
+}
+
+// Options are the settings of gitops saved to the wego config of the installation,
+// and the options of the pull request associating the cluster
+type Options struct {
+	// SigningKeySecret is the secret holding the key the commits of gitops are signed with
+	SigningKeySecret string
+	// CommitAuthor is the author of the commits of gitops
+	CommitAuthor git.Author
+	// CommitTemplates are the templates of the commit messages and pull requests of gitops,
+	// including the ones of the installation
+	CommitTemplates models.CommitTemplates
+	// PullRequestOptions are the reviewers, labels, assignees and draft status of the pull request,
+	// which aren't saved
+	PullRequestOptions gitproviders.PullRequestOptions
 }
 
 type install struct {
@@ -41,7 +56,8 @@ func NewInstaller(fluxClient flux.Flux, kubeClient kube.Kube, gitClient git.Git,
 }
 
 // Install generates gitops manifests, save them to the config repository and applies them to the cluster. In case auto-merge is true it creates a PR instead of writing directly to the default branch.
-func (i *install) Install(namespace string, configURL gitproviders.RepoURL, autoMerge bool) error {
+// The options are saved to the wego config, so the later commits of gitops are made with them as well.
+func (i *install) Install(namespace string, configURL gitproviders.RepoURL, autoMerge bool, opts Options) error {
 	ctx := context.Background()
 
 	if err := validateWegoInstall(ctx, i.kubeClient, namespace); err != nil {
@@ -58,9 +74,12 @@ func (i *install) Install(namespace string, configURL gitproviders.RepoURL, auto
 	}
 
 	manifestParams := models.ManifestsParams{
-		ClusterName:   clusterName,
-		WegoNamespace: namespace,
-		ConfigRepo:    configURL,
+		ClusterName:      clusterName,
+		WegoNamespace:    namespace,
+		ConfigRepo:       configURL,
+		SigningKeySecret: opts.SigningKeySecret,
+		CommitAuthor:     opts.CommitAuthor,
+		CommitTemplates:  opts.CommitTemplates,
 	}
 
 	bootstrapManifests, err := models.BootstrapManifests(ctx, i.fluxClient, i.gitProviderClient, i.kubeClient, manifestParams)
@@ -96,8 +115,21 @@ func (i *install) Install(namespace string, configURL gitproviders.RepoURL, auto
 
 	i.log.Actionf("Associating cluster %q", clusterName)
 
+	pullRequestInfo, err := opts.CommitTemplates.Apply(gitproviders.PullRequestInfo{
+		Title:              fmt.Sprintf("GitOps associate %s", clusterName),
+		Description:        fmt.Sprintf("Add gitops automation manifests for cluster %s", clusterName),
+		CommitMessage:      gitopswriter.ClusterCommitMessage,
+		NewBranch:          models.GetClusterHash(clusterName),
+		TargetBranch:       defaultBranch,
+		Files:              models.ConvertManifestsToCommitFiles(gitopsManifests),
+		PullRequestOptions: opts.PullRequestOptions,
+	}, models.CommitData{Action: models.ActionAssociateCluster, Clusters: []string{clusterName}})
+	if err != nil {
+		return err
+	}
+
 	if autoMerge {
-		err = i.repoWriter.Write(ctx, configURL, defaultBranch, models.ConvertManifestsToCommitFiles(gitopsManifests))
+		err = i.repoWriter.Write(ctx, configURL, defaultBranch, pullRequestInfo.CommitMessage, pullRequestInfo.Files)
 		if err != nil {
 			return fmt.Errorf("failed writting to default branch %w", err)
 		}
@@ -105,17 +137,10 @@ func (i *install) Install(namespace string, configURL gitproviders.RepoURL, auto
 		return nil
 	}
 
-	pullRequestInfo := gitproviders.PullRequestInfo{
-		Title:         fmt.Sprintf("GitOps associate %s", clusterName),
-		Description:   fmt.Sprintf("Add gitops automation manifests for cluster %s", clusterName),
-		CommitMessage: gitopswriter.ClusterCommitMessage,
-		NewBranch:     models.GetClusterHash(clusterName),
-		TargetBranch:  defaultBranch,
-		Files:         models.ConvertManifestsToCommitFiles(gitopsManifests),
-	}
-
 	pr, err := i.gitProviderClient.CreatePullRequest(ctx, configURL, pullRequestInfo)
-	if err != nil {
+	if errors.Is(err, gitproviders.ErrPullRequestOptionsNotSet) {
+		i.log.Warningf("%s", err)
+	} else if err != nil {
 		return fmt.Errorf("failed creating pull request: %w", err)
 	}
 
@@ -24,6 +24,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/logger/loggerfakes"
 	"github.com/weaveworks/weave-gitops/pkg/models"
 	"github.com/weaveworks/weave-gitops/pkg/services/gitopswriter"
+	"github.com/weaveworks/weave-gitops/pkg/testutils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/onsi/ginkgo"
@@ -86,14 +87,14 @@ var _ = Describe("Installer", func() {
 		It("should fail validating wego installation", func() {
 			fakeKubeClient.GetClusterStatusReturns(kube.Unknown)
 
-			err := installer.Install(testNamespace, configRepo, true)
+			err := installer.Install(testNamespace, configRepo, true, Options{})
 			Expect(err).Should(MatchError("failed validating wego installation: Weave GitOps cannot talk to the cluster"))
 		})
 
 		It("should fail getting cluster name", func() {
 			fakeKubeClient.GetClusterNameReturns("", someError)
 
-			err := installer.Install(testNamespace, configRepo, true)
+			err := installer.Install(testNamespace, configRepo, true, Options{})
 			Expect(err).Should(MatchError(fmt.Sprintf("failed getting cluster name: %s", someError)))
 		})
 
@@ -101,35 +102,35 @@ var _ = Describe("Installer", func() {
 
 			fakeFluxClient.InstallReturnsOnCall(0, nil, someError)
 
-			err := installer.Install(testNamespace, configRepo, true)
+			err := installer.Install(testNamespace, configRepo, true, Options{})
 			Expect(err).Should(MatchError(fmt.Sprintf("failed installing flux: %s", someError)))
 		})
 
 		It("should fail getting bootstrap manifests", func() {
 			fakeFluxClient.InstallReturnsOnCall(1, nil, someError)
 
-			err := installer.Install(testNamespace, configRepo, true)
+			err := installer.Install(testNamespace, configRepo, true, Options{})
 			Expect(err).Should(MatchError(fmt.Sprintf("failed getting bootstrap manifests: failed getting runtime manifests: %s", someError)))
 		})
 
 		It("should fail getting default branch", func() {
 			fakeGitProvider.GetDefaultBranchReturnsOnCall(1, "", someError)
 
-			err := installer.Install(testNamespace, configRepo, true)
+			err := installer.Install(testNamespace, configRepo, true, Options{})
 			Expect(err).Should(MatchError(fmt.Sprintf("failed getting default branch: %s", someError)))
 		})
 
 		It("should fail applying bootstrap manifests", func() {
 			fakeKubeClient.ApplyReturns(someError)
 
-			err := installer.Install(testNamespace, configRepo, true)
+			err := installer.Install(testNamespace, configRepo, true, Options{})
 			Expect(err).Should(MatchError(fmt.Sprintf("error applying manifest .weave-gitops/clusters/test-cluster/system/wego-system.yaml: %s", someError)))
 		})
 
 		It("should fail getting gitops manifests", func() {
 			fakeFluxClient.InstallReturnsOnCall(1, nil, someError)
 
-			err := installer.Install(testNamespace, configRepo, true)
+			err := installer.Install(testNamespace, configRepo, true, Options{})
 			Expect(err.Error()).Should(ContainSubstring(fmt.Sprintf("failed getting runtime manifests: %s", someError)))
 		})
 
@@ -137,16 +138,75 @@ var _ = Describe("Installer", func() {
 
 			fakeGitClient.CloneReturns(false, someError)
 
-			err := installer.Install(testNamespace, configRepo, true)
+			err := installer.Install(testNamespace, configRepo, true, Options{})
 			Expect(err).Should(MatchError(fmt.Sprintf("failed writting to default branch failed to clone repo: failed cloning user repo: ssh://git@github.com/test-user/test-repo.git: %s", someError)))
 		})
 
 		It("should fail creating a pull requests", func() {
 			fakeGitProvider.CreatePullRequestReturns(nil, someError)
 
-			err := installer.Install(testNamespace, configRepo, false)
+			err := installer.Install(testNamespace, configRepo, false, Options{})
 			Expect(err).Should(MatchError(fmt.Sprintf("failed creating pull request: %s", someError)))
 		})
+
+		It("should fail executing the commit templates", func() {
+			opts := Options{CommitTemplates: models.CommitTemplates{CommitMessage: "{{ .App.Name }}"}}
+
+			err := installer.Install(testNamespace, configRepo, true, opts)
+			Expect(err).Should(MatchError(ContainSubstring("failed executing commit message template")))
+		})
+	})
+
+	Context("options", func() {
+		BeforeEach(func() {
+			fakeKubeClient.GetClusterStatusReturns(kube.Unmodified)
+			fakeKubeClient.GetWegoConfigReturns(&kube.WegoConfig{}, kube.ErrWegoConfigNotFound)
+			fakeKubeClient.GetClusterNameReturns(clusterName, nil)
+			fakeGitProvider.GetDefaultBranchReturns("main", nil)
+
+			privateVisibility := gitprovider.RepositoryVisibilityPrivate
+			fakeGitProvider.GetRepoVisibilityReturns(&privateVisibility, nil)
+		})
+
+		It("saves the options to the wego config and commits with the templates", func() {
+			opts := Options{
+				SigningKeySecret: "wego-signing-key",
+				CommitAuthor:     git.Author{Name: "bot", Email: "bot@example.com"},
+				CommitTemplates:  models.CommitTemplates{CommitMessage: "chore: associate {{ index .Clusters 0 }}"},
+			}
+
+			err := installer.Install(testNamespace, configRepo, true, opts)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			applied := []string{}
+			for i := 0; i < fakeKubeClient.ApplyCallCount(); i++ {
+				_, manifest, _ := fakeKubeClient.ApplyArgsForCall(i)
+				applied = append(applied, string(manifest))
+			}
+
+			Expect(applied).To(ContainElement(And(
+				ContainSubstring("SigningKeySecret: wego-signing-key"),
+				ContainSubstring("CommitAuthorName: bot"),
+				ContainSubstring("CommitAuthorEmail: bot@example.com"),
+				ContainSubstring("CommitMessageTemplate: "),
+			)))
+
+			msg, _ := fakeGitClient.CommitArgsForCall(0)
+			Expect(msg.Message).To(Equal("chore: associate test-cluster"))
+		})
+
+		It("creates the pull request with the pull request options", func() {
+			fakeGitProvider.CreatePullRequestReturns(testutils.DummyPullRequest{}, nil)
+
+			err := installer.Install(testNamespace, configRepo, false, Options{
+				PullRequestOptions: gitproviders.PullRequestOptions{Assignees: []string{"bot"}, Draft: true},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, prInfo := fakeGitProvider.CreatePullRequestArgsForCall(0)
+			Expect(prInfo.Assignees).To(Equal([]string{"bot"}))
+			Expect(prInfo.Draft).To(BeTrue())
+		})
 	})
 
 	Context("success path", func() {
@@ -192,7 +252,7 @@ var _ = Describe("Installer", func() {
 			fakeFluxClient.CreateKustomizationReturnsOnCall(2, systemKustomizationResource, nil)
 			fakeFluxClient.CreateKustomizationReturnsOnCall(3, userKustomizationResource, nil)
 
-			gitopsConfigMap, err := models.CreateGitopsConfigMap(testNamespace, testNamespace, configRepo.String())
+			gitopsConfigMap, err := models.CreateGitopsConfigMap(testNamespace, models.ManifestsParams{WegoNamespace: testNamespace, ConfigRepo: configRepo})
 			Expect(err).ShouldNot(HaveOccurred())
 
 			wegoConfigManifest, err = yaml.Marshal(gitopsConfigMap)
@@ -280,7 +340,7 @@ var _ = Describe("Installer", func() {
 				return nil
 			})
 
-			err = installer.Install(testNamespace, configRepo, true)
+			err = installer.Install(testNamespace, configRepo, true, Options{})
 			Expect(err).ShouldNot(HaveOccurred())
 		})
 		It("should succeed with auto-merge=false", func() {
@@ -372,7 +432,7 @@ var _ = Describe("Installer", func() {
 				return NewFakePullRequest("test", "test", 1), nil
 			})
 
-			err = installer.Install(testNamespace, configRepo, false)
+			err = installer.Install(testNamespace, configRepo, false, Options{})
 			Expect(err).ShouldNot(HaveOccurred())
 		})
 	})
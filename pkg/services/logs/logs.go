@@ -0,0 +1,327 @@
+package logs
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// The kinds of the workloads selecting the pods they run with spec.selector.
+// The pods of a CronJob are found from the selectors of the Jobs it owns.
+var workloadKinds = []schema.GroupVersionKind{
+	appsv1.SchemeGroupVersion.WithKind("Deployment"),
+	appsv1.SchemeGroupVersion.WithKind("StatefulSet"),
+	appsv1.SchemeGroupVersion.WithKind("DaemonSet"),
+	appsv1.SchemeGroupVersion.WithKind("ReplicaSet"),
+	batchv1.SchemeGroupVersion.WithKind("Job"),
+}
+
+var cronJobKind = batchv1.SchemeGroupVersion.WithKind("CronJob")
+
+// ErrNoContainers is returned by Stream when none of the pods has a container matching the options
+var ErrNoContainers = errors.New("no containers found in the pods of the application")
+
+// Options selects the logs to read from the pods of an application
+type Options struct {
+	// Pod only reads the logs of this pod when set
+	Pod string
+	// Container only reads the logs of this container when set
+	Container string
+	// Follow keeps streaming the logs as they are written
+	Follow bool
+	// TailLines reads this many lines from the end of the logs of each container when set
+	TailLines int64
+}
+
+// Line is a line logged by a container
+type Line struct {
+	Pod       string
+	Namespace string
+	Container string
+	Text      string
+}
+
+// ApplicationLogs reads the logs of the containers of the pods run by the objects an application reconciled,
+// calling send for each line. Both clients should be created from the same config, for the access of the
+// caller to be checked the same way for the objects and the logs.
+func ApplicationLogs(ctx context.Context, kubeClient kube.Kube, clientset kubernetes.Interface, name types.NamespacedName, opts Options, send func(Line) error) error {
+	app, err := kubeClient.GetApplication(ctx, name)
+	if err != nil {
+		return fmt.Errorf("could not get application %q: %w", name.Name, err)
+	}
+
+	pods, err := ApplicationPods(ctx, kubeClient.Raw(), app)
+	if err != nil {
+		return err
+	}
+
+	return Stream(ctx, clientset, pods, opts, send)
+}
+
+// ApplicationPods returns the pods of the objects the Kustomization or HelmRelease of an application reconciled.
+// These are the pods it applied and the pods run by the workloads it applied, sorted by namespace and name.
+func ApplicationPods(ctx context.Context, cl client.Client, app *wego.Application) ([]corev1.Pod, error) {
+	automationLabels := client.MatchingLabels{
+		fmt.Sprintf("%s/name", kustomizev2.GroupVersion.Group):      app.Name,
+		fmt.Sprintf("%s/namespace", kustomizev2.GroupVersion.Group): app.Namespace,
+	}
+
+	if app.Spec.DeploymentType == wego.DeploymentTypeHelm {
+		automationLabels = client.MatchingLabels{
+			fmt.Sprintf("%s/name", helmv2.GroupVersion.Group):      app.Name,
+			fmt.Sprintf("%s/namespace", helmv2.GroupVersion.Group): app.Namespace,
+		}
+	}
+
+	found := map[types.UID]corev1.Pod{}
+
+	addPods := func(opts ...client.ListOption) error {
+		pods := corev1.PodList{}
+		if err := cl.List(ctx, &pods, opts...); err != nil {
+			return fmt.Errorf("could not list pods: %w", err)
+		}
+
+		for _, pod := range pods.Items {
+			found[pod.UID] = pod
+		}
+
+		return nil
+	}
+
+	addSelectedPods := func(workload *unstructured.Unstructured) error {
+		selector, err := podSelector(workload)
+		if err != nil || selector == nil {
+			return err
+		}
+
+		return addPods(client.InNamespace(workload.GetNamespace()), client.MatchingLabelsSelector{Selector: selector})
+	}
+
+	if err := addPods(automationLabels); err != nil {
+		return nil, err
+	}
+
+	for _, gvk := range workloadKinds {
+		workloads, err := list(ctx, cl, gvk, automationLabels)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range workloads {
+			if err := addSelectedPods(&workloads[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	cronJobs, err := list(ctx, cl, cronJobKind, automationLabels)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cronJob := range cronJobs {
+		jobs, err := list(ctx, cl, batchv1.SchemeGroupVersion.WithKind("Job"), client.InNamespace(cronJob.GetNamespace()))
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range jobs {
+			if !isOwnedBy(&jobs[i], cronJob.GetUID()) {
+				continue
+			}
+
+			if err := addSelectedPods(&jobs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	pods := make([]corev1.Pod, 0, len(found))
+
+	for _, pod := range found {
+		pods = append(pods, pod)
+	}
+
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Namespace != pods[j].Namespace {
+			return pods[i].Namespace < pods[j].Namespace
+		}
+
+		return pods[i].Name < pods[j].Name
+	})
+
+	return pods, nil
+}
+
+// Stream reads the logs of the containers of the pods selected by the options, calling send for each line.
+// The logs of the containers are read one after the other, or all at once when following them.
+func Stream(ctx context.Context, clientset kubernetes.Interface, pods []corev1.Pod, opts Options, send func(Line) error) error {
+	containers := []Line{}
+
+	for _, pod := range pods {
+		if opts.Pod != "" && pod.Name != opts.Pod {
+			continue
+		}
+
+		for _, container := range pod.Spec.Containers {
+			if opts.Container != "" && container.Name != opts.Container {
+				continue
+			}
+
+			containers = append(containers, Line{Pod: pod.Name, Namespace: pod.Namespace, Container: container.Name})
+		}
+	}
+
+	if len(containers) == 0 {
+		return ErrNoContainers
+	}
+
+	if !opts.Follow {
+		for _, container := range containers {
+			if err := streamContainer(ctx, clientset, container, opts, send); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	// the lines of the containers are interleaved, send is never called concurrently
+	sendLine := func(line Line) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return send(line)
+	}
+
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if firstErr == nil {
+			firstErr = err
+
+			cancel()
+		}
+	}
+
+	for _, container := range containers {
+		wg.Add(1)
+
+		go func(container Line) {
+			defer wg.Done()
+
+			if err := streamContainer(ctx, clientset, container, opts, sendLine); err != nil {
+				fail(err)
+			}
+		}(container)
+	}
+
+	wg.Wait()
+
+	return firstErr
+}
+
+func streamContainer(ctx context.Context, clientset kubernetes.Interface, container Line, opts Options, send func(Line) error) error {
+	logOptions := &corev1.PodLogOptions{
+		Container: container.Container,
+		Follow:    opts.Follow,
+	}
+
+	if opts.TailLines > 0 {
+		logOptions.TailLines = &opts.TailLines
+	}
+
+	reader, err := clientset.CoreV1().Pods(container.Namespace).GetLogs(container.Pod, logOptions).Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get the logs of container %s of pod %s: %w", container.Container, container.Pod, err)
+	}
+	defer reader.Close()
+
+	scanner := bufio.NewScanner(reader)
+
+	for scanner.Scan() {
+		line := container
+		line.Text = scanner.Text()
+
+		if err := send(line); err != nil {
+			return err
+		}
+	}
+
+	// reading fails once the context is cancelled, which only ends the stream
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("could not read the logs of container %s of pod %s: %w", container.Container, container.Pod, err)
+	}
+
+	return nil
+}
+
+func list(ctx context.Context, cl client.Client, gvk schema.GroupVersionKind, opts ...client.ListOption) ([]unstructured.Unstructured, error) {
+	objects := unstructured.UnstructuredList{}
+	objects.SetGroupVersionKind(gvk)
+
+	if err := cl.List(ctx, &objects, opts...); err != nil {
+		return nil, fmt.Errorf("could not list %s: %w", gvk.Kind, err)
+	}
+
+	return objects.Items, nil
+}
+
+// podSelector returns the selector of the pods of a workload, or nil if it has none
+func podSelector(workload *unstructured.Unstructured) (labels.Selector, error) {
+	content, found, err := unstructured.NestedMap(workload.Object, "spec", "selector")
+	if err != nil || !found {
+		return nil, err
+	}
+
+	selector := &metav1.LabelSelector{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, selector); err != nil {
+		return nil, fmt.Errorf("could not read the selector of %s %s: %w", workload.GetKind(), workload.GetName(), err)
+	}
+
+	// an empty selector matches every pod
+	if len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0 {
+		return nil, nil
+	}
+
+	return metav1.LabelSelectorAsSelector(selector)
+}
+
+func isOwnedBy(obj *unstructured.Unstructured, uid types.UID) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == uid {
+			return true
+		}
+	}
+
+	return false
+}
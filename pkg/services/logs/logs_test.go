@@ -0,0 +1,186 @@
+package logs_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/services/logs"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Logs", func() {
+	var (
+		app       *wego.Application
+		clientset *kubefake.Clientset
+	)
+
+	kustomizeLabels := map[string]string{
+		"kustomize.toolkit.fluxcd.io/name":      "podinfo",
+		"kustomize.toolkit.fluxcd.io/namespace": wego.DefaultNamespace,
+	}
+
+	pod := func(name string, labels map[string]string, containers ...string) *corev1.Pod {
+		p := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(name), Labels: labels}}
+
+		for _, container := range containers {
+			p.Spec.Containers = append(p.Spec.Containers, corev1.Container{Name: container, Image: container})
+		}
+
+		return p
+	}
+
+	newClient := func(objects ...client.Object) client.Client {
+		scheme := kube.CreateScheme()
+		Expect(batchv1.AddToScheme(scheme)).To(Succeed())
+
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	}
+
+	podNames := func(pods []corev1.Pod) []string {
+		names := []string{}
+
+		for _, p := range pods {
+			names = append(names, p.Name)
+		}
+
+		return names
+	}
+
+	collect := func(pods []corev1.Pod, opts logs.Options) ([]logs.Line, error) {
+		lines := []logs.Line{}
+
+		err := logs.Stream(context.Background(), clientset, pods, opts, func(line logs.Line) error {
+			lines = append(lines, line)
+			return nil
+		})
+
+		return lines, err
+	}
+
+	BeforeEach(func() {
+		app = &wego.Application{}
+		app.Name = "podinfo"
+		app.Namespace = wego.DefaultNamespace
+		app.Spec.DeploymentType = wego.DeploymentTypeKustomize
+
+		clientset = kubefake.NewSimpleClientset()
+	})
+
+	Describe("ApplicationPods", func() {
+		It("returns the pods of the workloads the application reconciled", func() {
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "default", Labels: kustomizeLabels},
+				Spec: appsv1.DeploymentSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "podinfo"}},
+				},
+			}
+
+			cronJob := &batchv1.CronJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "default", UID: "backup-uid", Labels: kustomizeLabels},
+				Spec:       batchv1.CronJobSpec{Schedule: "@daily"},
+			}
+
+			job := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "backup-1",
+					Namespace:       "default",
+					OwnerReferences: []metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "CronJob", Name: "backup", UID: "backup-uid"}},
+				},
+				Spec: batchv1.JobSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"controller-uid": "backup-1"}},
+				},
+			}
+
+			cl := newClient(
+				deployment, cronJob, job,
+				pod("podinfo-abc", map[string]string{"app": "podinfo"}, "podinfo"),
+				pod("backup-1-xyz", map[string]string{"controller-uid": "backup-1"}, "backup"),
+				pod("standalone", kustomizeLabels, "standalone"),
+				pod("other", map[string]string{"app": "other"}, "other"),
+			)
+
+			pods, err := logs.ApplicationPods(context.Background(), cl, app)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(podNames(pods)).To(Equal([]string{"backup-1-xyz", "podinfo-abc", "standalone"}))
+		})
+
+		It("looks up the objects applied by the helm release of a helm application", func() {
+			app.Spec.DeploymentType = wego.DeploymentTypeHelm
+
+			cl := newClient(
+				pod("kustomized", kustomizeLabels, "podinfo"),
+				pod("released", map[string]string{
+					"helm.toolkit.fluxcd.io/name":      "podinfo",
+					"helm.toolkit.fluxcd.io/namespace": wego.DefaultNamespace,
+				}, "podinfo"),
+			)
+
+			pods, err := logs.ApplicationPods(context.Background(), cl, app)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(podNames(pods)).To(Equal([]string{"released"}))
+		})
+	})
+
+	Describe("Stream", func() {
+		var pods []corev1.Pod
+
+		BeforeEach(func() {
+			pods = []corev1.Pod{
+				*pod("podinfo-abc", nil, "podinfo", "linkerd-proxy"),
+				*pod("podinfo-def", nil, "podinfo"),
+			}
+		})
+
+		It("reads the logs of every container", func() {
+			lines, err := collect(pods, logs.Options{TailLines: 10})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lines).To(Equal([]logs.Line{
+				{Pod: "podinfo-abc", Namespace: "default", Container: "podinfo", Text: "fake logs"},
+				{Pod: "podinfo-abc", Namespace: "default", Container: "linkerd-proxy", Text: "fake logs"},
+				{Pod: "podinfo-def", Namespace: "default", Container: "podinfo", Text: "fake logs"},
+			}))
+
+			for _, action := range clientset.Actions() {
+				Expect(action.GetSubresource()).To(Equal("log"))
+
+				opts, ok := action.(testing.GenericAction).GetValue().(*corev1.PodLogOptions)
+				Expect(ok).To(BeTrue())
+				Expect(*opts.TailLines).To(Equal(int64(10)))
+			}
+		})
+
+		It("only reads the logs of the selected pod and container", func() {
+			lines, err := collect(pods, logs.Options{Pod: "podinfo-abc", Container: "podinfo"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lines).To(Equal([]logs.Line{
+				{Pod: "podinfo-abc", Namespace: "default", Container: "podinfo", Text: "fake logs"},
+			}))
+		})
+
+		It("reads the logs of all the containers at once when following them", func() {
+			lines, err := collect(pods, logs.Options{Follow: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lines).To(ConsistOf(
+				logs.Line{Pod: "podinfo-abc", Namespace: "default", Container: "podinfo", Text: "fake logs"},
+				logs.Line{Pod: "podinfo-abc", Namespace: "default", Container: "linkerd-proxy", Text: "fake logs"},
+				logs.Line{Pod: "podinfo-def", Namespace: "default", Container: "podinfo", Text: "fake logs"},
+			))
+		})
+
+		It("fails when no container matches the options", func() {
+			_, err := collect(pods, logs.Options{Container: "sidecar"})
+			Expect(err).To(MatchError(logs.ErrNoContainers))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package profiles
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -64,12 +65,19 @@ func (s *ProfilesSvc) Add(ctx context.Context, gitProvider gitproviders.GitProvi
 	}
 
 	path := git.GetProfilesPath(opts.Cluster, models.WegoProfilesPath)
-	pr, err := gitProvider.CreatePullRequest(ctx, configRepoURL, prInfo(opts, "add", defaultBranch, gitprovider.CommitFile{
+
+	info, err := prInfo(opts, "add", defaultBranch, gitprovider.CommitFile{
 		Path:    &path,
 		Content: &content,
-	}))
-
+	})
 	if err != nil {
+		return err
+	}
+
+	pr, err := gitProvider.CreatePullRequest(ctx, configRepoURL, info)
+	if errors.Is(err, gitproviders.ErrPullRequestOptionsNotSet) {
+		s.Logger.Warningf("%s", err)
+	} else if err != nil {
 		return fmt.Errorf("failed to create pull request: %s", err)
 	}
 
@@ -78,7 +86,7 @@ func (s *ProfilesSvc) Add(ctx context.Context, gitProvider gitproviders.GitProvi
 	if opts.AutoMerge {
 		s.Logger.Actionf("auto-merge=true; merging PR number %v", pr.Get().Number)
 
-		if err := gitProvider.MergePullRequest(ctx, configRepoURL, pr.Get().Number, AddCommitMessage); err != nil {
+		if err := gitProvider.MergePullRequest(ctx, configRepoURL, pr.Get().Number, info.CommitMessage); err != nil {
 			return fmt.Errorf("error auto-merging PR: %w", err)
 		}
 	}
@@ -88,23 +96,13 @@ func (s *ProfilesSvc) Add(ctx context.Context, gitProvider gitproviders.GitProvi
 	return nil
 }
 
-func prInfo(opts Options, action, defaultBranch string, commitFile gitprovider.CommitFile) gitproviders.PullRequestInfo {
-	title := fmt.Sprintf("GitOps %s %s", action, opts.Name)
-
-	if opts.Title != "" {
-		title = opts.Title
-	}
-
-	description := fmt.Sprintf("%s manifest for %s profile", strings.Title(action), opts.Name)
-	if opts.Description != "" {
-		description = opts.Description
-	}
-
-	commitMessage := fmt.Sprintf("%s profile manifests", strings.Title(action))
-	if opts.Message != "" {
-		commitMessage = opts.Message
-	}
+// commitActions are the actions of the commit templates of the profile actions
+var commitActions = map[string]string{
+	"add":    models.ActionAddProfile,
+	"update": models.ActionUpdateProfile,
+}
 
+func prInfo(opts Options, action, defaultBranch string, commitFile gitprovider.CommitFile) (gitproviders.PullRequestInfo, error) {
 	headBranch := defaultBranch
 	if opts.HeadBranch != "" {
 		headBranch = opts.HeadBranch
@@ -115,14 +113,36 @@ func prInfo(opts Options, action, defaultBranch string, commitFile gitprovider.C
 		newBranch = opts.BaseBranch
 	}
 
-	return gitproviders.PullRequestInfo{
-		Title:         title,
-		Description:   description,
-		CommitMessage: commitMessage,
-		TargetBranch:  headBranch,
-		NewBranch:     newBranch,
-		Files:         []gitprovider.CommitFile{commitFile},
+	info, err := opts.Templates.Apply(gitproviders.PullRequestInfo{
+		Title:              fmt.Sprintf("GitOps %s %s", action, opts.Name),
+		Description:        fmt.Sprintf("%s manifest for %s profile", strings.Title(action), opts.Name),
+		CommitMessage:      fmt.Sprintf("%s profile manifests", strings.Title(action)),
+		TargetBranch:       headBranch,
+		NewBranch:          newBranch,
+		Files:              []gitprovider.CommitFile{commitFile},
+		PullRequestOptions: opts.PullRequestOptions,
+	}, models.CommitData{
+		Action:   commitActions[action],
+		Clusters: []string{opts.Cluster},
+		Profile:  &models.CommitProfile{Name: opts.Name, Version: opts.Version, Namespace: opts.Namespace},
+	})
+	if err != nil {
+		return info, err
 	}
+
+	if opts.Title != "" {
+		info.Title = opts.Title
+	}
+
+	if opts.Description != "" {
+		info.Description = opts.Description
+	}
+
+	if opts.Message != "" {
+		info.CommitMessage = opts.Message
+	}
+
+	return info, nil
 }
 
 func (s *ProfilesSvc) printAddSummary(opts Options) {
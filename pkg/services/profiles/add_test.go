@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders/gitprovidersfakes"
 	"github.com/weaveworks/weave-gitops/pkg/helm"
 	"github.com/weaveworks/weave-gitops/pkg/logger/loggerfakes"
@@ -118,6 +119,73 @@ var _ = Describe("Add", func() {
 					})
 				})
 
+				When("commit templates are set", func() {
+					It("opens a PR with the texts of the templates", func() {
+						addOptions.Title = "cool-title"
+						addOptions.Templates = models.CommitTemplates{
+							CommitMessage:    "feat({{ .Profile.Name }}): {{ lower .Default }} to {{ index .Clusters 0 }}",
+							PullRequestTitle: "{{ .Action }}",
+							PullRequestBody:  "{{ .Default }}\n\nVersion {{ .Profile.Version }}",
+						}
+
+						fakePR.GetReturns(gitprovider.PullRequestInfo{
+							WebURL: "url",
+						})
+						gitProviders.CreatePullRequestReturns(fakePR, nil)
+
+						Expect(profilesSvc.Add(context.TODO(), gitProviders, addOptions)).Should(Succeed())
+
+						_, _, prInfo := gitProviders.CreatePullRequestArgsForCall(0)
+						Expect(prInfo.CommitMessage).To(Equal("feat(podinfo): add profile manifests to prod"))
+						Expect(prInfo.Title).To(Equal("cool-title"))
+						Expect(prInfo.Description).To(Equal("Add manifest for podinfo profile\n\nVersion 6.0.1"))
+					})
+
+					It("fails on an invalid template", func() {
+						addOptions.Templates = models.CommitTemplates{PullRequestTitle: "{{ .App.Name }}"}
+
+						err := profilesSvc.Add(context.TODO(), gitProviders, addOptions)
+						Expect(err).To(MatchError(ContainSubstring("failed executing pull request title template")))
+						Expect(gitProviders.CreatePullRequestCallCount()).To(Equal(0))
+					})
+				})
+
+				When("pull request options are set", func() {
+					It("opens a PR with the reviewers and labels", func() {
+						addOptions.PullRequestOptions = gitproviders.PullRequestOptions{
+							Reviewers: []string{"alice"},
+							Labels:    []string{"profiles"},
+						}
+
+						fakePR.GetReturns(gitprovider.PullRequestInfo{
+							WebURL: "url",
+						})
+						gitProviders.CreatePullRequestReturns(fakePR, nil)
+
+						Expect(profilesSvc.Add(context.TODO(), gitProviders, addOptions)).Should(Succeed())
+
+						_, _, prInfo := gitProviders.CreatePullRequestArgsForCall(0)
+						Expect(prInfo.Reviewers).To(Equal([]string{"alice"}))
+						Expect(prInfo.Labels).To(Equal([]string{"profiles"}))
+						Expect(prInfo.Draft).To(BeFalse())
+					})
+
+					It("warns when the PR was created without some of its options", func() {
+						addOptions.PullRequestOptions = gitproviders.PullRequestOptions{Labels: []string{"profiles"}}
+
+						fakePR.GetReturns(gitprovider.PullRequestInfo{
+							WebURL: "url",
+						})
+						gitProviders.CreatePullRequestReturns(fakePR, fmt.Errorf("%w: url: error adding labels", gitproviders.ErrPullRequestOptionsNotSet))
+
+						Expect(profilesSvc.Add(context.TODO(), gitProviders, addOptions)).Should(Succeed())
+						Expect(fakeLogger.WarningfCallCount()).To(Equal(1))
+
+						format, args := fakeLogger.WarningfArgsForCall(0)
+						Expect(fmt.Sprintf(format, args...)).To(ContainSubstring("error adding labels"))
+					})
+				})
+
 				When("auto-merge is enabled", func() {
 					It("merges the PR that was created", func() {
 						fakePR.GetReturns(gitprovider.PullRequestInfo{
@@ -13,6 +13,7 @@ import (
 	"github.com/gogo/protobuf/jsonpb"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/profiles"
 	"github.com/weaveworks/weave-gitops/pkg/helm/watcher/controller"
+	"github.com/weaveworks/weave-gitops/pkg/printer"
 )
 
 type GetOptions struct {
@@ -21,6 +22,7 @@ type GetOptions struct {
 	Cluster   string
 	Namespace string
 	Writer    io.Writer
+	Printer   printer.Printer
 	Port      string
 }
 
@@ -31,9 +33,7 @@ func (s *ProfilesSvc) Get(ctx context.Context, opts GetOptions) error {
 		return err
 	}
 
-	printProfiles(profiles, opts.Writer)
-
-	return nil
+	return opts.Printer.Print(opts.Writer, profiles, profilesTable(profiles))
 }
 
 func doKubeGetRequest(ctx context.Context, namespace, serviceName, servicePort, path string, clientset kubernetes.Interface) (*pb.GetProfilesResponse, error) {
@@ -107,15 +107,23 @@ func foundVersion(availableVersions []string, version string) bool {
 	return false
 }
 
-func printProfiles(profiles *pb.GetProfilesResponse, w io.Writer) {
-	fmt.Fprintf(w, "NAME\tDESCRIPTION\tAVAILABLE_VERSIONS\n")
+func profilesTable(profiles *pb.GetProfilesResponse) printer.Table {
+	table := printer.Table{
+		Columns: []printer.Column{{Header: "NAME"}, {Header: "DESCRIPTION"}, {Header: "AVAILABLE_VERSIONS"}, {Header: "HELM_REPOSITORY", Wide: true}},
+	}
 
-	if profiles.Profiles != nil && len(profiles.Profiles) > 0 {
-		for _, p := range profiles.Profiles {
-			fmt.Fprintf(w, "%s\t%s\t%v", p.Name, p.Description, strings.Join(p.AvailableVersions, ","))
-			fmt.Fprintln(w, "")
-		}
+	for _, p := range profiles.Profiles {
+		repo := p.GetHelmRepository()
+
+		table.Rows = append(table.Rows, []string{
+			p.Name,
+			p.Description,
+			strings.Join(p.AvailableVersions, ","),
+			repo.GetNamespace() + "/" + repo.GetName(),
+		})
 	}
+
+	return table
 }
 
 func kubernetesDoRequest(ctx context.Context, namespace, serviceName, servicePort, path string, clientset kubernetes.Interface) ([]byte, error) {
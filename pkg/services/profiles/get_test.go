@@ -16,6 +16,7 @@ import (
 	"k8s.io/client-go/testing"
 
 	"github.com/weaveworks/weave-gitops/pkg/logger/loggerfakes"
+	"github.com/weaveworks/weave-gitops/pkg/printer"
 	"github.com/weaveworks/weave-gitops/pkg/services/profiles"
 )
 
@@ -84,6 +85,44 @@ podinfo	Podinfo Helm chart for Kubernetes	6.0.0,6.0.1
 `))
 		})
 
+		It("prints the helm repository of the profiles in the wide format", func() {
+			clientSet.AddProxyReactor("services", func(action testing.Action) (handled bool, ret restclient.ResponseWrapper, err error) {
+				return true, newFakeResponseWrapper(getProfilesResp), nil
+			})
+
+			p, err := printer.New("wide")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(profilesSvc.Get(context.TODO(), profiles.GetOptions{
+				Namespace: "test-namespace",
+				Writer:    buffer,
+				Printer:   p,
+				Port:      "9001",
+			})).To(Succeed())
+
+			Expect(string(buffer.Contents())).To(Equal(`NAME	DESCRIPTION	AVAILABLE_VERSIONS	HELM_REPOSITORY
+podinfo	Podinfo Helm chart for Kubernetes	6.0.0,6.0.1	weave-system/podinfo
+`))
+		})
+
+		It("prints the profiles response with a jsonpath template", func() {
+			clientSet.AddProxyReactor("services", func(action testing.Action) (handled bool, ret restclient.ResponseWrapper, err error) {
+				return true, newFakeResponseWrapper(getProfilesResp), nil
+			})
+
+			p, err := printer.New("jsonpath={.profiles[0].availableVersions}")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(profilesSvc.Get(context.TODO(), profiles.GetOptions{
+				Namespace: "test-namespace",
+				Writer:    buffer,
+				Printer:   p,
+				Port:      "9001",
+			})).To(Succeed())
+
+			Expect(string(buffer.Contents())).To(Equal(`["6.0.0","6.0.1"]` + "\n"))
+		})
+
 		When("the response isn't valid", func() {
 			It("errors", func() {
 				clientSet.AddProxyReactor("services", func(action testing.Action) (handled bool, ret restclient.ResponseWrapper, err error) {
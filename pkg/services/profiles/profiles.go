@@ -7,6 +7,7 @@ import (
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/models"
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
@@ -43,6 +44,10 @@ type Options struct {
 	Message      string
 	Title        string
 	Description  string
+	// Templates are the templates of the commit and pull request, replaced by Message, Title and Description when they're set
+	Templates models.CommitTemplates
+	// PullRequestOptions are the reviewers, labels, assignees and draft status of the pull request
+	PullRequestOptions gitproviders.PullRequestOptions
 }
 
 type ProfilesSvc struct {
@@ -2,6 +2,7 @@ package profiles
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/weaveworks/weave-gitops/pkg/git"
@@ -59,11 +60,18 @@ func (s *ProfilesSvc) Update(ctx context.Context, gitProvider gitproviders.GitPr
 
 	path := git.GetProfilesPath(opts.Cluster, models.WegoProfilesPath)
 
-	pr, err := gitProvider.CreatePullRequest(ctx, configRepoURL, prInfo(opts, "update", defaultBranch, gitprovider.CommitFile{
+	info, err := prInfo(opts, "update", defaultBranch, gitprovider.CommitFile{
 		Path:    &path,
 		Content: &content,
-	}))
+	})
 	if err != nil {
+		return err
+	}
+
+	pr, err := gitProvider.CreatePullRequest(ctx, configRepoURL, info)
+	if errors.Is(err, gitproviders.ErrPullRequestOptionsNotSet) {
+		s.Logger.Warningf("%s", err)
+	} else if err != nil {
 		return fmt.Errorf("failed to create pull request: %s", err)
 	}
 
@@ -72,7 +80,7 @@ func (s *ProfilesSvc) Update(ctx context.Context, gitProvider gitproviders.GitPr
 	if opts.AutoMerge {
 		s.Logger.Actionf("auto-merge=true; merging PR number %v", pr.Get().Number)
 
-		if err := gitProvider.MergePullRequest(ctx, configRepoURL, pr.Get().Number, AddCommitMessage); err != nil {
+		if err := gitProvider.MergePullRequest(ctx, configRepoURL, pr.Get().Number, info.CommitMessage); err != nil {
 			return fmt.Errorf("error auto-merging PR: %w", err)
 		}
 	}
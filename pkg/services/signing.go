@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// SigningKeySecretKey is the key of the GPG or SSH private key in a signing key secret
+	SigningKeySecretKey = "signing.key"
+	// SigningPassphraseSecretKey is the key of the optional passphrase of the private key in a signing key secret
+	SigningPassphraseSecretKey = "passphrase"
+)
+
+// NewSignerFromSecret returns the Signer of the private key stored in a secret
+func NewSignerFromSecret(ctx context.Context, kubeClient kube.Kube, name types.NamespacedName) (git.Signer, error) {
+	secret, err := kubeClient.GetSecret(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting signing key secret %s: %w", name, err)
+	}
+
+	if secret == nil {
+		return nil, fmt.Errorf("signing key secret %s not found", name)
+	}
+
+	key, ok := secret.Data[SigningKeySecretKey]
+	if !ok {
+		return nil, fmt.Errorf("signing key secret %s has no %q key", name, SigningKeySecretKey)
+	}
+
+	signer, err := git.NewSigner(key, string(secret.Data[SigningPassphraseSecretKey]))
+	if err != nil {
+		return nil, fmt.Errorf("error reading signing key secret %s: %w", name, err)
+	}
+
+	return signer, nil
+}
+
+// getConfiguredSigner returns the Signer of the signing key secret set in the wego config,
+// or nil when the commits aren't signed
+func getConfiguredSigner(ctx context.Context, kubeClient kube.Kube, config *kube.WegoConfig, namespace string) (git.Signer, error) {
+	if config.SigningKeySecret == "" {
+		return nil, nil
+	}
+
+	secretNamespace := config.WegoNamespace
+	if secretNamespace == "" {
+		secretNamespace = namespace
+	}
+
+	return NewSignerFromSecret(ctx, kubeClient, types.NamespacedName{Name: config.SigningKeySecret, Namespace: secretNamespace})
+}
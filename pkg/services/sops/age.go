@@ -0,0 +1,32 @@
+package sops
+
+import (
+	"fmt"
+
+	"filippo.io/age"
+)
+
+// AgeKey is an age X25519 key pair
+type AgeKey struct {
+	// Identity is the secret key, used to decrypt
+	Identity string
+	// Recipient is the public key, used to encrypt
+	Recipient string
+}
+
+// GenerateAgeKey generates an age X25519 key pair, the same as age-keygen does
+func GenerateAgeKey() (AgeKey, error) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		return AgeKey{}, fmt.Errorf("failed to generate age key: %w", err)
+	}
+
+	return newAgeKey(identity), nil
+}
+
+func newAgeKey(identity *age.X25519Identity) AgeKey {
+	return AgeKey{
+		Identity:  identity.String(),
+		Recipient: identity.Recipient().String(),
+	}
+}
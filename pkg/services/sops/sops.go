@@ -0,0 +1,64 @@
+package sops
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DecryptionProvider is the decryption provider of flux for SOPS encrypted manifests
+	DecryptionProvider = "sops"
+
+	// AgeKeySecretKey is the key holding the age identity in the decryption secret.
+	// The kustomize-controller reads age identities from the keys ending in .agekey.
+	AgeKeySecretKey = "age.agekey"
+)
+
+// CreateAgeKeySecret generates an age key and stores its identity in a new Secret, for the kustomize-controller
+// to decrypt the manifests encrypted with its recipient. An existing Secret is never replaced,
+// as the manifests encrypted for its key could no longer be decrypted.
+func CreateAgeKeySecret(ctx context.Context, kubeClient kube.Kube, name, namespace string) (AgeKey, error) {
+	present, err := kubeClient.SecretPresent(ctx, name, namespace)
+	if err != nil {
+		return AgeKey{}, fmt.Errorf("failed to check for secret %s: %w", name, err)
+	}
+
+	if present {
+		return AgeKey{}, fmt.Errorf("secret %s already exists in namespace %s", name, namespace)
+	}
+
+	key, err := GenerateAgeKey()
+	if err != nil {
+		return AgeKey{}, err
+	}
+
+	secret := corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		StringData: map[string]string{
+			AgeKeySecretKey: key.Identity,
+		},
+	}
+
+	manifest, err := yaml.Marshal(secret)
+	if err != nil {
+		return AgeKey{}, fmt.Errorf("failed to marshal secret %s: %w", name, err)
+	}
+
+	if err := kubeClient.Apply(ctx, manifest, namespace); err != nil {
+		return AgeKey{}, fmt.Errorf("failed to create secret %s: %w", name, err)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,13 @@
+package sops
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSops(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Sops Suite")
+}
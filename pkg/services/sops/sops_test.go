@@ -0,0 +1,80 @@
+package sops
+
+import (
+	"context"
+	"errors"
+
+	"filippo.io/age"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube/kubefakes"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Age keys", func() {
+	It("derives the recipient of the identity", func() {
+		// test vector of the age reference implementation
+		identity, err := age.ParseX25519Identity("AGE-SECRET-KEY-1GFPYYSJZGFPYYSJZGFPYYSJZGFPYYSJZGFPYYSJZGFPYYSJZGFPQ4EGAEX")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		key := newAgeKey(identity)
+		Expect(key.Identity).To(Equal("AGE-SECRET-KEY-1GFPYYSJZGFPYYSJZGFPYYSJZGFPYYSJZGFPYYSJZGFPYYSJZGFPQ4EGAEX"))
+		Expect(key.Recipient).To(Equal("age1zvkyg2lqzraa2lnjvqej32nkuu0ues2s82hzrye869xeexvn73equnujwj"))
+	})
+
+	It("generates a new key each time", func() {
+		key, err := GenerateAgeKey()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(key.Identity).To(HavePrefix("AGE-SECRET-KEY-1"))
+		Expect(key.Recipient).To(HavePrefix("age1"))
+
+		other, err := GenerateAgeKey()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(other.Identity).NotTo(Equal(key.Identity))
+	})
+})
+
+var _ = Describe("CreateAgeKeySecret", func() {
+	var (
+		ctx        context.Context
+		kubeClient *kubefakes.FakeKube
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		kubeClient = &kubefakes.FakeKube{}
+	})
+
+	It("applies a secret holding the age identity", func() {
+		key, err := CreateAgeKeySecret(ctx, kubeClient, "sops-age", wego.DefaultNamespace)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(kubeClient.ApplyCallCount()).To(Equal(1))
+
+		_, manifest, namespace := kubeClient.ApplyArgsForCall(0)
+		Expect(namespace).To(Equal(wego.DefaultNamespace))
+
+		var secret corev1.Secret
+		Expect(yaml.Unmarshal(manifest, &secret)).To(Succeed())
+		Expect(secret.Name).To(Equal("sops-age"))
+		Expect(secret.Namespace).To(Equal(wego.DefaultNamespace))
+		Expect(secret.StringData).To(Equal(map[string]string{"age.agekey": key.Identity}))
+	})
+
+	It("does not replace an existing secret", func() {
+		kubeClient.SecretPresentReturns(true, nil)
+
+		_, err := CreateAgeKeySecret(ctx, kubeClient, "sops-age", wego.DefaultNamespace)
+		Expect(err).To(MatchError("secret sops-age already exists in namespace wego-system"))
+		Expect(kubeClient.ApplyCallCount()).To(Equal(0))
+	})
+
+	It("fails when the secret can't be applied", func() {
+		kubeClient.ApplyReturns(errors.New("forbidden"))
+
+		_, err := CreateAgeKeySecret(ctx, kubeClient, "sops-age", wego.DefaultNamespace)
+		Expect(err).To(MatchError("failed to create secret sops-age: forbidden"))
+	})
+})
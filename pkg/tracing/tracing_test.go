@@ -0,0 +1,90 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ = Describe("Tracing", func() {
+	var recorder *tracetest.SpanRecorder
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+		_, err := tracing.Setup(context.Background(), tracing.Options{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	})
+
+	It("records the spans and their errors", func() {
+		ctx, parent := tracing.Start(context.Background(), "parent")
+		_, child := tracing.Start(ctx, "child")
+
+		tracing.End(child, errors.New("not found"))
+		tracing.End(parent, nil)
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[0].Name()).To(Equal("child"))
+		Expect(spans[0].Parent().SpanID()).To(Equal(spans[1].SpanContext().SpanID()))
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		Expect(spans[0].Status().Description).To(Equal("not found"))
+		Expect(spans[1].Status().Code).To(Equal(codes.Unset))
+	})
+
+	It("traces the requests sent in a trace and propagates it", func() {
+		var traceparent string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			traceparent = r.Header.Get("traceparent")
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client := &http.Client{Transport: tracing.Transport(http.DefaultTransport)}
+
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v1/namespaces", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		res, err := client.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		res.Body.Close()
+		Expect(traceparent).To(BeEmpty())
+		Expect(recorder.Ended()).To(BeEmpty())
+
+		ctx, parent := tracing.Start(context.Background(), "parent")
+
+		res, err = client.Do(req.WithContext(ctx))
+		Expect(err).NotTo(HaveOccurred())
+		res.Body.Close()
+		parent.End()
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[0].Name()).To(Equal("HTTP GET"))
+		Expect(spans[0].SpanKind()).To(Equal(trace.SpanKindClient))
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		Expect(traceparent).To(ContainSubstring(spans[0].SpanContext().TraceID().String()))
+		Expect(traceparent).To(ContainSubstring(spans[0].SpanContext().SpanID().String()))
+	})
+
+	It("fails with an unknown exporter", func() {
+		_, err := tracing.Setup(context.Background(), tracing.Options{Exporter: "jaeger"})
+		Expect(err).To(MatchError(`unknown tracing exporter "jaeger", must be one of none or otlp`))
+	})
+})
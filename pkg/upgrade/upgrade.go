@@ -41,6 +41,10 @@ type UpgradeValues struct {
 	Namespace     string
 	Values        []string
 	DryRun        bool
+	// PullRequestOptions are the reviewers, labels, assignees and draft status of the pull request
+	PullRequestOptions gitproviders.PullRequestOptions
+	// Templates are the templates of the commit message, title and body of the pull request
+	Templates models.CommitTemplates
 }
 
 const EnterpriseChartURL string = "https://charts.dev.wkp.weave.works/releases/charts-v3"
@@ -121,21 +125,28 @@ func upgrade(ctx context.Context, uv UpgradeValues, kube kube.Kube, gitClient gi
 		return fmt.Errorf("failed to write update manifest in clone repo: %w", err)
 	}
 
-	err = gitrepo.CommitAndPush(ctx, gitClient, uv.CommitMessage, logger)
-	if err != nil {
-		return fmt.Errorf("failed to commit and push: %w", err)
-	}
-
-	pri := gitproviders.PullRequestInfo{
+	pri, err := uv.Templates.Apply(gitproviders.PullRequestInfo{
 		Title:                     uv.CommitMessage,
 		Description:               "Pull request to upgrade to Weave GitOps Enterprise",
+		CommitMessage:             uv.CommitMessage,
 		SkipAddingFilesOnCreation: true,
 		TargetBranch:              configBranch,
 		NewBranch:                 uv.HeadBranch,
+		PullRequestOptions:        uv.PullRequestOptions,
+	}, models.CommitData{Action: models.ActionUpgrade, Clusters: []string{cname}})
+	if err != nil {
+		return err
 	}
 
-	pr, err := gitProvider.CreatePullRequest(ctx, normalizedURL, pri)
+	err = gitrepo.CommitAndPush(ctx, gitClient, pri.CommitMessage, logger)
 	if err != nil {
+		return fmt.Errorf("failed to commit and push: %w", err)
+	}
+
+	pr, err := gitProvider.CreatePullRequest(ctx, normalizedURL, pri)
+	if errors.Is(err, gitproviders.ErrPullRequestOptionsNotSet) {
+		logger.Warningf("%s", err)
+	} else if err != nil {
 		return err
 	}
 
@@ -15,6 +15,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders/gitprovidersfakes"
 	"github.com/weaveworks/weave-gitops/pkg/kube/kubefakes"
 	"github.com/weaveworks/weave-gitops/pkg/logger/loggerfakes"
+	"github.com/weaveworks/weave-gitops/pkg/models"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -72,19 +73,33 @@ func TestUpgrade(t *testing.T) {
 	}
 	logger := &loggerfakes.FakeLogger{}
 	k := &kubefakes.FakeKube{}
+	k.GetClusterNameReturns("management", nil)
 
 	var output bytes.Buffer
 
 	// Run upgrade!
 	err := upgrade(context.TODO(), UpgradeValues{
-		ConfigRepo:    "https://github.com/test/example.git",
-		HeadBranch:    "upgrade-to-wge",
-		BaseBranch:    "main",
-		CommitMessage: "Upgrade to wge",
-		Namespace:     wego.DefaultNamespace,
+		ConfigRepo:         "https://github.com/test/example.git",
+		HeadBranch:         "upgrade-to-wge",
+		BaseBranch:         "main",
+		CommitMessage:      "Upgrade to wge",
+		Namespace:          wego.DefaultNamespace,
+		PullRequestOptions: gitproviders.PullRequestOptions{Labels: []string{"upgrade"}},
+		Templates: models.CommitTemplates{
+			CommitMessage:    "chore: {{ .Default }}",
+			PullRequestTitle: "chore({{ join .Clusters \",\" }}): {{ .Action }}",
+		},
 	}, k, gitClient, kubeClient, gitProvider, logger, &output)
 
 	assert.NoError(t, err)
+
+	commit, _ := gitClient.CommitArgsForCall(0)
+	assert.Equal(t, "chore: Upgrade to wge", commit.Message)
+
+	_, _, prInfo := gitProvider.CreatePullRequestArgsForCall(0)
+	assert.Equal(t, []string{"upgrade"}, prInfo.Labels)
+	assert.Equal(t, "chore(management): upgrade", prInfo.Title)
+	assert.Equal(t, "Pull request to upgrade to Weave GitOps Enterprise", prInfo.Description)
 }
 
 func TestGetGitAuthFromDeployKey(t *testing.T) {
@@ -24,11 +24,21 @@ export enum GitProvider {
   Unknown = "Unknown",
   GitHub = "GitHub",
   GitLab = "GitLab",
+  BitbucketServer = "BitbucketServer",
+  Gitea = "Gitea",
 }
 
 export enum SourceType {
   Git = "Git",
   Helm = "Helm",
+  Bucket = "Bucket",
+  OCI = "OCI",
+}
+
+export enum ApplicationEventType {
+  Added = "Added",
+  Modified = "Modified",
+  Deleted = "Deleted",
 }
 
 export type Condition = {
@@ -51,6 +61,13 @@ export type Application = {
   kustomization?: Kustomization
   helmRelease?: HelmRelease
   source?: Source
+  conditions?: Condition[]
+  sourceRevision?: string
+  lastAppliedRevision?: string
+  lastReconcileTime?: number
+  suspended?: boolean
+  dependsOn?: string[]
+  dependents?: string[]
 }
 
 export type Kustomization = {
@@ -90,6 +107,7 @@ export type Source = {
   suspend?: boolean
   timeout?: string
   conditions?: Condition[]
+  bucketName?: string
 }
 
 export type AuthenticateRequest = {
@@ -118,7 +136,16 @@ export type GetApplicationResponse = {
   application?: Application
 }
 
-export type AddApplicationRequest = {
+export type PullRequestOptions = {
+  reviewers?: string[]
+  labels?: string[]
+  assignees?: string[]
+  draft?: boolean
+  bodyTemplate?: string
+}
+
+
+type BaseAddApplicationRequest = {
   name?: string
   namespace?: string
   path?: string
@@ -126,17 +153,59 @@ export type AddApplicationRequest = {
   branch?: string
   autoMerge?: boolean
   configRepo?: string
+  sourceInterval?: string
+  interval?: string
+  timeout?: string
+  wait?: boolean
+  healthChecks?: string[]
+  deploymentType?: AutomationKind
+  helmValues?: string
+  helmSetValues?: string[]
+  helmValuesFrom?: string[]
+  substitute?: string[]
+  substituteFrom?: string[]
+  dependsOn?: string[]
+  decryptionProvider?: string
+  decryptionSecret?: string
+  pullRequest?: PullRequestOptions
 }
 
+export type AddApplicationRequest = BaseAddApplicationRequest
+  & OneOf<{ prune: boolean }>
+
 export type AddApplicationResponse = {
   success?: boolean
   application?: Application
 }
 
+
+type BaseUpdateApplicationRequest = {
+  name?: string
+  namespace?: string
+  branch?: string
+  path?: string
+  chart?: string
+  helmTargetNamespace?: string
+  sourceInterval?: string
+  interval?: string
+  timeout?: string
+  autoMerge?: boolean
+}
+
+export type UpdateApplicationRequest = BaseUpdateApplicationRequest
+  & OneOf<{ prune: boolean }>
+
+export type UpdateApplicationResponse = {
+  success?: boolean
+  application?: Application
+}
+
 export type RemoveApplicationRequest = {
   name?: string
   namespace?: string
   autoMerge?: boolean
+  force?: boolean
+  pullRequest?: PullRequestOptions
 }
 
 export type RemoveApplicationResponse = {
@@ -159,6 +228,9 @@ export type Commit = {
   author?: string
   message?: string
   url?: string
+  repository?: string
+  applied?: boolean
+  signature?: string
 }
 
 
@@ -166,6 +238,7 @@ type BaseListCommitsRequest = {
   name?: string
   namespace?: string
   pageSize?: number
+  includeAutomation?: boolean
 }
 
 export type ListCommitsRequest = BaseListCommitsRequest
@@ -174,6 +247,8 @@ export type ListCommitsRequest = BaseListCommitsRequest
 export type ListCommitsResponse = {
   commits?: Commit[]
   nextPageToken?: number
+  lastAppliedRevision?: string
+  automationLastAppliedRevision?: string
 }
 
 export type GroupVersionKind = {
@@ -210,6 +285,101 @@ export type GetChildObjectsRes = {
   objects?: UnstructuredObject[]
 }
 
+export type GetApplicationTreeRequest = {
+  name?: string
+  namespace?: string
+}
+
+export type GetApplicationTreeResponse = {
+  tree?: ObjectNode
+}
+
+export type ObjectNode = {
+  object?: UnstructuredObject
+  children?: ObjectNode[]
+}
+
+export type WatchApplicationsRequest = {
+  namespace?: string
+}
+
+export type WatchApplicationRequest = {
+  name?: string
+  namespace?: string
+}
+
+export type ApplicationEvent = {
+  type?: ApplicationEventType
+  application?: string
+  namespace?: string
+  object?: UnstructuredObject
+}
+
+export type ListApplicationEventsRequest = {
+  name?: string
+  namespace?: string
+}
+
+export type ListApplicationEventsResponse = {
+  events?: KubernetesEvent[]
+}
+
+export type KubernetesEvent = {
+  type?: string
+  reason?: string
+  message?: string
+  involvedObject?: UnstructuredObject
+  component?: string
+  count?: number
+  timestamp?: number
+}
+
+export type GetObjectLogsRequest = {
+  name?: string
+  namespace?: string
+  podName?: string
+  container?: string
+  follow?: boolean
+  tailLines?: string
+}
+
+export type LogLine = {
+  podName?: string
+  namespace?: string
+  container?: string
+  text?: string
+}
+
+export type GetSystemHealthRequest = {
+  namespace?: string
+}
+
+export type GetSystemHealthResponse = {
+  healthy?: boolean
+  components?: ComponentHealth[]
+  crds?: CrdHealth[]
+}
+
+export type ComponentHealth = {
+  name?: string
+  namespace?: string
+  partOf?: string
+  version?: string
+  replicas?: number
+  readyReplicas?: number
+  restarts?: number
+  ready?: boolean
+  message?: string
+}
+
+export type CrdHealth = {
+  name?: string
+  version?: string
+  servedVersions?: string[]
+  ready?: boolean
+  message?: string
+}
+
 export type GetGithubDeviceCodeRequest = {
 }
 
@@ -290,6 +460,24 @@ export class Applications {
   static GetChildObjects(req: GetChildObjectsReq, initReq?: fm.InitReq): Promise<GetChildObjectsRes> {
     return fm.fetchReq<GetChildObjectsReq, GetChildObjectsRes>(`/v1/applications/child_objects`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static GetApplicationTree(req: GetApplicationTreeRequest, initReq?: fm.InitReq): Promise<GetApplicationTreeResponse> {
+    return fm.fetchReq<GetApplicationTreeRequest, GetApplicationTreeResponse>(`/v1/applications/${req["name"]}/tree?${fm.renderURLSearchParams(req, ["name"])}`, {...initReq, method: "GET"})
+  }
+  static WatchApplications(req: WatchApplicationsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ApplicationEvent>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<WatchApplicationsRequest, ApplicationEvent>(`/v1/watch/applications?${fm.renderURLSearchParams(req, [])}`, entityNotifier, {...initReq, method: "GET"})
+  }
+  static WatchApplication(req: WatchApplicationRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ApplicationEvent>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<WatchApplicationRequest, ApplicationEvent>(`/v1/watch/applications/${req["name"]}?${fm.renderURLSearchParams(req, ["name"])}`, entityNotifier, {...initReq, method: "GET"})
+  }
+  static ListApplicationEvents(req: ListApplicationEventsRequest, initReq?: fm.InitReq): Promise<ListApplicationEventsResponse> {
+    return fm.fetchReq<ListApplicationEventsRequest, ListApplicationEventsResponse>(`/v1/applications/${req["name"]}/events?${fm.renderURLSearchParams(req, ["name"])}`, {...initReq, method: "GET"})
+  }
+  static GetObjectLogs(req: GetObjectLogsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<LogLine>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<GetObjectLogsRequest, LogLine>(`/v1/applications/${req["name"]}/logs?${fm.renderURLSearchParams(req, ["name"])}`, entityNotifier, {...initReq, method: "GET"})
+  }
+  static GetSystemHealth(req: GetSystemHealthRequest, initReq?: fm.InitReq): Promise<GetSystemHealthResponse> {
+    return fm.fetchReq<GetSystemHealthRequest, GetSystemHealthResponse>(`/v1/system/health?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
   static GetGithubDeviceCode(req: GetGithubDeviceCodeRequest, initReq?: fm.InitReq): Promise<GetGithubDeviceCodeResponse> {
     return fm.fetchReq<GetGithubDeviceCodeRequest, GetGithubDeviceCodeResponse>(`/v1/applications/auth_providers/github?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
@@ -305,6 +493,9 @@ export class Applications {
   static AddApplication(req: AddApplicationRequest, initReq?: fm.InitReq): Promise<AddApplicationResponse> {
     return fm.fetchReq<AddApplicationRequest, AddApplicationResponse>(`/v1/applications`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static UpdateApplication(req: UpdateApplicationRequest, initReq?: fm.InitReq): Promise<UpdateApplicationResponse> {
+    return fm.fetchReq<UpdateApplicationRequest, UpdateApplicationResponse>(`/v1/applications/${req["name"]}`, {...initReq, method: "PATCH", body: JSON.stringify(req)})
+  }
   static RemoveApplication(req: RemoveApplicationRequest, initReq?: fm.InitReq): Promise<RemoveApplicationResponse> {
     return fm.fetchReq<RemoveApplicationRequest, RemoveApplicationResponse>(`/v1/applications/${req["name"]}`, {...initReq, method: "DELETE", body: JSON.stringify(req)})
   }